@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+	"time"
+
+	"api_fibergorm/internal/dto"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+)
+
+// runBenchJSON compara os codificadores JSON suportados serializando uma página de produtos
+// Uso: api bench-json [--page-size 100]
+func runBenchJSON(args []string) int {
+	fs := flag.NewFlagSet("bench-json", flag.ContinueOnError)
+	pageSize := fs.Int("page-size", 100, "Quantidade de itens da página serializada")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	page := benchPage(*pageSize)
+
+	fmt.Printf("Serializando página com %d produtos\n", *pageSize)
+	fmt.Printf("%-10s %14s %14s %12s\n", "codec", "marshal ns/op", "unmarshal ns/op", "allocs/op")
+
+	for _, name := range jsoncodec.Names() {
+		codec, _ := jsoncodec.Get(name)
+
+		body, err := codec.Marshal(page)
+		if err != nil {
+			fmt.Printf("%-10s erro: %v\n", name, err)
+			return 1
+		}
+
+		marshal := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = codec.Marshal(page)
+			}
+		})
+		unmarshal := testing.Benchmark(func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				var out arqdto.PaginatedResponse[dto.ProdutoResponse]
+				_ = codec.Unmarshal(body, &out)
+			}
+		})
+
+		fmt.Printf("%-10s %14d %14d %12d\n", name, marshal.NsPerOp(), unmarshal.NsPerOp(), marshal.AllocsPerOp())
+	}
+	return 0
+}
+
+// benchPage monta uma resposta paginada representativa
+func benchPage(size int) *arqdto.PaginatedResponse[dto.ProdutoResponse] {
+	now := time.Now().Format("2006-01-02 15:04:05")
+	items := make([]dto.ProdutoResponse, size)
+	for i := range items {
+		items[i] = dto.ProdutoResponse{
+			ID:          uint(i + 1),
+			Codigo:      fmt.Sprintf("PROD%05d", i+1),
+			Descricao:   "Descrição de exemplo com acentuação e tamanho médio para o benchmark",
+			Preco:       float64(i) * 1.5,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			CategoriaID: 1,
+			Categoria: &dto.CategoriaResponse{
+				ID:        1,
+				Nome:      "Categoria Padrão",
+				Ativo:     true,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}
+	}
+	return arqdto.NewPaginatedResponse(items, int64(size*10), 1, size)
+}
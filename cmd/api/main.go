@@ -1,17 +1,11 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
 
-	"api_fibergorm/internal/config"
-	"api_fibergorm/internal/database"
-	"api_fibergorm/internal/middleware"
-	"api_fibergorm/internal/routes"
-
-	"github.com/gofiber/fiber/v2"
+	"api_fibergorm/internal/bootstrap"
 )
 
 // @title API Produtos
@@ -28,76 +22,25 @@ import (
 // @host localhost:3000
 // @BasePath /
 func main() {
-	// Carrega as configurações
-	cfg := config.Load()
-
-	// Configura o logger
-	log := config.SetupLogger(cfg.LogLevel)
-
-	log.Info("Iniciando API de Produtos - POC Fiber + GORM")
-
-	// Conecta ao banco de dados
-	db, err := database.Connect(cfg, log)
-	if err != nil {
-		log.WithError(err).Fatal("Falha ao conectar ao banco de dados")
+	// Subcomando de smoke test pós-deploy: api smoke [--url ...]
+	if len(os.Args) > 1 && os.Args[1] == "smoke" {
+		os.Exit(runSmoke(os.Args[2:]))
 	}
 
-	// Executa as migrações
-	if err := database.Migrate(db, log); err != nil {
-		log.WithError(err).Fatal("Falha ao executar migrações")
+	// Subcomando de comparação dos codificadores JSON: api bench-json [--page-size 100]
+	if len(os.Args) > 1 && os.Args[1] == "bench-json" {
+		os.Exit(runBenchJSON(os.Args[2:]))
 	}
 
-	// Executa o seed de dados iniciais (categoria padrão, etc.)
-	if err := database.Seed(db, log); err != nil {
-		log.WithError(err).Fatal("Falha ao executar seed de dados")
-	}
-
-	// Cria a aplicação Fiber
-	app := fiber.New(fiber.Config{
-		AppName:      "API Produtos v1.0",
-		ErrorHandler: customErrorHandler,
-	})
-
-	// Configura os middlewares
-	middleware.SetupMiddlewares(app, log)
-
-	// Configura as rotas
-	routes.SetupRoutes(app, db, log)
-
-	// Canal para capturar sinais de shutdown
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-
-	// Inicia o servidor em uma goroutine
-	go func() {
-		addr := fmt.Sprintf(":%s", cfg.ServerPort)
-		log.WithField("port", cfg.ServerPort).Info("Servidor iniciado")
-		if err := app.Listen(addr); err != nil {
-			log.WithError(err).Fatal("Erro ao iniciar o servidor")
-		}
-	}()
-
-	// Aguarda sinal de shutdown
-	<-quit
-	log.Info("Encerrando servidor...")
-
-	// Graceful shutdown
-	if err := app.Shutdown(); err != nil {
-		log.WithError(err).Error("Erro ao encerrar servidor")
+	// Flags da inicialização: api [--validate-only]
+	fs := flag.NewFlagSet("api", flag.ContinueOnError)
+	validateOnly := fs.Bool("validate-only", false, "Executa todas as fases da inicialização (configuração, banco, migrações, seed, rotas) sem abrir a porta e encerra")
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		os.Exit(2)
 	}
 
-	log.Info("Servidor encerrado com sucesso")
-}
-
-// customErrorHandler trata erros globais da aplicação
-func customErrorHandler(c *fiber.Ctx, err error) error {
-	code := fiber.StatusInternalServerError
-
-	if e, ok := err.(*fiber.Error); ok {
-		code = e.Code
+	if err := bootstrap.New(bootstrap.Options{ValidateOnly: *validateOnly}).Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Falha na inicialização: %v\n", err)
+		os.Exit(1)
 	}
-
-	return c.Status(code).JSON(fiber.Map{
-		"error": err.Error(),
-	})
 }
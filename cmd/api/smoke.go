@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// smokeClient executa as requisições do smoke test contra uma instância da API
+type smokeClient struct {
+	baseURL    string
+	adminToken string // Token administrativo usado na exclusão definitiva da limpeza
+	client     *http.Client
+	failed     bool
+}
+
+// runSmoke executa o smoke test pós-deploy e retorna o código de saída do processo
+// Uso: api smoke [--url http://localhost:3000] [--timeout 10s] [--admin-token TOKEN]
+func runSmoke(args []string) int {
+	fs := flag.NewFlagSet("smoke", flag.ContinueOnError)
+	defaultURL := os.Getenv("SMOKE_BASE_URL")
+	if defaultURL == "" {
+		defaultURL = fmt.Sprintf("http://localhost:%s", getEnvOrDefault("SERVER_PORT", "3000"))
+	}
+	baseURL := fs.String("url", defaultURL, "URL base da API")
+	timeout := fs.Duration("timeout", 10*time.Second, "Timeout de cada requisição")
+	adminToken := fs.String("admin-token", os.Getenv("ADMIN_TOKEN"), "Token administrativo (X-Admin-Token) usado para remover definitivamente os dados do teste")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	s := &smokeClient{
+		baseURL:    strings.TrimRight(*baseURL, "/"),
+		adminToken: *adminToken,
+		client:     &http.Client{Timeout: *timeout},
+	}
+
+	fmt.Printf("Executando smoke test contra %s\n", s.baseURL)
+
+	s.expect("GET", "/health", nil, http.StatusOK, nil)
+	s.expect("GET", "/readyz", nil, http.StatusOK, nil)
+	s.crudCycle()
+
+	return s.result()
+}
+
+// crudCycle executa o ciclo create → get → update → delete com dados descartáveis
+// Os recursos criados são removidos definitivamente ao final, mesmo em caso de falha
+func (s *smokeClient) crudCycle() {
+	suffix := time.Now().Format("20060102150405.000")
+	suffix = strings.ReplaceAll(suffix, ".", "")
+
+	var categoria struct {
+		ID uint `json:"id"`
+	}
+	s.expect("POST", "/api/v1/categorias", map[string]interface{}{
+		"nome":      "smoke-" + suffix,
+		"descricao": "Categoria temporária do smoke test",
+		"ativo":     true,
+	}, http.StatusCreated, &categoria)
+
+	if categoria.ID != 0 {
+		defer s.cleanup("/api/v1/categorias/%d", categoria.ID)
+
+		var produto struct {
+			ID uint `json:"id"`
+		}
+		s.expect("POST", "/api/v1/produtos", map[string]interface{}{
+			"codigo":       "SMOKE-" + suffix,
+			"descricao":    "Produto temporário do smoke test",
+			"preco":        1.0,
+			"categoria_id": categoria.ID,
+		}, http.StatusCreated, &produto)
+
+		if produto.ID != 0 {
+			defer s.cleanup("/api/v1/produtos/%d", produto.ID)
+
+			path := fmt.Sprintf("/api/v1/produtos/%d", produto.ID)
+			s.expect("GET", path, nil, http.StatusOK, nil)
+
+			var updated struct {
+				Preco float64 `json:"preco"`
+			}
+			s.expect("PUT", path, map[string]interface{}{"preco": 2.0}, http.StatusOK, &updated)
+			if !s.failed && updated.Preco != 2.0 {
+				s.fail("PUT %s: preço esperado 2.00, recebido %.2f", path, updated.Preco)
+			}
+
+			s.expect("DELETE", path, nil, http.StatusOK, nil)
+			s.expect("GET", path, nil, http.StatusGone, nil)
+		}
+	}
+}
+
+// result retorna o código de saída conforme o resultado dos passos
+func (s *smokeClient) result() int {
+	if s.failed {
+		fmt.Println("Smoke test FALHOU")
+		return 1
+	}
+	fmt.Println("Smoke test concluído com sucesso")
+	return 0
+}
+
+// expect executa uma requisição e verifica o status esperado, decodificando a resposta em out
+func (s *smokeClient) expect(method, path string, body interface{}, status int, out interface{}) {
+	start := time.Now()
+
+	code, respBody, err := s.do(method, path, body, nil)
+	if err != nil {
+		s.fail("%s %s: %v", method, path, err)
+		return
+	}
+	if code != status {
+		s.fail("%s %s: status esperado %d, recebido %d: %s", method, path, status, code, strings.TrimSpace(string(respBody)))
+		return
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			s.fail("%s %s: resposta inválida: %v", method, path, err)
+			return
+		}
+	}
+
+	fmt.Printf("[OK]    %s %s (%d, %s)\n", method, path, code, time.Since(start).Round(time.Millisecond))
+}
+
+// cleanup remove definitivamente um recurso criado pelo smoke test: exclusão lógica (ignorada se já estiver
+// na lixeira, 404 ou 410) seguida da exclusão definitiva com o token administrativo, para não deixar
+// registros do teste na lixeira. Falha se a exclusão definitiva não for concluída
+func (s *smokeClient) cleanup(format string, id uint) {
+	path := fmt.Sprintf(format, id)
+	code, respBody, err := s.do("DELETE", path, nil, nil)
+	if err != nil {
+		s.fail("limpeza DELETE %s: %v", path, err)
+		return
+	}
+	if code != http.StatusOK && code != http.StatusNotFound && code != http.StatusGone {
+		s.fail("limpeza DELETE %s: status %d: %s", path, code, strings.TrimSpace(string(respBody)))
+		return
+	}
+
+	purgePath := path + "/definitivo"
+	if s.adminToken == "" {
+		s.fail("limpeza DELETE %s: token administrativo não informado (--admin-token ou ADMIN_TOKEN)", purgePath)
+		return
+	}
+	code, respBody, err = s.do("DELETE", purgePath, nil, http.Header{"X-Admin-Token": {s.adminToken}})
+	if err != nil {
+		s.fail("limpeza DELETE %s: %v", purgePath, err)
+		return
+	}
+	if code != http.StatusOK {
+		s.fail("limpeza DELETE %s: status %d: %s", purgePath, code, strings.TrimSpace(string(respBody)))
+		return
+	}
+	fmt.Printf("[OK]    limpeza DELETE %s (%d)\n", purgePath, code)
+}
+
+// do executa uma requisição HTTP com body JSON e cabeçalhos opcionais
+func (s *smokeClient) do(method, path string, body interface{}, header http.Header) (int, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, s.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// fail registra a falha de um passo
+func (s *smokeClient) fail(format string, args ...interface{}) {
+	s.failed = true
+	fmt.Printf("[FALHA] "+format+"\n", args...)
+}
+
+// getEnvOrDefault retorna o valor da variável de ambiente ou o valor padrão
+func getEnvOrDefault(key, defaultValue string) string {
+	if value, exists := os.LookupEnv(key); exists && value != "" {
+		return value
+	}
+	return defaultValue
+}
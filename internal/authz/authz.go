@@ -0,0 +1,180 @@
+package authz
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	"api_fibergorm/pkg/arquitetura/service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// HeaderUserRoles cabeçalho com os papéis do usuário autenticado, separados por vírgula
+// (repassado pelo gateway/proxy, como o X-User-ID)
+const HeaderUserRoles = "X-User-Roles"
+
+// HeaderAdminToken cabeçalho com o token administrativo (o mesmo das rotas /admin)
+const HeaderAdminToken = "X-Admin-Token"
+
+// RoleAdmin papel de administrador
+const RoleAdmin = "admin"
+
+// rolesKey chave dos papéis no context.Context
+type rolesKey struct{}
+
+// WithRoles retorna um contexto com os papéis do usuário
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// RolesFromContext retorna os papéis do usuário armazenados no contexto
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesKey{}).([]string)
+	return roles
+}
+
+// HasAnyRole indica se o usuário possui ao menos um dos papéis (sem diferenciar maiúsculas)
+func HasAnyRole(ctx context.Context, roles ...string) bool {
+	for _, current := range RolesFromContext(ctx) {
+		for _, role := range roles {
+			if strings.EqualFold(current, role) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Config configurações das fontes dos papéis do usuário
+type Config struct {
+	TrustRoleHeader bool     // Aceita o cabeçalho X-User-Roles (padrão: false; habilite apenas atrás de um gateway que o sobrescreve)
+	TrustedProxies  []string // IPs ou CIDRs autorizados a enviar X-User-Roles (vazio = qualquer origem, quando TrustRoleHeader)
+	AdminToken      string   // Token administrativo: X-Admin-Token válido concede o papel admin (vazio desabilita)
+}
+
+// Middleware disponibiliza no contexto os papéis do usuário (ver RolesFromContext), obtidos de fontes verificadas:
+// o papel admin para o X-Admin-Token válido e, somente com TrustRoleHeader, os papéis do cabeçalho X-User-Roles
+// enviados pelos proxies confiáveis (endereço da conexão, não X-Forwarded-For). Sem configuração, nenhum papel
+// é atribuído e as operações que exigem papéis são negadas
+func Middleware(config Config, log *logrus.Logger) fiber.Handler {
+	proxies := parseProxies(config.TrustedProxies, log)
+
+	return func(c *fiber.Ctx) error {
+		var roles []string
+
+		if config.AdminToken != "" {
+			provided := c.Get(HeaderAdminToken)
+			if provided != "" && subtle.ConstantTimeCompare([]byte(provided), []byte(config.AdminToken)) == 1 {
+				roles = append(roles, RoleAdmin)
+			}
+		}
+
+		if header := c.Get(HeaderUserRoles); header != "" {
+			if config.TrustRoleHeader && trustedSource(proxies, c.Context().RemoteIP()) {
+				for _, role := range strings.Split(header, ",") {
+					if role = strings.TrimSpace(role); role != "" {
+						roles = append(roles, role)
+					}
+				}
+			} else {
+				log.WithFields(logrus.Fields{
+					"request_id": c.Locals("requestid"),
+					"path":       c.Path(),
+					"ip":         c.Context().RemoteIP().String(),
+				}).Debug("Cabeçalho X-User-Roles ignorado (origem não confiável)")
+			}
+		}
+
+		if len(roles) > 0 {
+			c.SetUserContext(WithRoles(c.UserContext(), roles))
+		}
+		return c.Next()
+	}
+}
+
+// parseProxies converte os IPs/CIDRs configurados em redes (entradas inválidas são ignoradas com aviso)
+func parseProxies(entries []string, log *logrus.Logger) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				log.WithField("proxy", entry).Warn("Proxy confiável inválido ignorado")
+				continue
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			log.WithField("proxy", entry).Warn("Proxy confiável inválido ignorado")
+			continue
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// trustedSource indica se a conexão vem de um proxy confiável (sem proxies configurados, qualquer origem)
+func trustedSource(proxies []*net.IPNet, ip net.IP) bool {
+	if len(proxies) == 0 {
+		return true
+	}
+	for _, network := range proxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleAuthorizer autoriza as operações do serviço pelos papéis do usuário (service.Authorizer)
+// Operações sem papéis exigidos são permitidas a todos
+type RoleAuthorizer[E entity.Entity] struct {
+	required map[service.OperationType][]string
+}
+
+// NewRoleAuthorizer cria um autorizador sem restrições
+func NewRoleAuthorizer[E entity.Entity]() *RoleAuthorizer[E] {
+	return &RoleAuthorizer[E]{required: make(map[service.OperationType][]string)}
+}
+
+// Require exige um dos papéis para a operação (ex: Require(service.OperationDelete, authz.RoleAdmin))
+// Sem papéis, a operação continua liberada
+func (a *RoleAuthorizer[E]) Require(operation service.OperationType, roles ...string) *RoleAuthorizer[E] {
+	if len(roles) > 0 {
+		a.required[operation] = roles
+	}
+	return a
+}
+
+func (a *RoleAuthorizer[E]) CanCreate(ctx context.Context, entity E) bool {
+	return a.allowed(ctx, service.OperationCreate)
+}
+
+func (a *RoleAuthorizer[E]) CanRead(ctx context.Context, entity E) bool {
+	return a.allowed(ctx, service.OperationRead)
+}
+
+func (a *RoleAuthorizer[E]) CanUpdate(ctx context.Context, entity E) bool {
+	return a.allowed(ctx, service.OperationUpdate)
+}
+
+func (a *RoleAuthorizer[E]) CanDelete(ctx context.Context, entity E) bool {
+	return a.allowed(ctx, service.OperationDelete)
+}
+
+// allowed verifica se o usuário possui um dos papéis exigidos pela operação
+func (a *RoleAuthorizer[E]) allowed(ctx context.Context, operation service.OperationType) bool {
+	roles, ok := a.required[operation]
+	return !ok || HasAnyRole(ctx, roles...)
+}
@@ -0,0 +1,463 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"api_fibergorm/internal/config"
+	"api_fibergorm/internal/database"
+	"api_fibergorm/internal/health"
+	"api_fibergorm/internal/jobs"
+	"api_fibergorm/internal/logging"
+	"api_fibergorm/internal/metrics"
+	"api_fibergorm/internal/middleware"
+	"api_fibergorm/internal/routes"
+	"api_fibergorm/internal/shutdown"
+	"api_fibergorm/internal/tracker"
+	"api_fibergorm/pkg/arquitetura/entity"
+	"api_fibergorm/pkg/arquitetura/events"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/outbox"
+	"api_fibergorm/pkg/arquitetura/repository"
+	"api_fibergorm/pkg/arquitetura/selfcheck"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Options opções de inicialização da aplicação
+type Options struct {
+	// ValidateOnly executa todas as fases exceto a abertura da porta e encerra
+	// (pré-verificação em pipelines de CD: configuração, conexões, migrações e rotas)
+	ValidateOnly bool
+}
+
+// Phase representa uma fase da inicialização
+type Phase struct {
+	Name string
+	Run  func() error
+}
+
+// Bootstrap conduz a inicialização da aplicação em fases explícitas, cada uma cronometrada e registrada no log
+type Bootstrap struct {
+	options Options
+	started time.Time
+
+	cfg *config.Config
+	log *logrus.Logger
+	db  *gorm.DB
+	rdb *redis.Client
+	app *fiber.App
+
+	relay *outbox.Relay // Relay do outbox, agendado em listen (nil se desabilitado)
+}
+
+// New cria uma nova inicialização
+func New(options Options) *Bootstrap {
+	return &Bootstrap{
+		options: options,
+		started: time.Now(),
+	}
+}
+
+// Run executa as fases de inicialização e, exceto em ValidateOnly, atende as requisições até
+// receber SIGINT/SIGTERM. Retorna o erro da fase que falhou
+func (b *Bootstrap) Run() error {
+	// Configuração e logger precedem o log: suas durações são registradas assim que o logger existe
+	start := time.Now()
+	b.cfg = config.Load()
+	configDuration := time.Since(start)
+
+	start = time.Now()
+	b.log = config.SetupLogger(b.cfg.LogLevel)
+	b.logPhase("config", configDuration)
+	b.logPhase("logger", time.Since(start))
+
+	b.log.WithField("validate_only", b.options.ValidateOnly).Info("Iniciando API de Produtos - POC Fiber + GORM")
+
+	phases := []Phase{
+		{Name: "database", Run: b.connectDatabase},
+		{Name: "migrations", Run: b.migrate},
+		{Name: "seed", Run: b.seed},
+		{Name: "dependencies", Run: b.connectDependencies},
+		{Name: "routes", Run: b.setupRoutes},
+	}
+	for _, phase := range phases {
+		if err := b.runPhase(phase); err != nil {
+			b.close()
+			return err
+		}
+	}
+
+	b.log.WithField("duration", time.Since(b.started).String()).Info("Inicialização concluída")
+
+	if b.options.ValidateOnly {
+		b.log.Info("Validação concluída com sucesso (--validate-only): servidor não iniciado")
+		b.close()
+		return nil
+	}
+
+	return b.listen()
+}
+
+// runPhase executa uma fase registrando sua duração ou o erro
+func (b *Bootstrap) runPhase(phase Phase) error {
+	start := time.Now()
+	if err := phase.Run(); err != nil {
+		b.log.WithError(err).WithFields(logrus.Fields{
+			"phase":    phase.Name,
+			"duration": time.Since(start).String(),
+		}).Error("Falha na fase de inicialização")
+		return fmt.Errorf("fase %s: %w", phase.Name, err)
+	}
+	b.logPhase(phase.Name, time.Since(start))
+	return nil
+}
+
+// logPhase registra a conclusão de uma fase
+func (b *Bootstrap) logPhase(name string, duration time.Duration) {
+	b.log.WithFields(logrus.Fields{
+		"phase":    name,
+		"duration": duration.String(),
+	}).Info("Fase de inicialização concluída")
+}
+
+// connectDatabase conecta ao banco de dados e configura os repositórios e o rastreador de erros
+func (b *Bootstrap) connectDatabase() error {
+	// Rastreador de erros (panics); os envios pendentes são aguardados no encerramento
+	tracker.Setup(b.cfg.ErrorTrackerURL, time.Duration(b.cfg.ErrorTrackerTimeout)*time.Second, b.log)
+	shutdown.Default().Register("error-tracker", tracker.Default().Flush)
+
+	db, err := database.Connect(b.cfg, b.log)
+	if err != nil {
+		return err
+	}
+	b.db = db
+
+	// Opções padrão dos repositórios
+	repository.SetDefaultOptions(repository.Options{
+		ParallelCount:          b.cfg.DBParallelCount,
+		SkipDefaultTransaction: b.cfg.DBSkipDefaultTx,
+		Retry: repository.RetryPolicy{
+			MaxAttempts: b.cfg.DBRetryAttempts,
+			BaseDelay:   time.Duration(b.cfg.DBRetryBaseDelay) * time.Millisecond,
+			MaxDelay:    repository.DefaultRetryPolicy.MaxDelay,
+		},
+	})
+	return nil
+}
+
+// migrate executa as migrações (apenas no processo principal em modo prefork)
+func (b *Bootstrap) migrate() error {
+	if fiber.IsChild() {
+		return nil
+	}
+	return database.Migrate(b.db, b.log)
+}
+
+// seed executa o seed de dados iniciais (apenas no processo principal em modo prefork)
+func (b *Bootstrap) seed() error {
+	if fiber.IsChild() {
+		return nil
+	}
+	return database.Seed(b.db, b.log)
+}
+
+// connectDependencies conecta ao Redis (opcional) e ao broker do outbox, prepara os jobs e registra os health
+// checks das dependências. Nenhum job é agendado aqui: com --validate-only nada é executado em segundo plano
+func (b *Bootstrap) connectDependencies() error {
+	rdb, err := database.ConnectRedis(b.cfg, b.log)
+	if err != nil {
+		return err
+	}
+	b.rdb = rdb
+
+	// Jobs em segundo plano (interrompidos no encerramento, antes do fechamento do banco)
+	// Apenas no processo principal em modo prefork, como migrações e seed; agendados em listen (startJobs)
+	if !fiber.IsChild() {
+		jobs.Setup(b.log)
+		shutdown.Default().Register("jobs", jobs.Default().Stop)
+
+		// Conexão com o broker dos eventos de integração gravados no outbox (OUTBOX_BROKER)
+		if err := b.connectOutbox(); err != nil {
+			return err
+		}
+	}
+
+	// Health checks das dependências (agregados no /readyz)
+	// O banco é crítico; Redis e Loki possuem alternativa (memória/stdout) e apenas degradam a aplicação
+	health.Setup("api_fibergorm", time.Duration(b.cfg.HealthCheckTimeout)*time.Second, metrics.RecordHealth)
+	health.Default().Register("database", true, func(ctx context.Context) error {
+		sqlDB, err := b.db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+	if b.rdb != nil {
+		health.Default().Register("redis", false, func(ctx context.Context) error {
+			return b.rdb.Ping(ctx).Err()
+		})
+	}
+	if lokiHook := logging.FindLokiHook(b.log); lokiHook != nil {
+		health.Default().Register("loki", false, lokiHook.Check)
+	}
+	return nil
+}
+
+// connectOutbox conecta ao broker e prepara o relay das mensagens do outbox (agendado em startJobs)
+// O publicador é fechado no encerramento, após a parada dos jobs (registrada antes)
+func (b *Bootstrap) connectOutbox() error {
+	outboxConfig := b.cfg.Outbox()
+	if !outboxConfig.Enabled() {
+		b.log.Debug("Outbox desabilitado (OUTBOX_BROKER=none)")
+		return nil
+	}
+
+	publisher, err := outbox.NewPublisher(outboxConfig)
+	if err != nil {
+		return fmt.Errorf("falha ao configurar o broker do outbox: %w", err)
+	}
+	shutdown.Default().Register("outbox-publisher", func(ctx context.Context) error {
+		return publisher.Close()
+	})
+
+	if pinger, ok := publisher.(outbox.Pinger); ok {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(b.cfg.HealthCheckTimeout)*time.Second)
+		defer cancel()
+		if err := pinger.Ping(ctx); err != nil {
+			return fmt.Errorf("falha ao conectar ao broker do outbox: %w", err)
+		}
+	}
+
+	b.relay = outbox.NewRelay(b.db, publisher, arqlogging.NewLogrus(b.log)).
+		WithBatchSize(b.cfg.OutboxBatchSize).
+		WithMaxAttempts(b.cfg.OutboxMaxAttempts).
+		WithRetention(time.Duration(b.cfg.OutboxRetentionHours) * time.Hour)
+	return nil
+}
+
+// startJobs agenda os jobs em segundo plano (fase listeners; não executado com --validate-only)
+// Em modo prefork apenas o processo principal possui jobs (o relay não é preparado nos filhos)
+func (b *Bootstrap) startJobs() {
+	if b.relay == nil {
+		return
+	}
+
+	// Publicação das mensagens do outbox e limpeza das publicadas
+	jobs.Default().Schedule("outbox-relay", time.Duration(b.cfg.OutboxPollInterval)*time.Millisecond, b.relay.RunOnce)
+	jobs.Default().Schedule("outbox-cleanup", time.Hour, b.relay.Purge)
+	b.log.WithField("broker", b.cfg.Outbox().Broker).Info("Publicação do outbox iniciada")
+}
+
+// setupRoutes cria a aplicação Fiber com os middlewares e as rotas
+func (b *Bootstrap) setupRoutes() error {
+	// Codificador JSON usado nas respostas e no parse dos bodies
+	codec, err := jsoncodec.Get(b.cfg.JSONCodec)
+	if err != nil {
+		return fmt.Errorf("configuração JSON_CODEC inválida: %w", err)
+	}
+	codec, err = jsoncodec.WithNaming(codec, b.cfg.JSONNaming)
+	if err != nil {
+		return fmt.Errorf("configuração JSON_NAMING inválida: %w", err)
+	}
+	b.log.WithFields(logrus.Fields{
+		"codec":  codec.Name,
+		"naming": b.cfg.JSONNaming,
+	}).Info("Codificador JSON configurado")
+
+	// Formato e fuso das datas nas respostas (entidades e mappers)
+	location, err := time.LoadLocation(b.cfg.TimeZone)
+	if err != nil {
+		return fmt.Errorf("configuração TIME_ZONE inválida: %w", err)
+	}
+	entity.SetTimeFormat(b.cfg.TimeFormat, location)
+
+	// Formato das respostas de erro; no formato problem o type aponta para o catálogo de códigos
+	if err := arqhandler.SetErrorFormat(b.cfg.ErrorFormat, "/api/v1/erros#"); err != nil {
+		return fmt.Errorf("configuração ERROR_FORMAT inválida: %w", err)
+	}
+
+	// Em modo prefork o Fiber inicia um processo filho por GOMAXPROCS
+	if b.cfg.Prefork && b.cfg.PreforkWorkers > 0 && !fiber.IsChild() {
+		runtime.GOMAXPROCS(b.cfg.PreforkWorkers)
+	}
+
+	// O BodyLimit do servidor é o maior limite permitido (uploads); limites menores são aplicados por rota
+	b.app = fiber.New(fiber.Config{
+		AppName:      "API Produtos v1.0",
+		ErrorHandler: b.errorHandler,
+		BodyLimit:    b.cfg.BodyLimitUploadMB * 1024 * 1024,
+		JSONEncoder:  codec.Marshal,
+		JSONDecoder:  codec.Unmarshal,
+		Prefork:      b.cfg.Prefork,
+	})
+
+	// Panics dos assinantes de eventos de entidade são registrados no log da aplicação
+	// As entregas em andamento são aguardadas no encerramento
+	events.Default().WithLogger(arqlogging.NewLogrus(b.log))
+	shutdown.Default().Register("events", events.Default().Close)
+
+	middleware.SetupMiddlewares(b.app, b.cfg, b.rdb, b.log)
+	if err := routes.SetupRoutes(b.app, b.db, b.rdb, b.cfg, b.log); err != nil {
+		return err
+	}
+
+	// Verifica a montagem dos serviços (entidade, tabela, mapper e validador) antes de receber tráfego
+	if err := selfcheck.Default().Run(); err != nil {
+		return err
+	}
+
+	b.log.WithField("handlers", b.app.HandlersCount()).Debug("Rotas registradas")
+	return nil
+}
+
+// listen inicia o servidor (fase listeners) e aguarda o sinal de encerramento
+func (b *Bootstrap) listen() error {
+	// Canal para capturar sinais de shutdown
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	if err := b.startMetrics(); err != nil {
+		b.close()
+		return fmt.Errorf("fase listeners: %w", err)
+	}
+	b.startJobs()
+
+	// Inicia o servidor em uma goroutine
+	listenErr := make(chan error, 1)
+	go func() {
+		addr := fmt.Sprintf(":%s", b.cfg.ServerPort)
+		b.log.WithFields(logrus.Fields{
+			"phase": "listeners",
+			"port":  b.cfg.ServerPort,
+		}).Info("Servidor iniciado")
+		listenErr <- b.app.Listen(addr)
+	}()
+
+	// Aguarda sinal de shutdown ou falha ao abrir a porta
+	select {
+	case <-quit:
+		b.log.Info("Encerrando servidor...")
+	case err := <-listenErr:
+		b.log.WithError(err).Error("Erro ao iniciar o servidor")
+		b.close()
+		return fmt.Errorf("fase listeners: %w", err)
+	}
+
+	b.shutdown()
+	return nil
+}
+
+// startMetrics abre a porta própria das métricas (METRICS_PORT), encerrada com os componentes
+// Em prefork o processo principal agrega as métricas dos filhos, que as expõem em sockets unix
+func (b *Bootstrap) startMetrics() error {
+	if fiber.IsChild() {
+		closeChild, err := metrics.ServeChild(b.log)
+		if err != nil {
+			return err
+		}
+		shutdown.Default().Register("metrics", closeChild)
+		return nil
+	}
+	if b.cfg.MetricsPort == "" {
+		return nil
+	}
+
+	listener := metrics.NewListener(b.cfg.MetricsPort, b.cfg.Prefork, b.log)
+	if err := listener.Start(); err != nil {
+		return err
+	}
+	shutdown.Default().Register("metrics", listener.Close)
+	return nil
+}
+
+// shutdown encerra a aplicação em etapas, em ordem, dentro de SHUTDOWN_TIMEOUT
+// 1. HTTP: para de aceitar conexões e aguarda as requisições em andamento
+// 2. Componentes registrados em shutdown.Default() (jobs, barramento de eventos)
+// 3. Redis e banco de dados, após não haver mais trabalho em andamento
+// 4. Logs pendentes (Loki), por último para incluir os logs das etapas anteriores
+func (b *Bootstrap) shutdown() {
+	timeout := time.Duration(b.cfg.ShutdownTimeout) * time.Second
+	steps := &shutdown.Sequence{}
+	steps.Register("http", func(ctx context.Context) error {
+		return b.app.ShutdownWithTimeout(remaining(ctx))
+	})
+	steps.Register("components", func(ctx context.Context) error {
+		shutdown.Default().Run(remaining(ctx), b.log)
+		return nil
+	})
+	b.registerResourceSteps(steps)
+	steps.Run(timeout, b.log)
+
+	b.log.Info("Servidor encerrado com sucesso")
+	logging.CloseHooks(b.log)
+}
+
+// close libera os recursos já abertos quando o servidor não chegou a atender requisições
+// (falha de inicialização ou --validate-only)
+func (b *Bootstrap) close() {
+	steps := &shutdown.Sequence{}
+	steps.Register("components", func(ctx context.Context) error {
+		shutdown.Default().Run(remaining(ctx), b.log)
+		return nil
+	})
+	b.registerResourceSteps(steps)
+	steps.Run(time.Duration(b.cfg.ShutdownTimeout)*time.Second, b.log)
+	logging.CloseHooks(b.log)
+}
+
+// registerResourceSteps registra o fechamento das conexões abertas (Redis e banco de dados)
+func (b *Bootstrap) registerResourceSteps(steps *shutdown.Sequence) {
+	if b.rdb != nil {
+		steps.Register("redis", func(ctx context.Context) error {
+			return b.rdb.Close()
+		})
+	}
+	if b.db != nil {
+		steps.Register("database", func(ctx context.Context) error {
+			sqlDB, err := b.db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.Close()
+		})
+	}
+}
+
+// remaining retorna o tempo restante até o prazo do contexto
+func remaining(ctx context.Context) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return 0
+}
+
+// errorHandler trata erros globais da aplicação (roteamento, middlewares e erros não tratados pelos handlers)
+// A resposta segue o mesmo formato do HandleError dos handlers (arqhandler.ErrorResponseFor)
+func (b *Bootstrap) errorHandler(c *fiber.Ctx, err error) error {
+	status, resp := arqhandler.ErrorResponseFor(c, err)
+
+	// Body acima do limite do servidor: mesma resposta padronizada do limite por rota
+	if status == fiber.StatusRequestEntityTooLarge {
+		return middleware.RequestTooLarge(c, 0)
+	}
+
+	if status >= fiber.StatusInternalServerError {
+		b.log.WithError(err).WithFields(logrus.Fields{
+			"method":     c.Method(),
+			"path":       c.Path(),
+			"request_id": arqhandler.RequestID(c),
+		}).Error("Erro não tratado na requisição")
+	}
+	return arqhandler.SendError(c, status, resp)
+}
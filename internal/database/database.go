@@ -2,15 +2,20 @@ package database
 
 import (
 	"fmt"
+	"net"
 	"time"
 
 	"api_fibergorm/internal/config"
 	"api_fibergorm/internal/models"
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/outbox"
+	"api_fibergorm/pkg/arquitetura/repository"
 
 	"github.com/sirupsen/logrus"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/dbresolver"
 )
 
 // Connect estabelece conexão com o banco de dados PostgreSQL
@@ -21,15 +26,7 @@ func Connect(cfg *config.Config, log *logrus.Logger) (*gorm.DB, error) {
 	}
 
 	// Conecta ao banco de dados da aplicação
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		cfg.DBHost,
-		cfg.DBPort,
-		cfg.DBUser,
-		cfg.DBPassword,
-		cfg.DBName,
-		cfg.DBSSLMode,
-	)
+	dsn := buildDSN(cfg, cfg.DBHost, cfg.DBPort)
 
 	log.WithFields(logrus.Fields{
 		"host": cfg.DBHost,
@@ -45,6 +42,12 @@ func Connect(cfg *config.Config, log *logrus.Logger) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// Preenche created_by/updated_by das entidades auditadas com o usuário da requisição
+	if err := audit.RegisterCallbacks(db); err != nil {
+		log.WithError(err).Error("Falha ao registrar os callbacks de auditoria")
+		return nil, err
+	}
+
 	// Configura o pool de conexões
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -62,10 +65,61 @@ func Connect(cfg *config.Config, log *logrus.Logger) (*gorm.DB, error) {
 		"conn_max_lifetime": fmt.Sprintf("%dm", cfg.DBConnMaxLifetime),
 	}).Info("Pool de conexões configurado")
 
+	// Réplicas de leitura: consultas fora de transação vão para as réplicas e escritas para o primário
+	if err := registerReplicas(db, cfg, log); err != nil {
+		log.WithError(err).Error("Falha ao configurar as réplicas de leitura")
+		return nil, err
+	}
+
 	log.Info("Conexão com o banco de dados estabelecida com sucesso")
 	return db, nil
 }
 
+// registerReplicas registra as réplicas de DB_REPLICA_HOSTS no dbresolver (nada a fazer sem réplicas)
+// Transações e escritas usam sempre o primário; repositórios que precisam ler o que acabaram de gravar
+// usam WithPrimary. As réplicas compartilham usuário, senha, banco e os limites do pool do primário
+func registerReplicas(db *gorm.DB, cfg *config.Config, log *logrus.Logger) error {
+	if len(cfg.DBReplicaHosts) == 0 {
+		return nil
+	}
+
+	replicas := make([]gorm.Dialector, 0, len(cfg.DBReplicaHosts))
+	for _, replica := range cfg.DBReplicaHosts {
+		host, port, err := net.SplitHostPort(replica)
+		if err != nil {
+			host, port = replica, cfg.DBPort
+		}
+		replicas = append(replicas, postgres.Open(buildDSN(cfg, host, port)))
+	}
+
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	}).
+		SetMaxOpenConns(cfg.DBMaxOpenConns).
+		SetMaxIdleConns(cfg.DBMaxIdleConns).
+		SetConnMaxLifetime(time.Duration(cfg.DBConnMaxLifetime) * time.Minute)
+	if err := db.Use(resolver); err != nil {
+		return err
+	}
+
+	log.WithField("replicas", cfg.DBReplicaHosts).Info("Réplicas de leitura configuradas")
+	return nil
+}
+
+// buildDSN monta a DSN do banco da aplicação para o host informado
+func buildDSN(cfg *config.Config, host, port string) string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		host,
+		port,
+		cfg.DBUser,
+		cfg.DBPassword,
+		cfg.DBName,
+		cfg.DBSSLMode,
+	)
+}
+
 // createDatabaseIfNotExists conecta ao postgres e cria o banco se não existir
 func createDatabaseIfNotExists(cfg *config.Config, log *logrus.Logger) error {
 	// Conecta ao banco postgres padrão para criar o banco da aplicação
@@ -120,6 +174,18 @@ func createDatabaseIfNotExists(cfg *config.Config, log *logrus.Logger) error {
 func Migrate(db *gorm.DB, log *logrus.Logger) error {
 	log.Info("Executando migrações do banco de dados")
 
+	// Trilha de auditoria (independente das entidades)
+	if err := db.AutoMigrate(&audit.Entry{}); err != nil {
+		log.WithError(err).Error("Falha ao migrar tabela de auditoria")
+		return err
+	}
+
+	// Outbox dos eventos de integração (independente das entidades)
+	if err := db.AutoMigrate(&outbox.Message{}); err != nil {
+		log.WithError(err).Error("Falha ao migrar tabela do outbox")
+		return err
+	}
+
 	// Passo 1: Migra a tabela de categorias primeiro
 	if err := db.AutoMigrate(&models.Categoria{}); err != nil {
 		log.WithError(err).Error("Falha ao migrar tabela de categorias")
@@ -186,7 +252,7 @@ func Migrate(db *gorm.DB, log *logrus.Logger) error {
 			}
 
 			log.Info("Migração especial concluída")
-			return nil
+			return migrateProdutoDependents(db, log)
 		}
 	}
 
@@ -196,6 +262,26 @@ func Migrate(db *gorm.DB, log *logrus.Logger) error {
 		return err
 	}
 
+	// Índices da busca textual de produtos (tsvector e trigram)
+	if err := repository.EnsureSearchIndexes(db, &models.Produto{}); err != nil {
+		log.WithError(err).Error("Falha ao criar índices de busca de produtos")
+		return err
+	}
+
+	// Passo 5: Tabelas dependentes de produtos
+	if err := migrateProdutoDependents(db, log); err != nil {
+		return err
+	}
+
 	log.Info("Migrações executadas com sucesso")
 	return nil
 }
+
+// migrateProdutoDependents migra as tabelas que referenciam produtos (estoque e histórico de preços)
+func migrateProdutoDependents(db *gorm.DB, log *logrus.Logger) error {
+	if err := db.AutoMigrate(&models.Estoque{}, &models.PrecoHistorico{}); err != nil {
+		log.WithError(err).Error("Falha ao migrar tabelas de estoque e histórico de preços")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api_fibergorm/internal/config"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// ConnectRedis estabelece conexão com o Redis
+// Retorna nil (sem erro) quando REDIS_URL não está configurada
+func ConnectRedis(cfg *config.Config, log *logrus.Logger) (*redis.Client, error) {
+	if cfg.RedisURL == "" {
+		log.Debug("Redis não configurado")
+		return nil, nil
+	}
+
+	opts, err := redis.ParseURL(cfg.RedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("REDIS_URL inválida: %w", err)
+	}
+
+	client := redis.NewClient(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, fmt.Errorf("falha ao conectar ao Redis: %w", err)
+	}
+
+	log.WithFields(logrus.Fields{
+		"addr": opts.Addr,
+		"db":   opts.DB,
+	}).Info("Conexão com o Redis estabelecida com sucesso")
+
+	return client, nil
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // CreateCategoriaRequest representa o payload para criação de uma categoria
 // @Description Dados para criação de uma nova categoria
 type CreateCategoriaRequest struct {
@@ -20,23 +22,36 @@ type UpdateCategoriaRequest struct {
 // @Description Dados de resposta de uma categoria
 type CategoriaResponse struct {
 	ID        uint   `json:"id" example:"1"`
+	PublicID  string `json:"public_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
 	Nome      string `json:"nome" example:"Eletrônicos"`
 	Descricao string `json:"descricao" example:"Produtos eletrônicos em geral"`
 	Ativo     bool   `json:"ativo" example:"true"`
-	CreatedAt string `json:"created_at" example:"2024-01-01 10:00:00"`
-	UpdatedAt string `json:"updated_at" example:"2024-01-01 10:00:00"`
+	CreatedAt string `json:"created_at" example:"2024-01-01T10:00:00Z"`
+	UpdatedAt string `json:"updated_at" example:"2024-01-01T10:00:00Z"`
+
+	// Data da última alteração (não serializada) para Last-Modified
+	UpdatedAtTime time.Time `json:"-" swaggerignore:"true" mapper:"UpdatedAt"`
+}
+
+// LastModified retorna a data da última alteração da categoria
+func (r *CategoriaResponse) LastModified() time.Time {
+	return r.UpdatedAtTime
 }
 
 // CategoriaWithProdutosResponse representa uma categoria com seus produtos
 // @Description Dados de resposta de uma categoria com lista de produtos
 type CategoriaWithProdutosResponse struct {
 	ID        uint                    `json:"id" example:"1"`
+	PublicID  string                  `json:"public_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
 	Nome      string                  `json:"nome" example:"Eletrônicos"`
 	Descricao string                  `json:"descricao" example:"Produtos eletrônicos em geral"`
 	Ativo     bool                    `json:"ativo" example:"true"`
-	CreatedAt string                  `json:"created_at" example:"2024-01-01 10:00:00"`
-	UpdatedAt string                  `json:"updated_at" example:"2024-01-01 10:00:00"`
+	CreatedAt string                  `json:"created_at" example:"2024-01-01T10:00:00Z"`
+	UpdatedAt string                  `json:"updated_at" example:"2024-01-01T10:00:00Z"`
 	Produtos  []ProdutoSimpleResponse `json:"produtos"`
+
+	// Total de produtos da categoria (preenchido nas listagens, onde Produtos é limitado)
+	ProdutosTotal *int64 `json:"produtos_total,omitempty" example:"42"`
 }
 
 // ProdutoSimpleResponse representa uma resposta simplificada de produto (sem categoria aninhada)
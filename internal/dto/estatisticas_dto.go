@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// EstatisticasResponse representa os totais agregados exibidos no painel administrativo
+// @Description Estatísticas agregadas de categorias e produtos
+type EstatisticasResponse struct {
+	Categorias           CategoriasEstatisticas     `json:"categorias"`
+	Produtos             ProdutosEstatisticas       `json:"produtos"`
+	ProdutosPorCategoria []ProdutosPorCategoriaItem `json:"produtos_por_categoria"`
+	CriadosPorDia        []CriadosPorDiaItem        `json:"criados_por_dia"`
+	GeradoEm             time.Time                  `json:"gerado_em" example:"2024-01-31T10:00:00Z"`
+}
+
+// CategoriasEstatisticas representa os totais de categorias
+// @Description Totais de categorias ativas e inativas
+type CategoriasEstatisticas struct {
+	Total    int64 `json:"total" example:"12"`
+	Ativas   int64 `json:"ativas" example:"10"`
+	Inativas int64 `json:"inativas" example:"2"`
+}
+
+// ProdutosEstatisticas representa os totais e preços dos produtos
+// @Description Total de produtos e resumo dos preços
+type ProdutosEstatisticas struct {
+	Total      int64   `json:"total" example:"250"`
+	PrecoMedio float64 `json:"preco_medio" example:"149.90"`
+	PrecoMin   float64 `json:"preco_min" example:"9.90"`
+	PrecoMax   float64 `json:"preco_max" example:"4999.00"`
+}
+
+// ProdutosPorCategoriaItem representa a quantidade e o preço médio dos produtos de uma categoria
+// @Description Quantidade e preço médio dos produtos de uma categoria
+type ProdutosPorCategoriaItem struct {
+	CategoriaID uint    `json:"categoria_id" example:"1"`
+	Nome        string  `json:"nome" example:"Eletrônicos"`
+	Ativo       bool    `json:"ativo" example:"true"`
+	Total       int64   `json:"total" example:"42"`
+	PrecoMedio  float64 `json:"preco_medio" example:"149.90"`
+}
+
+// CriadosPorDiaItem representa a quantidade de registros criados em um dia
+// @Description Registros criados em um dia
+type CriadosPorDiaItem struct {
+	Data       string `json:"data" example:"2024-01-31"`
+	Categorias int64  `json:"categorias" example:"1"`
+	Produtos   int64  `json:"produtos" example:"8"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // CreateProdutoRequest representa o payload para criação de um produto
 // @Description Dados para criação de um novo produto
 type CreateProdutoRequest struct {
@@ -9,26 +11,39 @@ type CreateProdutoRequest struct {
 	CategoriaID uint    `json:"categoria_id" validate:"required,gt=0" example:"1"`
 }
 
-// UpdateProdutoRequest representa o payload para atualização de um produto
+// UpdateProdutoRequest representa o payload para atualização de um produto (PUT e PATCH)
+// Os campos são ponteiros para diferenciar o campo ausente (nil) do valor informado, inclusive zero:
+// no PUT apenas os campos informados são aplicados; no PATCH, null limpa a descrição
 // @Description Dados para atualização de um produto existente
 type UpdateProdutoRequest struct {
-	Codigo      string  `json:"codigo" validate:"omitempty,min=1,max=50" example:"PROD001"`
-	Descricao   string  `json:"descricao" validate:"omitempty,min=3,max=255" example:"Produto Atualizado"`
-	Preco       float64 `json:"preco" validate:"omitempty,gt=0" example:"149.90"`
-	CategoriaID uint    `json:"categoria_id" validate:"omitempty,gt=0" example:"2"`
+	Codigo      *string  `json:"codigo" validate:"omitempty,min=1,max=50" example:"PROD001"`
+	Descricao   *string  `json:"descricao" validate:"omitempty,max=255" example:"Produto Atualizado"`
+	Preco       *float64 `json:"preco" validate:"omitempty,gt=0" example:"149.90"`
+	CategoriaID *uint    `json:"categoria_id" validate:"omitempty,gt=0" example:"2"`
 }
 
 // ProdutoResponse representa a resposta de um produto
 // @Description Dados de resposta de um produto
 type ProdutoResponse struct {
 	ID        uint    `json:"id" example:"1"`
+	PublicID  string  `json:"public_id" example:"3b241101-e2bb-4255-8caf-4136c566a962"`
 	Codigo    string  `json:"codigo" example:"PROD001"`
 	Descricao string  `json:"descricao" example:"Produto de Exemplo"`
 	Preco     float64 `json:"preco" example:"99.90"`
-	CreatedAt string  `json:"created_at" example:"2024-01-01 10:00:00"`
-	UpdatedAt string  `json:"updated_at" example:"2024-01-01 10:00:00"`
+	CreatedAt string  `json:"created_at" example:"2024-01-01T10:00:00Z"`
+	UpdatedAt string  `json:"updated_at" example:"2024-01-01T10:00:00Z"`
+	CreatedBy string  `json:"created_by,omitempty" example:"maria"`
+	UpdatedBy string  `json:"updated_by,omitempty" example:"joao"`
 
 	// Dados da categoria associada
 	CategoriaID uint               `json:"categoria_id" example:"1"`
 	Categoria   *CategoriaResponse `json:"categoria,omitempty"`
+
+	// Data da última alteração (não serializada) para Last-Modified
+	UpdatedAtTime time.Time `json:"-" swaggerignore:"true" mapper:"UpdatedAt"`
+}
+
+// LastModified retorna a data da última alteração do produto
+func (r *ProdutoResponse) LastModified() time.Time {
+	return r.UpdatedAtTime
 }
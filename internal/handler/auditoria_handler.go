@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"api_fibergorm/internal/service"
+	"api_fibergorm/pkg/arquitetura/audit"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// AuditoriaHandler gerencia as requisições HTTP da consulta à trilha de auditoria
+type AuditoriaHandler struct {
+	auditoriaService service.AuditoriaService
+	log              *logrus.Logger
+}
+
+// NewAuditoriaHandler cria uma nova instância do handler de auditoria
+func NewAuditoriaHandler(s service.AuditoriaService, log *logrus.Logger) *AuditoriaHandler {
+	return &AuditoriaHandler{
+		auditoriaService: s,
+		log:              log,
+	}
+}
+
+// List godoc
+// @Summary Consultar trilha de auditoria
+// @Description Retorna as operações registradas na auditoria de todas as entidades (quem, quando e o que mudou), do mais recente para o mais antigo
+// @Tags Auditoria
+// @Accept json
+// @Produce json
+// @Param entity query string false "Entidade" Enums(categorias, produtos)
+// @Param entity_id query int false "ID do registro (requer entity)"
+// @Param actor query string false "Usuário que realizou a operação (header X-User-ID)"
+// @Param request_id query string false "Request ID da operação"
+// @Param field query string false "Apenas operações que alteraram o campo (ex: preco)"
+// @Param operation query string false "Operação" Enums(create, update, delete, restore, delete_permanently)
+// @Param from query string false "A partir da data (RFC3339 ou AAAA-MM-DD)"
+// @Param to query string false "Até a data (RFC3339 ou AAAA-MM-DD)"
+// @Param page query int false "Número da página" default(1)
+// @Param page_size query int false "Tamanho da página" default(10)
+// @Success 200 {object} arqdto.PaginatedResponse[audit.LogResponse]
+// @Failure 400 {object} arqdto.ErrorResponse
+// @Failure 500 {object} arqdto.ErrorResponse
+// @Router /api/v1/auditoria [get]
+func (h *AuditoriaHandler) List(c *fiber.Ctx) error {
+	filter, err := arqhandler.ParseHistoryFilter(c)
+	if err != nil {
+		return err
+	}
+	query := audit.Query{
+		Filter:    filter,
+		Actor:     c.Query("actor"),
+		RequestID: c.Query("request_id"),
+		Field:     c.Query("field"),
+	}
+
+	if value := c.Query("entity"); value != "" {
+		if !slices.Contains(service.AuditoriaEntidades, value) {
+			return fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "entity", "values": strings.Join(service.AuditoriaEntidades, ", ")}))
+		}
+		query.EntityName = value
+	}
+
+	if value := c.Query("entity_id"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || id == 0 || len(value) > arqhandler.MaxIDLength {
+			return fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidIDParam, i18n.Params{"param": "entity_id", "max": arqhandler.MaxIDLength}))
+		}
+		if query.EntityName == "" {
+			return fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgRequiredParameter, i18n.Params{"param": "entity"}))
+		}
+		query.EntityID = uint(id)
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	ctx := c.UserContext()
+	result, err := h.auditoriaService.List(ctx, query, page, pageSize)
+	if err != nil {
+		return err
+	}
+
+	return arqhandler.SendPaginated(c, result)
+}
+
+// RegisterRoutes registra as rotas de auditoria
+func (h *AuditoriaHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/", h.List)
+}
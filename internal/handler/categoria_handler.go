@@ -1,11 +1,11 @@
 package handler
 
 import (
-	"context"
-
 	"api_fibergorm/internal/dto"
+	"api_fibergorm/internal/messages"
 	"api_fibergorm/internal/service"
 	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
@@ -20,9 +20,12 @@ type CategoriaHandler struct {
 
 // NewCategoriaHandler cria uma nova instância do handler de categorias
 func NewCategoriaHandler(s service.CategoriaService, log *logrus.Logger) *CategoriaHandler {
-	config := arqhandler.DefaultHandlerConfig("Categoria")
+	config := arqhandler.DefaultHandlerConfig(messages.EntityCategoria)
+	config.FilterableFields = []string{"nome", "descricao", "ativo"}
+	config.SortableFields = []string{"id", "nome", "created_at", "updated_at"}
+	config.SelectableFields = []string{"id", "public_id", "nome", "descricao", "ativo", "created_at", "updated_at"}
 
-	baseHandler := arqhandler.NewBaseHandler(s, log, config)
+	baseHandler := arqhandler.NewBaseHandler(s, arqlogging.NewLogrus(log), config)
 
 	return &CategoriaHandler{
 		BaseHandlerImpl:  baseHandler,
@@ -48,13 +51,13 @@ func (h *CategoriaHandler) GetByIDWithProdutos(c *fiber.Ctx) error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := c.UserContext()
 	categoria, err := h.categoriaService.GetByIDWithProdutos(ctx, id)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(categoria)
+	return arqhandler.SendResponse(c, categoria)
 }
 
 // GetAllActive godoc
@@ -67,20 +70,54 @@ func (h *CategoriaHandler) GetByIDWithProdutos(c *fiber.Ctx) error {
 // @Failure 500 {object} arqdto.ErrorResponse
 // @Router /api/v1/categorias/ativas [get]
 func (h *CategoriaHandler) GetAllActive(c *fiber.Ctx) error {
-	ctx := context.Background()
+	ctx := c.UserContext()
 	response, err := h.categoriaService.GetAllActive(ctx)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(response)
+	return arqhandler.SendResponse(c, response)
+}
+
+// GetAllWithProdutos godoc
+// @Summary Listar categorias com produtos
+// @Description Retorna uma lista paginada de categorias com uma prévia limitada de seus produtos e o total de produtos de cada uma
+// @Tags Categorias
+// @Accept json
+// @Produce json
+// @Param page query int false "Número da página" default(1)
+// @Param page_size query int false "Tamanho da página" default(10)
+// @Param produtos_limit query int false "Quantidade máxima de produtos por categoria (máx. 50)" default(5)
+// @Param count query string false "Modo de contagem do total" Enums(exact, none, estimated) default(exact)
+// @Success 200 {object} arqdto.PaginatedResponse[dto.CategoriaWithProdutosResponse]
+// @Failure 400 {object} arqdto.ErrorResponse
+// @Failure 500 {object} arqdto.ErrorResponse
+// @Router /api/v1/categorias/com-produtos [get]
+func (h *CategoriaHandler) GetAllWithProdutos(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	pageSize := c.QueryInt("page_size", 10)
+	produtosLimit := c.QueryInt("produtos_limit", service.DefaultProdutosLimit)
+
+	countMode, err := h.ParseCountMode(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	response, err := h.categoriaService.GetAllWithProdutos(ctx, page, pageSize, produtosLimit, countMode)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return arqhandler.SendPaginated(c, response)
 }
 
 // RegisterRoutes registra as rotas de categoria (sobrescreve para adicionar rotas específicas)
 func (h *CategoriaHandler) RegisterRoutes(router fiber.Router) {
 	// Rotas específicas primeiro (devem vir antes das rotas com parâmetros)
-	router.Get("/ativas", h.GetAllActive)
-	router.Get("/:id/produtos", h.GetByIDWithProdutos)
+	router.Get("/ativas", h.WithDeprecation("GET /ativas", h.GetAllActive))
+	router.Get("/com-produtos", h.WithDeprecation("GET /com-produtos", h.GetAllWithProdutos))
+	router.Get("/:id/produtos", arqhandler.ValidateIDParams("id"), h.WithDeprecation("GET /:id/produtos", h.GetByIDWithProdutos))
 
 	// Registra as rotas padrão
 	h.BaseHandlerImpl.RegisterRoutes(router)
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"api_fibergorm/internal/service"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// EstatisticasHandler gerencia as requisições HTTP do painel de estatísticas
+type EstatisticasHandler struct {
+	estatisticasService service.EstatisticasService
+	log                 *logrus.Logger
+}
+
+// NewEstatisticasHandler cria uma nova instância do handler de estatísticas
+func NewEstatisticasHandler(s service.EstatisticasService, log *logrus.Logger) *EstatisticasHandler {
+	return &EstatisticasHandler{
+		estatisticasService: s,
+		log:                 log,
+	}
+}
+
+// Get godoc
+// @Summary Estatísticas do painel
+// @Description Retorna totais agregados: categorias ativas e inativas, total e preços (média, mínimo e máximo) dos produtos, produtos por categoria e registros criados por dia nos últimos 30 dias
+// @Tags Estatísticas
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.EstatisticasResponse
+// @Failure 500 {object} arqdto.ErrorResponse
+// @Router /api/v1/estatisticas [get]
+func (h *EstatisticasHandler) Get(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	response, err := h.estatisticasService.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(response)
+}
+
+// RegisterRoutes registra as rotas de estatísticas
+func (h *EstatisticasHandler) RegisterRoutes(router fiber.Router) {
+	router.Get("/", h.Get)
+}
@@ -1,11 +1,12 @@
 package handler
 
 import (
-	"context"
-
 	"api_fibergorm/internal/dto"
+	"api_fibergorm/internal/mapper"
+	"api_fibergorm/internal/messages"
 	"api_fibergorm/internal/service"
 	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sirupsen/logrus"
@@ -20,9 +21,13 @@ type ProdutoHandler struct {
 
 // NewProdutoHandler cria uma nova instância do handler de produtos
 func NewProdutoHandler(s service.ProdutoService, log *logrus.Logger) *ProdutoHandler {
-	config := arqhandler.DefaultHandlerConfig("Produto")
+	config := arqhandler.DefaultHandlerConfig(messages.EntityProduto)
+	config.FilterableFields = []string{"codigo", "descricao", "preco", "categoria_id"}
+	config.SortableFields = []string{"id", "codigo", "descricao", "preco", "created_at", "updated_at"}
+	config.SelectableFields = []string{"id", "public_id", "codigo", "descricao", "preco", "categoria_id", "created_at", "updated_at", "created_by", "updated_by"}
 
-	baseHandler := arqhandler.NewBaseHandler(s, log, config)
+	baseHandler := arqhandler.NewBaseHandler(s, arqlogging.NewLogrus(log), config).
+		WithCSVExport("produtos", mapper.NewProdutoCSVMapper())
 
 	return &ProdutoHandler{
 		BaseHandlerImpl: baseHandler,
@@ -30,6 +35,16 @@ func NewProdutoHandler(s service.ProdutoService, log *logrus.Logger) *ProdutoHan
 	}
 }
 
+// NewProdutoCategoriaHandler cria o handler de produtos como sub-recurso da categoria
+// (/categorias/:categoria_id/produtos). A listagem não é registrada: GET /categorias/:id/produtos
+// já retorna a categoria com seus produtos e a lista paginada está em /produtos/categoria/:categoria_id
+func NewProdutoCategoriaHandler(s service.ProdutoService, log *logrus.Logger) *arqhandler.NestedHandler[dto.CreateProdutoRequest, dto.UpdateProdutoRequest, dto.ProdutoResponse] {
+	config := arqhandler.DefaultHandlerConfig(messages.EntityProduto)
+
+	return arqhandler.NewNestedHandler[dto.CreateProdutoRequest, dto.UpdateProdutoRequest, dto.ProdutoResponse](s, s.CategoriaRelation(), arqlogging.NewLogrus(log), config).
+		WithoutList()
+}
+
 // GetByCategoriaID godoc
 // @Summary Listar produtos por categoria
 // @Description Retorna uma lista paginada de produtos de uma categoria específica
@@ -39,6 +54,7 @@ func NewProdutoHandler(s service.ProdutoService, log *logrus.Logger) *ProdutoHan
 // @Param categoria_id path int true "ID da categoria"
 // @Param page query int false "Número da página" default(1)
 // @Param page_size query int false "Tamanho da página" default(10)
+// @Param count query string false "Modo de contagem do total" Enums(exact, none, estimated) default(exact)
 // @Success 200 {object} arqdto.PaginatedResponse[dto.ProdutoResponse]
 // @Failure 400 {object} arqdto.ErrorResponse
 // @Failure 404 {object} arqdto.ErrorResponse
@@ -52,13 +68,18 @@ func (h *ProdutoHandler) GetByCategoriaID(c *fiber.Ctx) error {
 
 	page, pageSize := h.getPaginationParams(c)
 
-	ctx := context.Background()
-	response, err := h.produtoService.GetByCategoriaID(ctx, categoriaID, page, pageSize)
+	countMode, err := h.ParseCountMode(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	response, err := h.produtoService.GetByCategoriaID(ctx, categoriaID, page, pageSize, countMode)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(response)
+	return arqhandler.SendPaginated(c, response)
 }
 
 // getPaginationParams extrai os parâmetros de paginação da query
@@ -71,7 +92,7 @@ func (h *ProdutoHandler) getPaginationParams(c *fiber.Ctx) (int, int) {
 // RegisterRoutes registra as rotas de produto (sobrescreve para adicionar rotas específicas)
 func (h *ProdutoHandler) RegisterRoutes(router fiber.Router) {
 	// Rotas específicas primeiro (devem vir antes das rotas com parâmetros)
-	router.Get("/categoria/:categoria_id", h.GetByCategoriaID)
+	router.Get("/categoria/:categoria_id", arqhandler.ValidateIDParams("categoria_id"), h.WithDeprecation("GET /categoria/:categoria_id", h.GetByCategoriaID))
 
 	// Registra as rotas padrão
 	h.BaseHandlerImpl.RegisterRoutes(router)
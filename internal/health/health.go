@@ -0,0 +1,157 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status estado de um check ou da aplicação
+type Status string
+
+const (
+	StatusHealthy   Status = "healthy"   // Todas as dependências disponíveis
+	StatusDegraded  Status = "degraded"  // Dependência não crítica indisponível (a API continua atendendo)
+	StatusUnhealthy Status = "unhealthy" // Dependência crítica indisponível (instância fora do balanceamento)
+)
+
+// CheckFunc verifica uma dependência; retorna erro quando indisponível
+type CheckFunc func(ctx context.Context) error
+
+// check representa um check registrado
+type check struct {
+	name     string
+	critical bool
+	fn       CheckFunc
+}
+
+// CheckResult resultado de um check
+type CheckResult struct {
+	Status   Status `json:"status"`
+	Critical bool   `json:"critical"`
+	Latency  string `json:"latency"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Report resultado agregado dos checks
+type Report struct {
+	Status  Status                 `json:"status"`
+	Service string                 `json:"service"`
+	Checks  map[string]CheckResult `json:"checks"`
+}
+
+// Observer recebe o resultado de cada verificação (ex: exportação de métricas)
+type Observer func(report Report)
+
+// Registry registro dos health checks das dependências da aplicação
+// Cada módulo registra o check da sua dependência (banco, Redis, Loki, ...);
+// falhas de checks críticos tornam a aplicação unhealthy, as demais apenas degraded
+type Registry struct {
+	service  string
+	timeout  time.Duration
+	checks   []check
+	observer Observer
+	mutex    sync.RWMutex
+}
+
+// NewRegistry cria um novo registro de health checks
+// timeout é o prazo de cada check (padrão: 2s)
+func NewRegistry(service string, timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	return &Registry{
+		service: service,
+		timeout: timeout,
+	}
+}
+
+// defaultRegistry registro utilizado pelo /readyz
+var defaultRegistry = NewRegistry("api_fibergorm", 0)
+
+// Setup configura o registro padrão da aplicação
+func Setup(service string, timeout time.Duration, observer Observer) {
+	defaultRegistry = NewRegistry(service, timeout).WithObserver(observer)
+}
+
+// Default retorna o registro padrão da aplicação
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// WithObserver configura o observador dos resultados (retorna o próprio registro para chaining)
+func (r *Registry) WithObserver(observer Observer) *Registry {
+	r.observer = observer
+	return r
+}
+
+// Register adiciona um check; critical indica se a falha torna a aplicação unhealthy
+func (r *Registry) Register(name string, critical bool, fn CheckFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.checks = append(r.checks, check{name: name, critical: critical, fn: fn})
+}
+
+// Check executa todos os checks concorrentemente, cada um com o seu prazo, e agrega o resultado
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mutex.RLock()
+	checks := append([]check(nil), r.checks...)
+	r.mutex.RUnlock()
+
+	report := Report{
+		Status:  StatusHealthy,
+		Service: r.service,
+		Checks:  make(map[string]CheckResult, len(checks)),
+	}
+
+	results := make([]CheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = r.run(ctx, checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for i, c := range checks {
+		report.Checks[c.name] = results[i]
+		if results[i].Status == StatusHealthy {
+			continue
+		}
+		if c.critical {
+			report.Status = StatusUnhealthy
+		} else if report.Status == StatusHealthy {
+			report.Status = StatusDegraded
+		}
+	}
+
+	if r.observer != nil {
+		r.observer(report)
+	}
+	return report
+}
+
+// run executa um check com o prazo configurado e mede a latência
+func (r *Registry) run(ctx context.Context, c check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.fn(ctx)
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+
+	result := CheckResult{
+		Status:   StatusHealthy,
+		Critical: c.critical,
+		Latency:  time.Since(start).String(),
+	}
+	if err != nil {
+		result.Status = StatusUnhealthy
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,202 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// HeaderKey cabeçalho enviado pelo cliente para identificar a operação
+	HeaderKey = "Idempotency-Key"
+
+	// HeaderReplayed cabeçalho adicionado às respostas reproduzidas do armazenamento
+	HeaderReplayed = "Idempotent-Replayed"
+
+	// maxKeyLength tamanho máximo aceito para a chave
+	maxKeyLength = 255
+)
+
+// replayedHeaders cabeçalhos da resposta original que são armazenados para replay
+// (Preference-Applied porque a resposta da criação depende do cabeçalho Prefer)
+var replayedHeaders = []string{fiber.HeaderContentType, fiber.HeaderLocation, arqhandler.HeaderPreferenceApplied}
+
+// Config configurações do middleware de idempotência
+type Config struct {
+	Enabled     bool          // Se o middleware está habilitado
+	TTL         time.Duration // Tempo de retenção das respostas (padrão: 24h)
+	LockTimeout time.Duration // Tempo máximo de reserva da chave durante o processamento (padrão: 1m)
+	Store       Store         // Armazenamento das respostas (padrão: memória)
+}
+
+// Middleware captura as respostas de requisições POST com Idempotency-Key
+// e as reproduz quando a mesma chave é reenviada pelo mesmo chamador dentro do TTL
+// Deve ser registrado após o AuditMiddleware, que identifica o usuário da requisição
+func Middleware(config Config, log *logrus.Logger) fiber.Handler {
+	if config.TTL <= 0 {
+		config.TTL = 24 * time.Hour
+	}
+	if config.LockTimeout <= 0 {
+		config.LockTimeout = time.Minute
+	}
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+
+	return func(c *fiber.Ctx) error {
+		if !config.Enabled || c.Method() != fiber.MethodPost {
+			return c.Next()
+		}
+
+		key := c.Get(HeaderKey)
+		if key == "" {
+			return c.Next()
+		}
+		if len(key) > maxKeyLength {
+			return arqhandler.SendError(c, fiber.StatusBadRequest, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeIdempotencyInvalid,
+				Error: arqhandler.Message(c, i18n.MsgIdempotencyInvalid, nil),
+			})
+		}
+
+		ctx := c.UserContext()
+		caller := callerIdentity(c)
+		storeKey := c.Method() + ":" + c.Path() + ":" + caller + ":" + key
+		fingerprint := requestFingerprint(c)
+
+		logFields := logrus.Fields{
+			"request_id":      c.Locals("requestid"),
+			"idempotency_key": key,
+			"path":            c.Path(),
+		}
+
+		// lookup busca a resposta armazenada da chave; a chave já inclui o chamador e a comparação
+		// protege contra colisões entre identidades
+		lookup := func() (*Response, error) {
+			stored, err := config.Store.Get(ctx, storeKey)
+			if err != nil || stored == nil {
+				return nil, err
+			}
+			if stored.Caller != caller {
+				log.WithFields(logFields).Warn("Idempotency-Key armazenada para outro chamador")
+				return nil, nil
+			}
+			return stored, nil
+		}
+
+		// respond reproduz a resposta armazenada, desde que o payload seja o mesmo da requisição original
+		respond := func(stored *Response) error {
+			if stored.Fingerprint != fingerprint {
+				log.WithFields(logFields).Warn("Idempotency-Key reutilizada com payload diferente")
+				return arqhandler.SendError(c, fiber.StatusUnprocessableEntity, arqdto.ErrorResponse{
+					Code:  arqerrors.CodeIdempotencyMismatch,
+					Error: arqhandler.Message(c, i18n.MsgIdempotencyReused, nil),
+				})
+			}
+
+			log.WithFields(logFields).Info("Reproduzindo resposta idempotente")
+			return replay(c, stored)
+		}
+
+		stored, err := lookup()
+		if err != nil {
+			// Falha no armazenamento não deve impedir a requisição
+			log.WithError(err).WithFields(logFields).Warn("Falha ao consultar armazenamento de idempotência")
+			return c.Next()
+		}
+		if stored != nil {
+			return respond(stored)
+		}
+
+		locked, err := config.Store.Lock(ctx, storeKey, config.LockTimeout)
+		if err != nil {
+			log.WithError(err).WithFields(logFields).Warn("Falha ao reservar Idempotency-Key")
+			return c.Next()
+		}
+		if !locked {
+			return arqhandler.SendError(c, fiber.StatusConflict, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeIdempotencyPending,
+				Error: arqhandler.Message(c, i18n.MsgIdempotencyPending, nil),
+			})
+		}
+		defer func() {
+			if err := config.Store.Unlock(context.Background(), storeKey); err != nil {
+				log.WithError(err).WithFields(logFields).Warn("Falha ao liberar Idempotency-Key")
+			}
+		}()
+
+		// A requisição original pode ter concluído (e liberado a chave) entre a consulta e a reserva:
+		// a resposta armazenada é reproduzida em vez de executar o handler novamente
+		if stored, err := lookup(); err == nil && stored != nil {
+			return respond(stored)
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		// Erros de servidor não são armazenados para permitir nova tentativa
+		status := c.Response().StatusCode()
+		if status >= fiber.StatusInternalServerError {
+			return nil
+		}
+
+		resp := &Response{
+			Status:      status,
+			Headers:     make(map[string]string),
+			Body:        append([]byte(nil), c.Response().Body()...),
+			Fingerprint: fingerprint,
+			Caller:      caller,
+		}
+		for _, header := range replayedHeaders {
+			// Cópia: o valor do Fiber referencia o buffer da resposta, reutilizado após a requisição
+			if value := c.GetRespHeader(header); value != "" {
+				resp.Headers[header] = utils.CopyString(value)
+			}
+		}
+
+		if err := config.Store.Save(context.Background(), storeKey, resp, config.TTL); err != nil {
+			log.WithError(err).WithFields(logFields).Warn("Falha ao armazenar resposta idempotente")
+		}
+
+		return nil
+	}
+}
+
+// replay escreve a resposta armazenada
+func replay(c *fiber.Ctx, stored *Response) error {
+	for header, value := range stored.Headers {
+		c.Set(header, value)
+	}
+	c.Set(HeaderReplayed, "true")
+	return c.Status(stored.Status).Send(stored.Body)
+}
+
+// callerIdentity identifica quem envia a requisição: o usuário autenticado (X-User-ID, via AuditMiddleware)
+// ou, sem autenticação, o IP do cliente. Assim a mesma chave enviada por outro chamador não reproduz a resposta
+func callerIdentity(c *fiber.Ctx) string {
+	if actor := audit.InfoFromContext(c.UserContext()).Actor; actor != "" {
+		return "user=" + actor
+	}
+	return "ip=" + c.IP()
+}
+
+// requestFingerprint calcula o hash do método, rota e body da requisição
+func requestFingerprint(c *fiber.Ctx) string {
+	hash := sha256.New()
+	hash.Write([]byte(c.Method()))
+	hash.Write([]byte(c.Path()))
+	hash.Write(c.Body())
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,169 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Response representa uma resposta armazenada para replay
+type Response struct {
+	Status      int               `json:"status"`
+	Headers     map[string]string `json:"headers"`
+	Body        []byte            `json:"body"`
+	Fingerprint string            `json:"fingerprint"` // Hash do método, rota e body da requisição original
+	Caller      string            `json:"caller"`      // Identidade de quem enviou a requisição original
+}
+
+// Store é a interface de armazenamento das respostas idempotentes
+type Store interface {
+	// Get retorna a resposta armazenada ou nil se não existir
+	Get(ctx context.Context, key string) (*Response, error)
+
+	// Save armazena a resposta pelo tempo informado
+	Save(ctx context.Context, key string, resp *Response, ttl time.Duration) error
+
+	// Lock reserva a chave enquanto a requisição original é processada
+	// Retorna false se a chave já estiver reservada
+	Lock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// Unlock libera a reserva da chave
+	Unlock(ctx context.Context, key string) error
+}
+
+// memoryItem representa um valor armazenado em memória com expiração
+type memoryItem struct {
+	response  *Response
+	expiresAt time.Time
+}
+
+// MemoryStore armazena as respostas em memória (adequado para uma única instância)
+type MemoryStore struct {
+	items map[string]memoryItem
+	locks map[string]time.Time
+	mutex sync.Mutex
+}
+
+// NewMemoryStore cria um novo store em memória
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		items: make(map[string]memoryItem),
+		locks: make(map[string]time.Time),
+	}
+}
+
+// Get retorna a resposta armazenada ou nil se não existir
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Response, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.evictExpired()
+
+	item, ok := s.items[key]
+	if !ok {
+		return nil, nil
+	}
+	return item.response, nil
+}
+
+// Save armazena a resposta pelo tempo informado
+func (s *MemoryStore) Save(ctx context.Context, key string, resp *Response, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.items[key] = memoryItem{
+		response:  resp,
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// Lock reserva a chave enquanto a requisição original é processada
+func (s *MemoryStore) Lock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if expiresAt, ok := s.locks[key]; ok && time.Now().Before(expiresAt) {
+		return false, nil
+	}
+	s.locks[key] = time.Now().Add(ttl)
+	return true, nil
+}
+
+// Unlock libera a reserva da chave
+func (s *MemoryStore) Unlock(ctx context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.locks, key)
+	return nil
+}
+
+// evictExpired remove os itens expirados (deve ser chamado com o mutex bloqueado)
+func (s *MemoryStore) evictExpired() {
+	now := time.Now()
+	for key, item := range s.items {
+		if now.After(item.expiresAt) {
+			delete(s.items, key)
+		}
+	}
+	for key, expiresAt := range s.locks {
+		if now.After(expiresAt) {
+			delete(s.locks, key)
+		}
+	}
+}
+
+// RedisStore armazena as respostas no Redis (compartilhado entre instâncias)
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisStore cria um novo store no Redis
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: "idempotency:",
+	}
+}
+
+// Get retorna a resposta armazenada ou nil se não existir
+func (s *RedisStore) Get(ctx context.Context, key string) (*Response, error) {
+	data, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var resp Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Save armazena a resposta pelo tempo informado
+func (s *RedisStore) Save(ctx context.Context, key string, resp *Response, ttl time.Duration) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, s.prefix+key, data, ttl).Err()
+}
+
+// Lock reserva a chave enquanto a requisição original é processada
+func (s *RedisStore) Lock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, s.prefix+"lock:"+key, 1, ttl).Result()
+}
+
+// Unlock libera a reserva da chave
+func (s *RedisStore) Unlock(ctx context.Context, key string) error {
+	return s.client.Del(ctx, s.prefix+"lock:"+key).Err()
+}
@@ -0,0 +1,178 @@
+package jobs
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Func é a função executada a cada ciclo de um job
+// O contexto é cancelado no encerramento da aplicação
+type Func func(ctx context.Context) error
+
+// Status representa o estado de um job (exposto em /admin/jobs)
+type Status struct {
+	Name           string     `json:"name"`
+	Interval       string     `json:"interval"`
+	Running        bool       `json:"running"`                    // Execução em andamento
+	Runs           int64      `json:"runs"`                       // Execuções concluídas
+	Failures       int64      `json:"failures"`                   // Execuções concluídas com erro
+	LastStartedAt  *time.Time `json:"last_started_at,omitempty"`  // Início da última execução
+	LastFinishedAt *time.Time `json:"last_finished_at,omitempty"` // Fim da última execução
+	LastDuration   string     `json:"last_duration,omitempty"`
+	LastError      string     `json:"last_error,omitempty"` // Erro da última execução (vazio se bem-sucedida)
+}
+
+// job representa um job agendado
+type job struct {
+	fn     Func
+	status Status
+	mutex  sync.RWMutex
+}
+
+// Scheduler executa jobs periódicos em segundo plano e mantém o estado de cada um
+// Em modo prefork os jobs são executados apenas pelo processo principal (ver bootstrap)
+type Scheduler struct {
+	jobs   map[string]*job
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	log    *logrus.Logger
+	mutex  sync.RWMutex
+}
+
+// NewScheduler cria um novo agendador de jobs
+func NewScheduler(log *logrus.Logger) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		jobs:   make(map[string]*job),
+		ctx:    ctx,
+		cancel: cancel,
+		log:    log,
+	}
+}
+
+// defaultScheduler agendador utilizado pela aplicação
+var defaultScheduler = NewScheduler(logrus.StandardLogger())
+
+// Setup configura o agendador padrão da aplicação
+func Setup(log *logrus.Logger) {
+	defaultScheduler = NewScheduler(log)
+}
+
+// Default retorna o agendador padrão da aplicação
+func Default() *Scheduler {
+	return defaultScheduler
+}
+
+// Schedule inicia um job executado a cada interval (a primeira execução ocorre após o primeiro intervalo)
+// Um nome já agendado é ignorado
+func (s *Scheduler) Schedule(name string, interval time.Duration, fn Func) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, exists := s.jobs[name]; exists {
+		s.log.WithField("job", name).Warn("Job já agendado")
+		return
+	}
+
+	j := &job{
+		fn:     fn,
+		status: Status{Name: name, Interval: interval.String()},
+	}
+	s.jobs[name] = j
+
+	s.wg.Add(1)
+	go s.loop(j, interval)
+}
+
+// loop executa o job a cada intervalo até o encerramento
+func (s *Scheduler) loop(j *job, interval time.Duration) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.run(j)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// run executa um ciclo do job registrando início, duração e erro
+func (s *Scheduler) run(j *job) {
+	start := time.Now()
+	j.mutex.Lock()
+	j.status.Running = true
+	j.status.LastStartedAt = &start
+	j.mutex.Unlock()
+
+	err := j.fn(s.ctx)
+
+	finished := time.Now()
+	j.mutex.Lock()
+	j.status.Running = false
+	j.status.Runs++
+	j.status.LastFinishedAt = &finished
+	j.status.LastDuration = finished.Sub(start).String()
+	j.status.LastError = ""
+	if err != nil {
+		j.status.Failures++
+		j.status.LastError = err.Error()
+	}
+	j.mutex.Unlock()
+
+	if err != nil {
+		s.log.WithError(err).WithField("job", j.status.Name).Error("Falha na execução do job")
+	}
+}
+
+// Statuses retorna o estado dos jobs ordenados pelo nome
+func (s *Scheduler) Statuses() []Status {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	statuses := make([]Status, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		j.mutex.RLock()
+		statuses = append(statuses, j.status)
+		j.mutex.RUnlock()
+	}
+	sort.Slice(statuses, func(i, k int) bool {
+		return statuses[i].Name < statuses[k].Name
+	})
+	return statuses
+}
+
+// Stop cancela os jobs e aguarda as execuções em andamento dentro do prazo do contexto
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// StatusHandler retorna o handler que expõe o estado dos jobs
+func (s *Scheduler) StatusHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(s.Statuses())
+	}
+}
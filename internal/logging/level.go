@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"sync"
+	"time"
+
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// LevelStatus representa o nível de log atual
+type LevelStatus struct {
+	Level    string     `json:"level"`
+	RevertTo string     `json:"revert_to,omitempty"` // Nível restaurado ao fim da duração
+	RevertAt *time.Time `json:"revert_at,omitempty"` // Momento da restauração automática
+}
+
+// LevelRequest representa o payload de alteração do nível de log
+// Duration (ex: "15m") restaura automaticamente o nível anterior, evitando esquecer o debug ligado
+type LevelRequest struct {
+	Level    string `json:"level"`
+	Duration string `json:"duration"`
+}
+
+// LevelSwitch altera o nível do logger em tempo de execução
+// Em modo prefork a alteração vale apenas para o processo que recebeu a requisição
+type LevelSwitch struct {
+	log      *logrus.Logger
+	revertTo logrus.Level
+	revertAt *time.Time
+	timer    *time.Timer
+	mutex    sync.Mutex
+}
+
+// NewLevelSwitch cria o controle de nível do logger informado
+func NewLevelSwitch(log *logrus.Logger) *LevelSwitch {
+	return &LevelSwitch{log: log}
+}
+
+// Status retorna o nível atual
+func (s *LevelSwitch) Status() LevelStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	status := LevelStatus{Level: s.log.GetLevel().String()}
+	if s.revertAt != nil {
+		status.RevertTo = s.revertTo.String()
+		status.RevertAt = s.revertAt
+	}
+	return status
+}
+
+// Set altera o nível; com duration > 0 o nível atual é restaurado ao fim do período
+func (s *LevelSwitch) Set(level logrus.Level, duration time.Duration) LevelStatus {
+	s.mutex.Lock()
+
+	// Uma restauração pendente mantém o nível original (anterior ao primeiro ajuste temporário)
+	previous := s.log.GetLevel()
+	if s.timer != nil {
+		s.timer.Stop()
+		previous = s.revertTo
+		s.timer = nil
+		s.revertAt = nil
+	}
+
+	s.log.SetLevel(level)
+	if duration > 0 {
+		revertAt := time.Now().Add(duration)
+		s.revertTo = previous
+		s.revertAt = &revertAt
+		s.timer = time.AfterFunc(duration, s.revert)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"level":    level.String(),
+		"previous": previous.String(),
+		"duration": duration.String(),
+	}).Warn("Nível de log alterado")
+
+	s.mutex.Unlock()
+	return s.Status()
+}
+
+// revert restaura o nível anterior ao ajuste temporário
+func (s *LevelSwitch) revert() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.revertAt == nil {
+		return
+	}
+	s.log.SetLevel(s.revertTo)
+	s.timer = nil
+	s.revertAt = nil
+	s.log.WithField("level", s.revertTo.String()).Warn("Nível de log restaurado")
+}
+
+// StatusHandler retorna o handler que expõe o nível atual
+func (s *LevelSwitch) StatusHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(s.Status())
+	}
+}
+
+// UpdateHandler retorna o handler que altera o nível de log
+func (s *LevelSwitch) UpdateHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req LevelRequest
+		if err := c.BodyParser(&req); err != nil {
+			return arqhandler.SendError(c, fiber.StatusBadRequest, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeInvalidBody,
+				Error: arqhandler.Message(c, i18n.MsgInvalidBody, nil),
+			})
+		}
+
+		level, err := logrus.ParseLevel(req.Level)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidValues, i18n.Params{
+				"param":  "level",
+				"values": "trace, debug, info, warn, error, fatal, panic",
+			}))
+		}
+
+		var duration time.Duration
+		if req.Duration != "" {
+			duration, err = time.ParseDuration(req.Duration)
+			if err != nil || duration < 0 {
+				return fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidValues, i18n.Params{
+					"param":  "duration",
+					"values": "15m, 1h, ...",
+				}))
+			}
+		}
+
+		return c.JSON(s.Set(level, duration))
+	}
+}
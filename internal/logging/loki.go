@@ -2,6 +2,7 @@ package logging
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -31,7 +32,13 @@ type LokiHook struct {
 	entries  []lokiEntry
 	mutex    sync.Mutex
 	quit     chan struct{}
+	done     chan struct{}
+	close    sync.Once
 	hostname string
+
+	// Resultado do último envio (usado no health check)
+	lastErr     error
+	statusMutex sync.RWMutex
 }
 
 // lokiEntry representa uma entrada de log
@@ -83,6 +90,7 @@ func NewLokiHook(config LokiConfig) (*LokiHook, error) {
 		client:   &http.Client{Timeout: config.Timeout},
 		entries:  make([]lokiEntry, 0, config.BatchSize),
 		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
 		hostname: hostname,
 	}
 
@@ -149,6 +157,8 @@ func (h *LokiHook) formatEntry(entry *logrus.Entry) (string, error) {
 
 // runFlusher executa flush periódico
 func (h *LokiHook) runFlusher() {
+	defer close(h.done)
+
 	ticker := time.NewTicker(h.config.BatchWait)
 	defer ticker.Stop()
 
@@ -205,35 +215,83 @@ func (h *LokiHook) flush() {
 
 // send envia a requisição para o Loki
 func (h *LokiHook) send(req lokiPushRequest) {
+	err := h.push(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+	}
+
+	h.statusMutex.Lock()
+	h.lastErr = err
+	h.statusMutex.Unlock()
+}
+
+// push serializa e envia os streams para o Loki
+func (h *LokiHook) push(req lokiPushRequest) error {
 	jsonData, err := json.Marshal(req)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "erro ao serializar logs para Loki: %v\n", err)
-		return
+		return fmt.Errorf("erro ao serializar logs para Loki: %w", err)
 	}
 
 	httpReq, err := http.NewRequest("POST", h.config.URL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "erro ao criar requisição para Loki: %v\n", err)
-		return
+		return fmt.Errorf("erro ao criar requisição para Loki: %w", err)
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
 
 	resp, err := h.client.Do(httpReq)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "erro ao enviar logs para Loki: %v\n", err)
-		return
+		return fmt.Errorf("erro ao enviar logs para Loki: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		fmt.Fprintf(os.Stderr, "Loki retornou status %d\n", resp.StatusCode)
+		return fmt.Errorf("Loki retornou status %d", resp.StatusCode)
 	}
+	return nil
 }
 
-// Close fecha o hook e faz flush final
+// Check retorna o erro do último envio ao Loki (nil se o último envio foi bem-sucedido ou ainda não houve envio)
+func (h *LokiHook) Check(ctx context.Context) error {
+	h.statusMutex.RLock()
+	defer h.statusMutex.RUnlock()
+	return h.lastErr
+}
+
+// FindLokiHook retorna o hook do Loki registrado no logger (nil se a integração estiver desabilitada)
+func FindLokiHook(log *logrus.Logger) *LokiHook {
+	for _, hooks := range log.Hooks {
+		for _, hook := range hooks {
+			if lokiHook, ok := hook.(*LokiHook); ok {
+				return lokiHook
+			}
+		}
+	}
+	return nil
+}
+
+// Close fecha o hook e aguarda o flush final (chamadas repetidas são ignoradas)
 func (h *LokiHook) Close() {
-	close(h.quit)
+	h.close.Do(func() {
+		close(h.quit)
+	})
+	<-h.done
+}
+
+// CloseHooks fecha os hooks do logger que mantêm logs pendentes (ex: Loki), enviando o que restar
+// Deve ser a última etapa do encerramento, para que os logs das etapas anteriores sejam enviados
+func CloseHooks(log *logrus.Logger) {
+	closed := make(map[logrus.Hook]bool)
+	for _, hooks := range log.Hooks {
+		for _, hook := range hooks {
+			closer, ok := hook.(interface{ Close() })
+			if !ok || closed[hook] {
+				continue
+			}
+			closed[hook] = true
+			closer.Close()
+		}
+	}
 }
 
 // DefaultLokiConfig retorna configuração padrão do Loki
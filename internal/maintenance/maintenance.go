@@ -0,0 +1,184 @@
+package maintenance
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Config configurações iniciais do modo de manutenção
+type Config struct {
+	Enabled    bool   // Se a API inicia em manutenção
+	AllowReads bool   // Se leituras (GET/HEAD/OPTIONS) continuam permitidas
+	RetryAfter int    // Valor do cabeçalho Retry-After em segundos (padrão: 120)
+	Message    string // Mensagem retornada aos clientes (vazio = mensagem padrão no idioma do cliente)
+}
+
+// Status representa o estado atual do modo de manutenção
+type Status struct {
+	Enabled    bool       `json:"enabled"`
+	AllowReads bool       `json:"allow_reads"`
+	RetryAfter int        `json:"retry_after"`
+	Message    string     `json:"message"`
+	Since      *time.Time `json:"since,omitempty"`
+}
+
+// UpdateRequest representa o payload de alteração do modo de manutenção
+type UpdateRequest struct {
+	Enabled    *bool   `json:"enabled"`
+	AllowReads *bool   `json:"allow_reads"`
+	RetryAfter *int    `json:"retry_after"`
+	Message    *string `json:"message"`
+}
+
+// Switch controla o modo de manutenção da API
+type Switch struct {
+	status Status
+	mutex  sync.RWMutex
+	log    *logrus.Logger
+}
+
+// defaultSwitch instância utilizada pelo middleware e pelo endpoint administrativo
+var defaultSwitch = New(Config{AllowReads: true}, logrus.StandardLogger())
+
+// New cria um novo controle de manutenção
+func New(config Config, log *logrus.Logger) *Switch {
+	if config.RetryAfter <= 0 {
+		config.RetryAfter = 120
+	}
+
+	s := &Switch{
+		status: Status{
+			Enabled:    config.Enabled,
+			AllowReads: config.AllowReads,
+			RetryAfter: config.RetryAfter,
+			Message:    config.Message,
+		},
+		log: log,
+	}
+	if config.Enabled {
+		now := time.Now()
+		s.status.Since = &now
+	}
+	return s
+}
+
+// Setup configura o controle de manutenção padrão da aplicação
+func Setup(config Config, log *logrus.Logger) {
+	defaultSwitch = New(config, log)
+	if config.Enabled {
+		log.Warn("API iniciada em modo de manutenção")
+	}
+}
+
+// Default retorna o controle de manutenção padrão da aplicação
+func Default() *Switch {
+	return defaultSwitch
+}
+
+// Status retorna o estado atual
+func (s *Switch) Status() Status {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.status
+}
+
+// Update aplica as alterações informadas e retorna o novo estado
+func (s *Switch) Update(req UpdateRequest) Status {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if req.Enabled != nil && *req.Enabled != s.status.Enabled {
+		s.status.Enabled = *req.Enabled
+		if s.status.Enabled {
+			now := time.Now()
+			s.status.Since = &now
+		} else {
+			s.status.Since = nil
+		}
+	}
+	if req.AllowReads != nil {
+		s.status.AllowReads = *req.AllowReads
+	}
+	if req.RetryAfter != nil && *req.RetryAfter > 0 {
+		s.status.RetryAfter = *req.RetryAfter
+	}
+	// Mensagem vazia restaura a mensagem padrão traduzida
+	if req.Message != nil {
+		s.status.Message = *req.Message
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"enabled":     s.status.Enabled,
+		"allow_reads": s.status.AllowReads,
+		"retry_after": s.status.RetryAfter,
+	}).Warn("Modo de manutenção alterado")
+
+	return s.status
+}
+
+// Middleware bloqueia as requisições da API enquanto o modo de manutenção estiver ativo
+// Apenas as rotas /api são afetadas; health checks, métricas e /admin continuam disponíveis
+func (s *Switch) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !strings.HasPrefix(c.Path(), "/api/") {
+			return c.Next()
+		}
+
+		status := s.Status()
+		if !status.Enabled {
+			return c.Next()
+		}
+
+		if status.AllowReads && isReadMethod(c.Method()) {
+			return c.Next()
+		}
+
+		// Sem mensagem definida via /admin, a mensagem padrão no idioma do cliente (Accept-Language)
+		message := status.Message
+		if message == "" {
+			message = arqhandler.Message(c, i18n.MsgMaintenance, nil)
+		}
+
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(status.RetryAfter))
+		return arqhandler.SendError(c, fiber.StatusServiceUnavailable, arqdto.ErrorResponse{
+			Code:  arqerrors.CodeMaintenance,
+			Error: message,
+		})
+	}
+}
+
+// StatusHandler retorna o handler que expõe o estado atual
+func (s *Switch) StatusHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(s.Status())
+	}
+}
+
+// UpdateHandler retorna o handler que altera o modo de manutenção
+func (s *Switch) UpdateHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req UpdateRequest
+		if err := c.BodyParser(&req); err != nil {
+			return arqhandler.SendError(c, fiber.StatusBadRequest, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeInvalidBody,
+				Error: arqhandler.Message(c, i18n.MsgInvalidBody, nil),
+			})
+		}
+		return c.JSON(s.Update(req))
+	}
+}
+
+// isReadMethod verifica se o método HTTP é de leitura
+func isReadMethod(method string) bool {
+	return method == fiber.MethodGet || method == fiber.MethodHead || method == fiber.MethodOptions
+}
@@ -3,74 +3,42 @@ package mapper
 import (
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/models"
+	arqmapper "api_fibergorm/pkg/arquitetura/mapper"
 )
 
 // CategoriaMapper implementa o mapeamento entre Categoria e seus DTOs
-type CategoriaMapper struct{}
+// Os campos são mapeados automaticamente por nome (AutoMapper); apenas as regras
+// específicas (ativo padrão e resposta com produtos) são escritas manualmente
+type CategoriaMapper struct {
+	*arqmapper.AutoMapper[*models.Categoria, dto.CreateCategoriaRequest, dto.UpdateCategoriaRequest, dto.CategoriaResponse]
+}
 
 // NewCategoriaMapper cria uma nova instância do mapper
 func NewCategoriaMapper() *CategoriaMapper {
-	return &CategoriaMapper{}
-}
-
-// ToEntity converte CreateCategoriaRequest para Categoria
-func (m *CategoriaMapper) ToEntity(req *dto.CreateCategoriaRequest) *models.Categoria {
-	ativo := true
-	if req.Ativo != nil {
-		ativo = *req.Ativo
-	}
-
-	return &models.Categoria{
-		Nome:      req.Nome,
-		Descricao: req.Descricao,
-		Ativo:     ativo,
-	}
+	auto := arqmapper.NewAutoMapper[*models.Categoria, dto.CreateCategoriaRequest, dto.UpdateCategoriaRequest, dto.CategoriaResponse]().
+		WithToEntity(func(req *dto.CreateCategoriaRequest, entity *models.Categoria) error {
+			// Categorias são criadas ativas quando o campo não é informado
+			if req.Ativo == nil {
+				entity.Ativo = true
+			}
+			return nil
+		})
+
+	return &CategoriaMapper{AutoMapper: auto}
 }
 
-// ToResponse converte Categoria para CategoriaResponse
-func (m *CategoriaMapper) ToResponse(entity *models.Categoria) *dto.CategoriaResponse {
-	return &dto.CategoriaResponse{
-		ID:        entity.ID,
-		Nome:      entity.Nome,
-		Descricao: entity.Descricao,
-		Ativo:     entity.Ativo,
-		CreatedAt: entity.GetCreatedAt(),
-		UpdatedAt: entity.GetUpdatedAt(),
-	}
-}
-
-// ApplyUpdate aplica as alterações do UpdateCategoriaRequest na entidade
-func (m *CategoriaMapper) ApplyUpdate(entity *models.Categoria, req *dto.UpdateCategoriaRequest) {
-	if req.Nome != "" {
-		entity.Nome = req.Nome
-	}
-	if req.Descricao != "" {
-		entity.Descricao = req.Descricao
-	}
-	if req.Ativo != nil {
-		entity.Ativo = *req.Ativo
-	}
-}
-
-// ToResponseWithProdutos converte Categoria para CategoriaWithProdutosResponse
+// ToResponseWithProdutos converte Categoria para CategoriaWithProdutosResponse (nil quando a categoria é nil)
 func (m *CategoriaMapper) ToResponseWithProdutos(entity *models.Categoria) *dto.CategoriaWithProdutosResponse {
-	produtos := make([]dto.ProdutoSimpleResponse, len(entity.Produtos))
-	for i, p := range entity.Produtos {
-		produtos[i] = dto.ProdutoSimpleResponse{
-			ID:        p.ID,
-			Codigo:    p.Codigo,
-			Descricao: p.Descricao,
-			Preco:     p.Preco,
-		}
+	if entity == nil {
+		return nil
 	}
+	response := &dto.CategoriaWithProdutosResponse{}
+	arqmapper.Map(entity, response)
 
-	return &dto.CategoriaWithProdutosResponse{
-		ID:        entity.ID,
-		Nome:      entity.Nome,
-		Descricao: entity.Descricao,
-		Ativo:     entity.Ativo,
-		CreatedAt: entity.GetCreatedAt(),
-		UpdatedAt: entity.GetUpdatedAt(),
-		Produtos:  produtos,
+	// Garante lista vazia (e não null) quando a categoria não possui produtos
+	if response.Produtos == nil {
+		response.Produtos = []dto.ProdutoSimpleResponse{}
 	}
+
+	return response
 }
@@ -0,0 +1,40 @@
+package mapper
+
+import (
+	"api_fibergorm/internal/dto"
+	"api_fibergorm/pkg/arquitetura/export"
+)
+
+// ProdutoCSVMapper converte os produtos em linhas da exportação em CSV (GET /produtos/export?format=csv)
+type ProdutoCSVMapper struct{}
+
+// NewProdutoCSVMapper cria uma nova instância do mapper da exportação
+func NewProdutoCSVMapper() *ProdutoCSVMapper {
+	return &ProdutoCSVMapper{}
+}
+
+// Header retorna as colunas do CSV
+func (m *ProdutoCSVMapper) Header() []string {
+	return []string{"id", "public_id", "codigo", "descricao", "preco", "categoria_id", "categoria", "created_at", "updated_at", "created_by", "updated_by"}
+}
+
+// Row converte o produto na linha do CSV, na ordem de Header
+func (m *ProdutoCSVMapper) Row(item *dto.ProdutoResponse) []string {
+	categoria := ""
+	if item.Categoria != nil {
+		categoria = item.Categoria.Nome
+	}
+	return []string{
+		export.FormatValue(item.ID),
+		item.PublicID,
+		item.Codigo,
+		item.Descricao,
+		export.FormatValue(item.Preco),
+		export.FormatValue(item.CategoriaID),
+		categoria,
+		item.CreatedAt,
+		item.UpdatedAt,
+		item.CreatedBy,
+		item.UpdatedBy,
+	}
+}
@@ -1,10 +1,17 @@
 package mapper
 
 import (
+	"errors"
+
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/models"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqmapper "api_fibergorm/pkg/arquitetura/mapper"
 )
 
+// ErrCampoNaoNulo indica null no PATCH em um campo obrigatório do produto
+var ErrCampoNaoNulo = errors.New("o campo não pode ser nulo")
+
 // ProdutoMapper implementa o mapeamento entre Produto e seus DTOs
 type ProdutoMapper struct{}
 
@@ -14,31 +21,41 @@ func NewProdutoMapper() *ProdutoMapper {
 }
 
 // ToEntity converte CreateProdutoRequest para Produto
-func (m *ProdutoMapper) ToEntity(req *dto.CreateProdutoRequest) *models.Produto {
+func (m *ProdutoMapper) ToEntity(req *dto.CreateProdutoRequest) (*models.Produto, error) {
+	if req == nil {
+		return nil, arqerrors.ErrMapping
+	}
 	return &models.Produto{
 		Codigo:      req.Codigo,
 		Descricao:   req.Descricao,
 		Preco:       req.Preco,
 		CategoriaID: req.CategoriaID,
-	}
+	}, nil
 }
 
-// ToResponse converte Produto para ProdutoResponse
+// ToResponse converte Produto para ProdutoResponse (nil quando o produto é nil)
 func (m *ProdutoMapper) ToResponse(entity *models.Produto) *dto.ProdutoResponse {
+	if entity == nil {
+		return nil
+	}
 	response := &dto.ProdutoResponse{
 		ID:          entity.ID,
+		PublicID:    entity.PublicID.String(),
 		Codigo:      entity.Codigo,
 		Descricao:   entity.Descricao,
 		Preco:       entity.Preco,
 		CategoriaID: entity.CategoriaID,
 		CreatedAt:   entity.GetCreatedAt(),
 		UpdatedAt:   entity.GetUpdatedAt(),
+
+		UpdatedAtTime: entity.UpdatedAt,
 	}
 
 	// Se a categoria foi carregada (eager loading), inclui os dados
 	if entity.Categoria.ID != 0 {
 		response.Categoria = &dto.CategoriaResponse{
 			ID:        entity.Categoria.ID,
+			PublicID:  entity.Categoria.PublicID.String(),
 			Nome:      entity.Categoria.Nome,
 			Descricao: entity.Categoria.Descricao,
 			Ativo:     entity.Categoria.Ativo,
@@ -50,18 +67,57 @@ func (m *ProdutoMapper) ToResponse(entity *models.Produto) *dto.ProdutoResponse
 	return response
 }
 
-// ApplyUpdate aplica as alterações do UpdateProdutoRequest na entidade
-func (m *ProdutoMapper) ApplyUpdate(entity *models.Produto, req *dto.UpdateProdutoRequest) {
-	if req.Codigo != "" {
-		entity.Codigo = req.Codigo
+// ApplyUpdate aplica as alterações do UpdateProdutoRequest na entidade (apenas os campos informados)
+func (m *ProdutoMapper) ApplyUpdate(entity *models.Produto, req *dto.UpdateProdutoRequest) error {
+	if entity == nil || req == nil {
+		return arqerrors.ErrMapping
 	}
-	if req.Descricao != "" {
-		entity.Descricao = req.Descricao
+	if req.Codigo != nil {
+		entity.Codigo = *req.Codigo
 	}
-	if req.Preco != 0 {
-		entity.Preco = req.Preco
+	if req.Descricao != nil {
+		entity.Descricao = *req.Descricao
 	}
-	if req.CategoriaID != 0 {
-		entity.CategoriaID = req.CategoriaID
+	if req.Preco != nil {
+		entity.Preco = *req.Preco
+	}
+	if req.CategoriaID != nil {
+		entity.CategoriaID = *req.CategoriaID
+	}
+	return nil
+}
+
+// ApplyPatch aplica os campos da máscara (PATCH), inclusive os valores zero (dto.PatchMapper)
+// null limpa a descrição; código, preço e categoria são obrigatórios e retornam erro de validação do campo
+func (m *ProdutoMapper) ApplyPatch(entity *models.Produto, req *dto.UpdateProdutoRequest, mask []string) error {
+	if entity == nil || req == nil {
+		return arqerrors.ErrMapping
+	}
+	for _, field := range mask {
+		switch field {
+		case "codigo":
+			if req.Codigo == nil {
+				return arqerrors.NewMappingError(field, ErrCampoNaoNulo)
+			}
+			entity.Codigo = *req.Codigo
+		case "descricao":
+			entity.Descricao = ""
+			if req.Descricao != nil {
+				entity.Descricao = *req.Descricao
+			}
+		case "preco":
+			if req.Preco == nil {
+				return arqerrors.NewMappingError(field, ErrCampoNaoNulo)
+			}
+			entity.Preco = *req.Preco
+		case "categoria_id":
+			if req.CategoriaID == nil {
+				return arqerrors.NewMappingError(field, ErrCampoNaoNulo)
+			}
+			entity.CategoriaID = *req.CategoriaID
+		default:
+			return arqerrors.NewMappingError(field, arqmapper.ErrFieldNotUpdatable)
+		}
 	}
+	return nil
 }
@@ -0,0 +1,25 @@
+package messages
+
+import "api_fibergorm/pkg/arquitetura/i18n"
+
+// Nomes das entidades da aplicação (EntityName dos serviços e handlers)
+// Traduzidos pelo catálogo de mensagens com a chave "entity.<nome em minúsculas>"
+const (
+	EntityCategoria = "Categoria"
+	EntityProduto   = "Produto"
+)
+
+func init() {
+	i18n.RegisterMessages("pt-BR", map[string]string{
+		"entity.categoria": "Categoria",
+		"entity.produto":   "Produto",
+	})
+	i18n.RegisterMessages("en", map[string]string{
+		"entity.categoria": "Category",
+		"entity.produto":   "Product",
+	})
+	i18n.RegisterMessages("es", map[string]string{
+		"entity.categoria": "Categoría",
+		"entity.produto":   "Producto",
+	})
+}
@@ -0,0 +1,238 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/sirupsen/logrus"
+)
+
+// childScrapeTimeout prazo da coleta das métricas de cada processo filho
+const childScrapeTimeout = 2 * time.Second
+
+// Listener servidor HTTP próprio das métricas, fora do prefork (METRICS_PORT)
+// Com prefork, o processo principal agrega as métricas de todos os processos filhos, que as expõem
+// em sockets unix (ServeChild); sem prefork expõe o registro do próprio processo
+type Listener struct {
+	server *http.Server
+	dir    string // Diretório dos sockets dos filhos (vazio sem prefork)
+	log    *logrus.Logger
+}
+
+// NewListener cria o servidor de métricas na porta informada
+// Nos processos filhos do prefork não há servidor: as métricas são coletadas pelo processo principal
+func NewListener(port string, prefork bool, log *logrus.Logger) *Listener {
+	l := &Listener{log: log}
+	if fiber.IsChild() {
+		return l
+	}
+
+	var gatherer prometheus.Gatherer = prometheus.DefaultGatherer
+	if prefork {
+		l.dir = socketDir(os.Getpid())
+		gatherer = prometheus.GathererFunc(l.gather)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{ErrorLog: log}))
+	l.server = &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	return l
+}
+
+// Start abre a porta de métricas em segundo plano
+func (l *Listener) Start() error {
+	if l.server == nil {
+		return nil
+	}
+	if l.dir != "" {
+		if err := os.MkdirAll(l.dir, 0o700); err != nil {
+			return fmt.Errorf("falha ao criar o diretório dos sockets de métricas: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("tcp", l.server.Addr)
+	if err != nil {
+		return fmt.Errorf("falha ao abrir a porta de métricas: %w", err)
+	}
+	go func() {
+		if err := l.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.log.WithError(err).Error("Falha no servidor de métricas")
+		}
+	}()
+
+	l.log.WithField("addr", l.server.Addr).Info("Servidor de métricas iniciado")
+	return nil
+}
+
+// Close encerra o servidor de métricas e remove o diretório dos sockets dos filhos
+func (l *Listener) Close(ctx context.Context) error {
+	if l.server == nil {
+		return nil
+	}
+	err := l.server.Shutdown(ctx)
+	if l.dir != "" {
+		_ = os.RemoveAll(l.dir)
+	}
+	return err
+}
+
+// gather coleta o registro do processo principal e o de cada processo filho, com o label pid em todas as
+// séries; as famílias de mesmo nome são combinadas em uma única resposta
+func (l *Listener) gather() ([]*dto.MetricFamily, error) {
+	own, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]*dto.MetricFamily)
+	mergeFamilies(merged, own, os.Getpid())
+
+	sockets, _ := filepath.Glob(filepath.Join(l.dir, "*.sock"))
+	results := make([][]*dto.MetricFamily, len(sockets))
+	var wg sync.WaitGroup
+	for i, socket := range sockets {
+		wg.Add(1)
+		go func(i int, socket string) {
+			defer wg.Done()
+			families, err := scrapeChild(socket)
+			if err != nil {
+				// Processo filho encerrado ou ainda iniciando: a coleta segue com os demais
+				l.log.WithError(err).WithField("socket", socket).Debug("Falha ao coletar métricas do processo filho")
+				return
+			}
+			results[i] = families
+		}(i, socket)
+	}
+	wg.Wait()
+
+	for i, socket := range sockets {
+		pid, _ := strconv.Atoi(strings.TrimSuffix(filepath.Base(socket), ".sock"))
+		mergeFamilies(merged, results[i], pid)
+	}
+
+	families := make([]*dto.MetricFamily, 0, len(merged))
+	for _, family := range merged {
+		families = append(families, family)
+	}
+	sort.Slice(families, func(i, k int) bool {
+		return families[i].GetName() < families[k].GetName()
+	})
+	return families, nil
+}
+
+// mergeFamilies adiciona as séries das famílias ao resultado, com o label pid quando ausente
+// (as séries da aplicação nos filhos já possuem o label; as do runtime Go não)
+func mergeFamilies(merged map[string]*dto.MetricFamily, families []*dto.MetricFamily, pid int) {
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			addPIDLabel(metric, pid)
+		}
+
+		existing, ok := merged[family.GetName()]
+		if !ok {
+			merged[family.GetName()] = family
+			continue
+		}
+		if existing.GetType() != family.GetType() {
+			continue
+		}
+		existing.Metric = append(existing.Metric, family.Metric...)
+	}
+}
+
+// addPIDLabel inclui o label pid na série, mantendo os labels ordenados
+func addPIDLabel(metric *dto.Metric, pid int) {
+	for _, label := range metric.Label {
+		if label.GetName() == "pid" {
+			return
+		}
+	}
+	name, value := "pid", strconv.Itoa(pid)
+	metric.Label = append(metric.Label, &dto.LabelPair{Name: &name, Value: &value})
+	sort.Slice(metric.Label, func(i, k int) bool {
+		return metric.Label[i].GetName() < metric.Label[k].GetName()
+	})
+}
+
+// scrapeChild coleta as métricas expostas pelo processo filho no socket unix
+func scrapeChild(socket string) ([]*dto.MetricFamily, error) {
+	client := &http.Client{
+		Timeout: childScrapeTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	resp, err := client.Get("http://child/metrics")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	var parser expfmt.TextParser
+	byName, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	families := make([]*dto.MetricFamily, 0, len(byName))
+	for _, family := range byName {
+		families = append(families, family)
+	}
+	return families, nil
+}
+
+// ServeChild expõe as métricas do processo filho do prefork em um socket unix, coletado pelo Listener do
+// processo principal. Retorna a função de encerramento (nil fora dos processos filhos)
+func ServeChild(log *logrus.Logger) (func(ctx context.Context) error, error) {
+	if !fiber.IsChild() {
+		return nil, nil
+	}
+
+	socket := filepath.Join(socketDir(os.Getppid()), strconv.Itoa(os.Getpid())+".sock")
+	_ = os.Remove(socket)
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao abrir o socket de métricas: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{ErrorLog: log}))
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Error("Falha no socket de métricas do processo filho")
+		}
+	}()
+
+	return server.Shutdown, nil
+}
+
+// socketDir diretório dos sockets de métricas dos filhos do processo principal informado
+func socketDir(parentPID int) string {
+	return filepath.Join(os.TempDir(), "api_fibergorm-metrics-"+strconv.Itoa(parentPID))
+}
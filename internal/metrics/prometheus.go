@@ -1,9 +1,12 @@
 package metrics
 
 import (
+	"os"
 	"strconv"
 	"time"
 
+	"api_fibergorm/internal/health"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/prometheus/client_golang/prometheus"
@@ -11,9 +14,23 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// registerer registro das métricas da aplicação
+// Em modo prefork cada processo filho possui seu próprio registro; o label pid
+// evita que as séries de processos diferentes se sobrescrevam na agregação feita pelo
+// processo principal (ver Listener). Consultas devem agregar os processos com sum without (pid).
+var registerer = processRegisterer()
+
+// processRegisterer retorna o registro padrão, com o label pid nos processos filhos do prefork
+func processRegisterer() prometheus.Registerer {
+	if !fiber.IsChild() {
+		return prometheus.DefaultRegisterer
+	}
+	return prometheus.WrapRegistererWith(prometheus.Labels{"pid": strconv.Itoa(os.Getpid())}, prometheus.DefaultRegisterer)
+}
+
 var (
 	// HTTPRequestsTotal contador de requisições HTTP
-	HTTPRequestsTotal = promauto.NewCounterVec(
+	HTTPRequestsTotal = promauto.With(registerer).NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "http_requests_total",
 			Help: "Total de requisições HTTP recebidas",
@@ -22,7 +39,7 @@ var (
 	)
 
 	// HTTPRequestDuration histograma de duração das requisições
-	HTTPRequestDuration = promauto.NewHistogramVec(
+	HTTPRequestDuration = promauto.With(registerer).NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "http_request_duration_seconds",
 			Help:    "Duração das requisições HTTP em segundos",
@@ -32,7 +49,7 @@ var (
 	)
 
 	// HTTPRequestsInFlight gauge de requisições em andamento
-	HTTPRequestsInFlight = promauto.NewGauge(
+	HTTPRequestsInFlight = promauto.With(registerer).NewGauge(
 		prometheus.GaugeOpts{
 			Name: "http_requests_in_flight",
 			Help: "Número de requisições HTTP em processamento",
@@ -40,7 +57,7 @@ var (
 	)
 
 	// HTTPResponseSize histograma do tamanho das respostas
-	HTTPResponseSize = promauto.NewHistogramVec(
+	HTTPResponseSize = promauto.With(registerer).NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "http_response_size_bytes",
 			Help:    "Tamanho das respostas HTTP em bytes",
@@ -49,8 +66,53 @@ var (
 		[]string{"method", "path", "status"},
 	)
 
+	// DeprecatedRequestsTotal contador de requisições a rotas descontinuadas
+	DeprecatedRequestsTotal = promauto.With(registerer).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_deprecated_requests_total",
+			Help: "Total de requisições recebidas por rotas descontinuadas",
+		},
+		[]string{"method", "path"},
+	)
+
+	// RequestsTooLargeTotal contador de requisições rejeitadas por excederem o limite de body
+	RequestsTooLargeTotal = promauto.With(registerer).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_body_too_large_total",
+			Help: "Total de requisições rejeitadas por excederem o tamanho máximo do body",
+		},
+		[]string{"method", "path"},
+	)
+
+	// PanicsTotal contador de panics recuperados durante o processamento das requisições
+	PanicsTotal = promauto.With(registerer).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "panics_total",
+			Help: "Total de panics recuperados durante o processamento das requisições",
+		},
+		[]string{"method", "path"},
+	)
+
+	// HealthCheckUp gauge do resultado de cada health check (1 = disponível, 0 = indisponível)
+	HealthCheckUp = promauto.With(registerer).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "health_check_up",
+			Help: "Resultado do último health check de cada dependência (1 = disponível, 0 = indisponível)",
+		},
+		[]string{"check"},
+	)
+
+	// HealthStatus gauge do estado agregado da aplicação (1 no estado atual, 0 nos demais)
+	HealthStatus = promauto.With(registerer).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "health_status",
+			Help: "Estado agregado dos health checks (1 no estado atual: healthy, degraded ou unhealthy)",
+		},
+		[]string{"status"},
+	)
+
 	// DatabaseQueriesTotal contador de queries no banco de dados
-	DatabaseQueriesTotal = promauto.NewCounterVec(
+	DatabaseQueriesTotal = promauto.With(registerer).NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "database_queries_total",
 			Help: "Total de queries executadas no banco de dados",
@@ -59,7 +121,7 @@ var (
 	)
 
 	// DatabaseQueryDuration histograma de duração das queries
-	DatabaseQueryDuration = promauto.NewHistogramVec(
+	DatabaseQueryDuration = promauto.With(registerer).NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "database_query_duration_seconds",
 			Help:    "Duração das queries no banco de dados em segundos",
@@ -103,7 +165,15 @@ func PrometheusMiddleware() fiber.Handler {
 		// Registra as métricas
 		HTTPRequestsTotal.WithLabelValues(method, path, status).Inc()
 		HTTPRequestDuration.WithLabelValues(method, path, status).Observe(duration)
-		HTTPResponseSize.WithLabelValues(method, path, status).Observe(float64(len(c.Response().Body())))
+		// Respostas em streaming não têm tamanho conhecido (ler o body consumiria o stream)
+		if !c.Response().IsBodyStream() {
+			HTTPResponseSize.WithLabelValues(method, path, status).Observe(float64(len(c.Response().Body())))
+		}
+
+		// Rotas descontinuadas são sinalizadas pelo handler com o cabeçalho Deprecation
+		if len(c.Response().Header.Peek("Deprecation")) > 0 {
+			DeprecatedRequestsTotal.WithLabelValues(method, path).Inc()
+		}
 
 		return err
 	}
@@ -120,3 +190,21 @@ func RecordDatabaseQuery(operation, table string, duration time.Duration) {
 	DatabaseQueryDuration.WithLabelValues(operation, table).Observe(duration.Seconds())
 }
 
+// RecordHealth registra o resultado dos health checks (usado como observador do registro de health checks)
+func RecordHealth(report health.Report) {
+	for name, result := range report.Checks {
+		up := 0.0
+		if result.Status == health.StatusHealthy {
+			up = 1
+		}
+		HealthCheckUp.WithLabelValues(name).Set(up)
+	}
+
+	for _, status := range []health.Status{health.StatusHealthy, health.StatusDegraded, health.StatusUnhealthy} {
+		value := 0.0
+		if report.Status == status {
+			value = 1
+		}
+		HealthStatus.WithLabelValues(string(status)).Set(value)
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"api_fibergorm/pkg/arquitetura/audit"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderUserID cabeçalho com o usuário autenticado (repassado pelo gateway/proxy)
+const HeaderUserID = "X-User-ID"
+
+// AuditMiddleware disponibiliza no contexto quem executa a requisição (X-User-ID) e o ID da requisição,
+// usados na trilha de auditoria (audit.InfoFromContext)
+// Deve ser registrado após o middleware de Request ID
+func AuditMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID, _ := c.Locals("requestid").(string)
+
+		c.SetUserContext(audit.WithInfo(c.UserContext(), audit.Info{
+			Actor:     c.Get(HeaderUserID),
+			RequestID: requestID,
+		}))
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CachePolicy define os cabeçalhos de cache de uma rota
+type CachePolicy struct {
+	MaxAge  time.Duration // Tempo em que a resposta pode ser reutilizada
+	Public  bool          // Permite cache em CDNs/proxies compartilhados (senão private)
+	NoStore bool          // Proíbe qualquer armazenamento da resposta
+}
+
+// RouteCachePolicy associa uma política de cache a um método e rota (padrão registrado no Fiber)
+type RouteCachePolicy struct {
+	Method string // Método HTTP (ex: GET)
+	Path   string // Padrão da rota (ex: /api/v1/categorias/:id)
+	Policy CachePolicy
+}
+
+// Políticas padrão aplicadas quando a rota não declara uma política específica
+var (
+	// NoStorePolicy usada em escritas e respostas de erro
+	NoStorePolicy = CachePolicy{NoStore: true}
+
+	// RevalidatePolicy usada em leituras sem política declarada
+	RevalidatePolicy = CachePolicy{}
+)
+
+// CacheFor cria uma política pública com o tempo de cache informado
+func CacheFor(maxAge time.Duration) CachePolicy {
+	return CachePolicy{MaxAge: maxAge, Public: true}
+}
+
+// Header retorna o valor do cabeçalho Cache-Control da política
+func (p CachePolicy) Header() string {
+	if p.NoStore {
+		return "no-store"
+	}
+	if p.MaxAge <= 0 {
+		return "no-cache"
+	}
+
+	visibility := "private"
+	if p.Public {
+		visibility = "public"
+	}
+	return visibility + ", max-age=" + strconv.Itoa(int(p.MaxAge.Seconds()))
+}
+
+// CacheControlMiddleware aplica as políticas de cache declaradas por rota
+// Escritas (POST, PUT, PATCH, DELETE) e respostas de erro recebem no-store;
+// leituras sem política declarada recebem no-cache.
+// Cabeçalhos definidos explicitamente pelo handler são preservados.
+func CacheControlMiddleware(policies []RouteCachePolicy) fiber.Handler {
+	index := make(map[string]CachePolicy, len(policies))
+	for _, p := range policies {
+		index[strings.ToUpper(p.Method)+" "+p.Path] = p.Policy
+	}
+
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+
+		if c.GetRespHeader(fiber.HeaderCacheControl) != "" {
+			return err
+		}
+
+		policy := RevalidatePolicy
+		method := c.Method()
+		switch {
+		case err != nil || c.Response().StatusCode() >= fiber.StatusBadRequest:
+			policy = NoStorePolicy
+		case method != fiber.MethodGet && method != fiber.MethodHead:
+			policy = NoStorePolicy
+		default:
+			// HEAD segue a política declarada para o GET da mesma rota
+			if declared, ok := index[fiber.MethodGet+" "+c.Route().Path]; ok {
+				policy = declared
+			}
+		}
+
+		applyCachePolicy(c, policy)
+		return err
+	}
+}
+
+// applyCachePolicy escreve os cabeçalhos Cache-Control e Expires
+func applyCachePolicy(c *fiber.Ctx, policy CachePolicy) {
+	c.Set(fiber.HeaderCacheControl, policy.Header())
+
+	if policy.NoStore || policy.MaxAge <= 0 {
+		c.Set(fiber.HeaderExpires, "0")
+		return
+	}
+	c.Set(fiber.HeaderExpires, time.Now().Add(policy.MaxAge).UTC().Format(http.TimeFormat))
+}
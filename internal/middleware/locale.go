@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LocaleMiddleware negocia o idioma da requisição a partir do cabeçalho Accept-Language
+// O idioma resolvido fica disponível em c.Locals("locale") e no contexto (i18n.LocaleFromContext),
+// e é informado ao cliente no cabeçalho Content-Language
+func LocaleMiddleware(supported []string, fallback string) fiber.Handler {
+	if len(supported) == 0 {
+		supported = []string{fallback}
+	}
+
+	return func(c *fiber.Ctx) error {
+		locale := i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage), supported, fallback)
+
+		c.Locals("locale", locale)
+		c.SetUserContext(i18n.WithLocale(c.UserContext(), locale))
+		c.Set(fiber.HeaderContentLanguage, locale)
+		c.Vary(fiber.HeaderAcceptLanguage)
+
+		return c.Next()
+	}
+}
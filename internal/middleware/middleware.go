@@ -1,20 +1,35 @@
 package middleware
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
 	"time"
 
+	"api_fibergorm/internal/authz"
+	"api_fibergorm/internal/config"
+	"api_fibergorm/internal/idempotency"
+	"api_fibergorm/internal/maintenance"
 	"api_fibergorm/internal/metrics"
+	"api_fibergorm/internal/recorder"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 )
 
 // SetupMiddlewares configura os middlewares globais da aplicação
-func SetupMiddlewares(app *fiber.App, log *logrus.Logger) {
-	// Recover middleware para capturar panics
+// rdb é opcional: quando nil, os armazenamentos em memória são utilizados
+func SetupMiddlewares(app *fiber.App, cfg *config.Config, rdb *redis.Client, log *logrus.Logger) {
+	// Recover do Fiber como última proteção contra panics nos middlewares de infraestrutura abaixo
+	// (os panics dos handlers são tratados pelo RecoverMiddleware, registrado após métricas e log)
 	app.Use(recover.New(recover.Config{
 		EnableStackTrace: true,
 	}))
@@ -24,16 +39,132 @@ func SetupMiddlewares(app *fiber.App, log *logrus.Logger) {
 
 	// CORS
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: "*",
-		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
+		AllowOrigins:  "*",
+		AllowMethods:  "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowHeaders:  "Origin, Content-Type, Accept, Accept-Language, Authorization, Idempotency-Key, API-Version, X-User-ID, X-User-Roles, Prefer",
+		ExposeHeaders: "Link, X-Total-Count, Location, Preference-Applied",
 	}))
 
+	// Usuário e Request ID para a trilha de auditoria
+	app.Use(AuditMiddleware())
+
+	// Papéis do usuário para a autorização das operações (X-Admin-Token e, se confiável, X-User-Roles)
+	app.Use(authz.Middleware(authz.Config{
+		TrustRoleHeader: cfg.AuthzTrustRoleHeader,
+		TrustedProxies:  cfg.AuthzTrustedProxies,
+		AdminToken:      cfg.AdminToken,
+	}, log))
+
+	// Negociação de idioma (Accept-Language)
+	app.Use(LocaleMiddleware(cfg.SupportedLocales, cfg.DefaultLocale))
+
+	// Prazo máximo de processamento de cada requisição
+	app.Use(TimeoutMiddleware(time.Duration(cfg.RequestTimeout)*time.Second, log))
+
 	// Prometheus metrics middleware
 	app.Use(metrics.PrometheusMiddleware())
 
 	// Logger middleware customizado com Logrus
 	app.Use(LoggerMiddleware(log))
+
+	// Panics: log com contexto da requisição, métrica panics_total, rastreador de erros e 500 padronizado
+	// Registrado após métricas e log para que a requisição também seja contabilizada com status 500
+	app.Use(RecoverMiddleware(log))
+
+	// Modo de manutenção (escritas respondidas com 503 + Retry-After)
+	maintenance.Setup(maintenance.Config{
+		Enabled:    cfg.MaintenanceMode,
+		AllowReads: cfg.MaintenanceAllowReads,
+		RetryAfter: cfg.MaintenanceRetryAfter,
+	}, log)
+	app.Use(maintenance.Default().Middleware())
+
+	// Gravação de requisições/respostas para debug (desabilitada por padrão)
+	recorder.Setup(recorder.Config{
+		Enabled:     cfg.DebugRecordEnabled,
+		Routes:      cfg.DebugRecordRoutes,
+		Size:        cfg.DebugRecordSize,
+		MaxBodySize: cfg.DebugRecordMaxBody,
+	})
+	if cfg.DebugRecordEnabled {
+		log.WithFields(logrus.Fields{
+			"routes": cfg.DebugRecordRoutes,
+			"size":   cfg.DebugRecordSize,
+		}).Warn("Modo de gravação de requisições habilitado")
+	}
+	app.Use(recorder.Default().Middleware())
+
+	// Replay de respostas para POST com Idempotency-Key
+	var idempotencyStore idempotency.Store = idempotency.NewMemoryStore()
+	if rdb != nil {
+		idempotencyStore = idempotency.NewRedisStore(rdb)
+	}
+	app.Use(idempotency.Middleware(idempotency.Config{
+		Enabled: cfg.IdempotencyEnabled,
+		TTL:     time.Duration(cfg.IdempotencyTTLHours) * time.Hour,
+		Store:   idempotencyStore,
+	}, log))
+}
+
+// TimeoutMiddleware define um prazo para o processamento da requisição
+// O deadline é propagado via c.UserContext() até os serviços e as queries no banco;
+// se for excedido, a resposta é substituída por 503 no formato padrão de erro
+func TimeoutMiddleware(timeout time.Duration, log *logrus.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if timeout <= 0 {
+			return c.Next()
+		}
+
+		ctx, cancel := context.WithTimeout(c.UserContext(), timeout)
+		defer cancel()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			log.WithFields(logrus.Fields{
+				"request_id": c.Locals("requestid"),
+				"method":     c.Method(),
+				"path":       c.Path(),
+				"timeout":    timeout.String(),
+			}).Warn("Tempo limite da requisição excedido")
+
+			c.Response().ResetBody()
+			return arqhandler.SendError(c, fiber.StatusServiceUnavailable, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeTimeout,
+				Error: arqhandler.Message(c, i18n.MsgTimeout, nil),
+			})
+		}
+
+		return err
+	}
+}
+
+// AdminAuth middleware que protege as rotas administrativas com o token configurado
+// Se o token não estiver configurado, as rotas administrativas ficam indisponíveis
+func AdminAuth(token string, log *logrus.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return arqhandler.SendError(c, fiber.StatusForbidden, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeAdminDisabled,
+				Error: arqhandler.Message(c, i18n.MsgAdminDisabled, nil),
+			})
+		}
+
+		provided := c.Get("X-Admin-Token")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			log.WithFields(logrus.Fields{
+				"path": c.Path(),
+				"ip":   c.IP(),
+			}).Warn("Acesso administrativo negado")
+			return arqhandler.SendError(c, fiber.StatusUnauthorized, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeUnauthorized,
+				Error: arqhandler.Message(c, i18n.MsgUnauthorized, nil),
+			})
+		}
+
+		return c.Next()
+	}
 }
 
 // LoggerMiddleware middleware para logging das requisições
@@ -48,7 +179,7 @@ func LoggerMiddleware(log *logrus.Logger) fiber.Handler {
 		latency := time.Since(start)
 
 		// Log da requisição
-		log.WithFields(logrus.Fields{
+		fields := logrus.Fields{
 			"request_id": c.Locals("requestid"),
 			"method":     c.Method(),
 			"path":       c.Path(),
@@ -56,7 +187,11 @@ func LoggerMiddleware(log *logrus.Logger) fiber.Handler {
 			"latency":    latency.String(),
 			"ip":         c.IP(),
 			"user_agent": c.Get("User-Agent"),
-		}).Info("Requisição HTTP")
+		}
+		if traceID := arqhandler.TraceID(c); traceID != "" {
+			fields["trace_id"] = traceID
+		}
+		log.WithFields(fields).Info("Requisição HTTP")
 
 		return err
 	}
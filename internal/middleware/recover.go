@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"api_fibergorm/internal/metrics"
+	"api_fibergorm/internal/tracker"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// unmatchedRoute label de rota das métricas das requisições sem rota correspondente
+const unmatchedRoute = "unmatched"
+
+// routeLabel retorna o padrão da rota para os labels das métricas (cardinalidade limitada); sem rota
+// correspondente (ex: ErrorHandler do fasthttp, em que o Fiber devolve o path original), um valor fixo
+func routeLabel(c *fiber.Ctx) string {
+	route := c.Route()
+	if route.Path == "" || len(route.Handlers) == 0 {
+		return unmatchedRoute
+	}
+	return route.Path
+}
+
+// RecoverMiddleware captura panics nas requisições
+// O panic é registrado no log com o contexto da requisição e o stack trace, contabilizado em
+// panics_total, reportado ao rastreador de erros e respondido com 500 no formato padrão de erro
+// (sem expor detalhes internos ao cliente)
+func RecoverMiddleware(log *logrus.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) (err error) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+
+			stack := string(debug.Stack())
+			route := routeLabel(c)
+
+			// As tags são enviadas ao rastreador em segundo plano, após a requisição: os valores do Fiber
+			// referenciam buffers reutilizados e são copiados
+			method := utils.CopyString(c.Method())
+			tags := map[string]string{
+				"request_id": utils.CopyString(arqhandler.RequestID(c)),
+				"method":     method,
+				"path":       utils.CopyString(c.Path()),
+				"route":      route,
+			}
+			if traceID := arqhandler.TraceID(c); traceID != "" {
+				tags["trace_id"] = utils.CopyString(traceID)
+			}
+			if actor := c.Get(HeaderUserID); actor != "" {
+				tags["actor"] = utils.CopyString(actor)
+			}
+
+			fields := logrus.Fields{
+				"panic": fmt.Sprint(r),
+				"stack": stack,
+			}
+			for key, value := range tags {
+				fields[key] = value
+			}
+			log.WithFields(fields).Error("Panic ao processar requisição")
+
+			metrics.PanicsTotal.WithLabelValues(method, route).Inc()
+
+			tracker.Default().Report(c.UserContext(), tracker.Event{
+				Message: fmt.Sprintf("panic: %v", r),
+				Stack:   stack,
+				Tags:    tags,
+			})
+
+			c.Response().ResetBody()
+			err = arqhandler.SendError(c, fiber.StatusInternalServerError, arqdto.ErrorResponse{
+				Code:  arqerrors.CodeInternal,
+				Error: arqhandler.Message(c, i18n.MsgInternal, nil),
+			})
+		}()
+
+		return c.Next()
+	}
+}
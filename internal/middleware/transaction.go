@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"time"
+
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// TransactionMiddleware executa a requisição em uma única transação do banco de dados
+// A transação é propagada no c.UserContext() e usada pelos repositórios (repository.WithContext)
+// e pela auditoria. O commit ocorre apenas em respostas 2xx: erros, respostas não-2xx (inclusive
+// falhas de validação após escritas parciais) e panics desfazem todas as escritas da requisição
+// Falhas de serialização/deadlock (repository.TxConflicted) desfazem a transação e executam novamente o
+// handler final da rota em uma nova transação, conforme a política de repetição dos repositórios
+// (DB_RETRY_ATTEMPTS); o middleware é inserido imediatamente antes dele (handler.WithTransaction)
+func TransactionMiddleware(db *gorm.DB, log *logrus.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Transação já aberta (ex: middleware registrado em grupo e rota)
+		if _, ok := repository.TxFromContext(c.UserContext()); ok {
+			return c.Next()
+		}
+
+		policy := repository.DefaultOptions().Retry
+		parent := c.UserContext()
+		next := c.Next
+		for attempt := 1; ; attempt++ {
+			retry, err := runTransaction(c, db, log, next, attempt < policy.MaxAttempts)
+			if !retry {
+				return err
+			}
+
+			timer := time.NewTimer(policy.Delay(attempt))
+			select {
+			case <-parent.Done():
+				timer.Stop()
+				return parent.Err()
+			case <-timer.C:
+			}
+
+			// Nova tentativa a partir do estado da requisição antes do handler
+			c.SetUserContext(parent)
+			c.Response().ResetBody()
+			c.Status(fiber.StatusOK)
+			handlers := c.Route().Handlers
+			final := handlers[len(handlers)-1]
+			next = func() error { return final(c) }
+		}
+	}
+}
+
+// runTransaction executa next em uma transação; retry indica que a transação foi desfeita por conflito de
+// concorrência e deve ser repetida (apenas com canRetry)
+func runTransaction(c *fiber.Ctx, db *gorm.DB, log *logrus.Logger, next func() error, canRetry bool) (retry bool, err error) {
+	tx := db.WithContext(c.UserContext()).Begin()
+	if tx.Error != nil {
+		log.WithError(tx.Error).Error("Falha ao iniciar transação da requisição")
+		return false, arqhandler.SendError(c, fiber.StatusServiceUnavailable, arqdto.ErrorResponse{
+			Code:  arqerrors.CodeServiceUnavailable,
+			Error: arqhandler.Message(c, i18n.MsgDatabaseDown, nil),
+		})
+	}
+
+	fields := logrus.Fields{
+		"request_id": arqhandler.RequestID(c),
+		"method":     c.Method(),
+		"path":       c.Path(),
+	}
+
+	finished := false
+	defer func() {
+		if finished {
+			return
+		}
+		// Panic ou resposta de falha: desfaz as escritas (o panic segue para o RecoverMiddleware)
+		if rbErr := tx.Rollback().Error; rbErr != nil {
+			log.WithError(rbErr).WithFields(fields).Error("Falha ao desfazer transação da requisição")
+		}
+	}()
+
+	txCtx := repository.ContextWithTx(c.UserContext(), tx)
+	c.SetUserContext(txCtx)
+
+	err = next()
+	if canRetry && (repository.TxConflicted(txCtx) || repository.IsRetryable(err)) {
+		log.WithFields(fields).Info("Transação desfeita: conflito de concorrência, requisição repetida")
+		return true, nil
+	}
+	if err != nil {
+		log.WithFields(fields).Debug("Transação desfeita: erro no handler")
+		return false, err
+	}
+
+	status := c.Response().StatusCode()
+	if status < fiber.StatusOK || status >= fiber.StatusMultipleChoices {
+		log.WithFields(fields).WithField("status", status).Debug("Transação desfeita: resposta sem sucesso")
+		return false, nil
+	}
+
+	// Após uma falha no commit a transação já está encerrada e não é desfeita novamente
+	finished = true
+	if commitErr := tx.Commit().Error; commitErr != nil {
+		if canRetry && repository.IsRetryable(commitErr) {
+			log.WithFields(fields).Info("Commit rejeitado: conflito de concorrência, requisição repetida")
+			return true, nil
+		}
+		log.WithError(commitErr).WithFields(fields).Error("Falha ao confirmar transação da requisição")
+		c.Response().ResetBody()
+		return false, arqhandler.SendError(c, fiber.StatusInternalServerError, arqdto.ErrorResponse{
+			Code:  arqerrors.CodeInternal,
+			Error: arqhandler.Message(c, i18n.MsgCommitFailed, nil),
+		})
+	}
+
+	// Efeitos agendados para depois do commit (ex: invalidação de cache)
+	repository.RunAfterCommit(txCtx)
+	return false, nil
+}
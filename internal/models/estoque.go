@@ -0,0 +1,21 @@
+package models
+
+import (
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// Estoque representa a quantidade em estoque de um produto (um registro por produto)
+// Criado com quantidade zero junto com o produto (ver produtoHooks.AfterCreate)
+type Estoque struct {
+	entity.BaseEntity
+	ProdutoID  uint `gorm:"uniqueIndex;not null" json:"produto_id"`
+	Quantidade int  `gorm:"not null;default:0" json:"quantidade"`
+
+	// Chave estrangeira para Produto (removido junto com o produto na exclusão definitiva)
+	Produto Produto `gorm:"foreignKey:ProdutoID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName define o nome da tabela no banco de dados
+func (Estoque) TableName() string {
+	return "estoques"
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// PrecoHistorico registra cada alteração de preço de um produto (ver produtoHooks.AfterUpdate)
+// A autoria (created_by) é preenchida com o usuário da requisição por AuditedEntity
+type PrecoHistorico struct {
+	entity.AuditedEntity
+	ProdutoID     uint    `gorm:"index;not null" json:"produto_id"`
+	PrecoAnterior float64 `gorm:"type:decimal(10,2);not null" json:"preco_anterior"`
+	PrecoNovo     float64 `gorm:"type:decimal(10,2);not null" json:"preco_novo"`
+
+	// Chave estrangeira para Produto (removido junto com o produto na exclusão definitiva)
+	Produto Produto `gorm:"foreignKey:ProdutoID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName define o nome da tabela no banco de dados
+func (PrecoHistorico) TableName() string {
+	return "historico_precos"
+}
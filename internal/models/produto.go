@@ -5,8 +5,9 @@ import (
 )
 
 // Produto representa a entidade de produto no banco de dados
+// Registra quem criou e quem alterou por último (ex: alterações de preço) com AuditedEntity
 type Produto struct {
-	entity.BaseEntity
+	entity.AuditedEntity
 	Codigo    string  `gorm:"type:varchar(50);uniqueIndex;not null" json:"codigo"`
 	Descricao string  `gorm:"type:varchar(255);not null" json:"descricao"`
 	Preco     float64 `gorm:"type:decimal(10,2);not null" json:"preco"`
@@ -20,3 +21,11 @@ type Produto struct {
 func (Produto) TableName() string {
 	return "produtos"
 }
+
+// SearchColumns define as colunas da busca textual (GET /produtos/search): código com mais peso que a descrição
+func (Produto) SearchColumns() []entity.SearchColumn {
+	return []entity.SearchColumn{
+		{Name: "codigo", Weight: "A"},
+		{Name: "descricao", Weight: "B"},
+	}
+}
@@ -0,0 +1,276 @@
+package recorder
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// Config configurações do modo de gravação de requisições
+type Config struct {
+	Enabled     bool     // Se a gravação está habilitada
+	Routes      []string // Prefixos de rota gravados (vazio = todas as rotas da API)
+	Size        int      // Quantidade máxima de entradas no buffer circular (padrão: 100)
+	MaxBodySize int      // Tamanho máximo do body gravado em bytes (padrão: 4096)
+}
+
+// Entry representa um par requisição/resposta gravado
+type Entry struct {
+	ID              uint64            `json:"id"`
+	Timestamp       time.Time         `json:"timestamp"`
+	RequestID       string            `json:"request_id"`
+	Method          string            `json:"method"`
+	Path            string            `json:"path"`
+	Query           string            `json:"query,omitempty"`
+	Status          int               `json:"status"`
+	Latency         string            `json:"latency"`
+	RequestHeaders  map[string]string `json:"request_headers"`
+	RequestBody     string            `json:"request_body,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers"`
+	ResponseBody    string            `json:"response_body,omitempty"`
+}
+
+// Recorder armazena as últimas requisições gravadas em um buffer circular
+type Recorder struct {
+	config  Config
+	entries []Entry
+	next    int
+	count   int
+	seq     uint64
+	mutex   sync.RWMutex
+}
+
+// sensitiveHeaders cabeçalhos que nunca são gravados em texto claro
+var sensitiveHeaders = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+	"x-admin-token": true,
+	"x-api-key":     true,
+}
+
+// sensitiveFields trechos de nomes de campos JSON mascarados no body
+var sensitiveFields = []string{"password", "senha", "token", "secret", "authorization"}
+
+// redacted valor utilizado no lugar de dados sensíveis
+const redacted = "***"
+
+// defaultRecorder instância utilizada pelo middleware e pelo endpoint administrativo
+var defaultRecorder = New(Config{})
+
+// New cria um novo recorder
+func New(config Config) *Recorder {
+	if config.Size <= 0 {
+		config.Size = 100
+	}
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = 4096
+	}
+
+	return &Recorder{
+		config:  config,
+		entries: make([]Entry, config.Size),
+	}
+}
+
+// Setup configura o recorder padrão da aplicação
+func Setup(config Config) {
+	defaultRecorder = New(config)
+}
+
+// Default retorna o recorder padrão da aplicação
+func Default() *Recorder {
+	return defaultRecorder
+}
+
+// Enabled indica se a gravação está habilitada
+func (r *Recorder) Enabled() bool {
+	return r.config.Enabled
+}
+
+// Middleware retorna o middleware que grava as requisições das rotas configuradas
+func (r *Recorder) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !r.config.Enabled || !r.shouldRecord(c.Path()) {
+			return c.Next()
+		}
+
+		start := time.Now()
+		requestBody := string(c.Body())
+
+		// O erro do handler é convertido na resposta pelo ErrorHandler antes da gravação, para registrar o
+		// status e o body enviados ao cliente (não o 200 vazio anterior ao tratamento)
+		if err := c.Next(); err != nil {
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		// Os valores do Fiber referenciam buffers reutilizados após a requisição: a entrada guarda cópias
+		requestID, _ := c.Locals("requestid").(string)
+
+		// Respostas em streaming não são gravadas (ler o body consumiria o stream)
+		responseBody := "[stream]"
+		if !c.Response().IsBodyStream() {
+			responseBody = r.sanitizeBody(string(c.Response().Body()))
+		}
+
+		r.add(Entry{
+			Timestamp:       start,
+			RequestID:       utils.CopyString(requestID),
+			Method:          utils.CopyString(c.Method()),
+			Path:            utils.CopyString(c.Path()),
+			Query:           string(c.Request().URI().QueryString()),
+			Status:          c.Response().StatusCode(),
+			Latency:         time.Since(start).String(),
+			RequestHeaders:  sanitizeHeaders(c.GetReqHeaders()),
+			RequestBody:     r.sanitizeBody(requestBody),
+			ResponseHeaders: sanitizeHeaders(c.GetRespHeaders()),
+			ResponseBody:    responseBody,
+		})
+
+		return nil
+	}
+}
+
+// Entries retorna as entradas gravadas, da mais recente para a mais antiga
+func (r *Recorder) Entries() []Entry {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make([]Entry, 0, r.count)
+	for i := 1; i <= r.count; i++ {
+		idx := (r.next - i + len(r.entries)) % len(r.entries)
+		result = append(result, r.entries[idx])
+	}
+	return result
+}
+
+// Clear remove todas as entradas gravadas
+func (r *Recorder) Clear() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.entries = make([]Entry, len(r.entries))
+	r.next = 0
+	r.count = 0
+}
+
+// ListHandler retorna o handler que expõe as entradas gravadas
+func (r *Recorder) ListHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		entries := r.Entries()
+		return c.JSON(fiber.Map{
+			"enabled": r.config.Enabled,
+			"routes":  r.config.Routes,
+			"size":    len(r.entries),
+			"total":   len(entries),
+			"entries": entries,
+		})
+	}
+}
+
+// ClearHandler retorna o handler que limpa as entradas gravadas
+func (r *Recorder) ClearHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		r.Clear()
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// add insere uma entrada no buffer circular, sobrescrevendo a mais antiga
+func (r *Recorder) add(entry Entry) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.seq++
+	entry.ID = r.seq
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+	if r.count < len(r.entries) {
+		r.count++
+	}
+}
+
+// shouldRecord verifica se o path pertence às rotas configuradas
+func (r *Recorder) shouldRecord(path string) bool {
+	if len(r.config.Routes) == 0 {
+		return strings.HasPrefix(path, "/api/")
+	}
+	for _, route := range r.config.Routes {
+		if strings.HasPrefix(path, route) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeBody mascara campos sensíveis e trunca o body no tamanho máximo
+func (r *Recorder) sanitizeBody(body string) string {
+	if body == "" {
+		return ""
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(body), &data); err == nil {
+		if sanitized, err := json.Marshal(sanitizeValue(data)); err == nil {
+			body = string(sanitized)
+		}
+	}
+
+	if len(body) > r.config.MaxBodySize {
+		return body[:r.config.MaxBodySize] + "...(truncado)"
+	}
+	return body
+}
+
+// sanitizeValue percorre o JSON mascarando os campos sensíveis
+func sanitizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveField(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = sanitizeValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = sanitizeValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// isSensitiveField verifica se o nome do campo indica um dado sensível
+func isSensitiveField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, field := range sensitiveFields {
+		if strings.Contains(lower, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeHeaders achata os cabeçalhos e mascara os sensíveis, copiando nomes e valores
+func sanitizeHeaders(headers map[string][]string) map[string]string {
+	result := make(map[string]string, len(headers))
+	for key, values := range headers {
+		key = utils.CopyString(key)
+		if sensitiveHeaders[strings.ToLower(key)] {
+			result[key] = redacted
+			continue
+		}
+		result[key] = utils.CopyString(strings.Join(values, ", "))
+	}
+	return result
+}
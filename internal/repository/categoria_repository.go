@@ -5,6 +5,7 @@ import (
 	"api_fibergorm/pkg/arquitetura/repository"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CategoriaRepository é o repositório específico para Categoria
@@ -14,10 +15,16 @@ type CategoriaRepository struct {
 	*repository.BaseRepositoryImpl[*models.Categoria]
 }
 
+// ScopeAtivas escopo das categorias ativas (ex: repo.Scoped(ScopeAtivas))
+const ScopeAtivas = "Ativas"
+
 // NewCategoriaRepository cria uma nova instância do repositório de categorias
 func NewCategoriaRepository(db *gorm.DB) *CategoriaRepository {
 	baseRepo := repository.NewBaseRepository[*models.Categoria](db).
-		WithDefaultOrder("nome ASC")
+		WithDefaultOrder("nome ASC").
+		WithScope(ScopeAtivas, func(db *gorm.DB) *gorm.DB {
+			return db.Where(clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "ativo"}, Value: true})
+		})
 
 	return &CategoriaRepository{
 		BaseRepositoryImpl: baseRepo,
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"api_fibergorm/internal/models"
 	"api_fibergorm/pkg/arquitetura/repository"
 
@@ -25,6 +27,53 @@ func NewProdutoRepository(db *gorm.DB) *ProdutoRepository {
 	}
 }
 
-// Métodos específicos de Produto podem ser adicionados aqui conforme necessidade
-// Exemplo:
-// func (r *ProdutoRepository) FindByCategoria(categoriaID uint) ([]*models.Produto, error) { ... }
+// ResumoCategoria totais dos produtos de uma categoria
+type ResumoCategoria struct {
+	CategoriaID uint
+	Nome        string
+	Ativo       bool
+	Produtos    int64
+	PrecoMedio  float64
+	PrecoMin    float64
+	PrecoMax    float64
+	ValorTotal  float64
+}
+
+// FiltroResumoCategoria filtros do resumo por categoria (campos nil não filtram)
+type FiltroResumoCategoria struct {
+	CategoriaID *uint
+	PrecoMin    *float64
+	PrecoMax    *float64
+}
+
+// ResumoPorCategoria retorna a quantidade e os preços dos produtos de cada categoria, considerando
+// apenas os produtos na faixa de preço do filtro. Categorias sem produtos na faixa aparecem zeradas
+func (r *ProdutoRepository) ResumoPorCategoria(ctx context.Context, filtro FiltroResumoCategoria) ([]ResumoCategoria, error) {
+	join := "LEFT JOIN produtos AS p ON p.categoria_id = c.id AND p.deleted_at IS NULL"
+	var args []interface{}
+	if filtro.PrecoMin != nil {
+		join += " AND p.preco >= ?"
+		args = append(args, *filtro.PrecoMin)
+	}
+	if filtro.PrecoMax != nil {
+		join += " AND p.preco <= ?"
+		args = append(args, *filtro.PrecoMax)
+	}
+
+	query := r.WithContext(ctx).GetDB().
+		Table("categorias AS c").
+		Select("c.id AS categoria_id, c.nome, c.ativo, COUNT(p.id) AS produtos, "+
+			"COALESCE(AVG(p.preco), 0) AS preco_medio, COALESCE(MIN(p.preco), 0) AS preco_min, "+
+			"COALESCE(MAX(p.preco), 0) AS preco_max, COALESCE(SUM(p.preco), 0) AS valor_total").
+		Joins(join, args...).
+		Where("c.deleted_at IS NULL")
+	if filtro.CategoriaID != nil {
+		query = query.Where("c.id = ?", *filtro.CategoriaID)
+	}
+
+	var rows []ResumoCategoria
+	err := query.Group("c.id, c.nome, c.ativo").
+		Order("c.nome").
+		Scan(&rows).Error
+	return rows, err
+}
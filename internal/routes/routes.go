@@ -1,20 +1,74 @@
 package routes
 
 import (
+	"fmt"
+	"time"
+
+	"api_fibergorm/internal/config"
 	"api_fibergorm/internal/handler"
+	"api_fibergorm/internal/health"
+	"api_fibergorm/internal/jobs"
+	"api_fibergorm/internal/logging"
+	"api_fibergorm/internal/maintenance"
 	"api_fibergorm/internal/metrics"
+	"api_fibergorm/internal/middleware"
+	"api_fibergorm/internal/recorder"
 	"api_fibergorm/internal/service"
+	"api_fibergorm/internal/shutdown"
+	"api_fibergorm/pkg/arquitetura/cache"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/outbox"
+	"api_fibergorm/pkg/arquitetura/report"
+	"api_fibergorm/pkg/arquitetura/selfcheck"
+	"api_fibergorm/pkg/arquitetura/versioning"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/swagger"
+	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
 	_ "api_fibergorm/docs" // Importa a documentação gerada pelo swag
 )
 
+// cachePolicies declara as políticas de Cache-Control por rota
+// Rotas não listadas: leituras recebem no-cache e escritas no-store
+var cachePolicies = []middleware.RouteCachePolicy{
+	{Method: fiber.MethodGet, Path: "/api/v1/categorias/ativas", Policy: middleware.CacheFor(60 * time.Second)},
+}
+
+// uploadRoutes prefixos de rota que aceitam bodies grandes (importações/uploads)
+// Demais rotas utilizam o limite do CRUD JSON (BODY_LIMIT_KB)
+var uploadRoutes = []string{
+	"/api/v1/categorias/bulk",
+	"/api/v1/categorias/import",
+	"/api/v1/produtos/bulk",
+	"/api/v1/produtos/import",
+}
+
+// apiVersions versões da API disponíveis
+// Uma nova versão monta handlers próprios (DTOs da versão) sobre os mesmos serviços
+// com handler.NewVersionedService
+var apiVersions = []string{"v1"}
+
 // SetupRoutes configura todas as rotas da aplicação
-func SetupRoutes(app *fiber.App, db *gorm.DB, log *logrus.Logger) {
+// Retorna erro quando a configuração das rotas é inválida (ex: versões da API), para que a fase de
+// inicialização falhe com o encerramento ordenado das dependências já abertas
+func SetupRoutes(app *fiber.App, db *gorm.DB, rdb *redis.Client, cfg *config.Config, log *logrus.Logger) error {
+	// Cabeçalhos de cache declarados por rota
+	app.Use(middleware.CacheControlMiddleware(cachePolicies))
+
+	// Limite de tamanho do body por rota
+	bodyLimits := middleware.BodyLimitConfig{
+		Default:   cfg.BodyLimitKB * 1024,
+		Overrides: make(map[string]int, len(uploadRoutes)),
+	}
+	for _, prefix := range uploadRoutes {
+		bodyLimits.Overrides[prefix] = cfg.BodyLimitUploadMB * 1024 * 1024
+	}
+	app.Use(middleware.BodyLimitMiddleware(bodyLimits, log))
+
 	// Swagger
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
@@ -29,21 +83,111 @@ func SetupRoutes(app *fiber.App, db *gorm.DB, log *logrus.Logger) {
 		})
 	})
 
+	// Readiness check: agrega os health checks das dependências (banco, Redis, Loki, ...)
+	// healthy e degraded respondem 200; unhealthy (dependência crítica indisponível) responde 503
+	app.Get("/readyz", func(c *fiber.Ctx) error {
+		report := health.Default().Check(c.UserContext())
+		if report.Status == health.StatusUnhealthy {
+			log.WithField("checks", report.Checks).Warn("Readiness check falhou")
+			return c.Status(fiber.StatusServiceUnavailable).JSON(report)
+		}
+		if report.Status == health.StatusDegraded {
+			log.WithField("checks", report.Checks).Warn("Aplicação degradada")
+		}
+		return c.JSON(report)
+	})
+
+	// Cache compartilhado pelos serviços (Redis quando configurado, senão memória)
+	appCache := cache.New(rdb)
+
+	// Rotas administrativas e exclusões definitivas (protegidas por token)
+	// As ferramentas de operação ficam no grupo /admin, fora da API pública (/api) e do Swagger
+	adminGuard := middleware.AdminAuth(cfg.AdminToken, log)
+	admin := app.Group("/admin", adminGuard)
+	setupAdminRoutes(admin, cfg, appCache, log)
+
+	// Versões da API (/api/v1, ...) e negociação opcional pelo header API-Version
+	versions, err := versioning.New(app, versioning.Config{
+		Prefix:      "/api",
+		Versions:    apiVersions,
+		Default:     cfg.APIDefaultVersion,
+		Negotiation: cfg.APIVersionNegotiation,
+	})
+	if err != nil {
+		return fmt.Errorf("configuração de versões da API inválida: %w", err)
+	}
+
 	// API v1
-	api := app.Group("/api/v1")
+	api := versions.Group("v1")
+
+	// Catálogo dos códigos de erro (campo code das respostas de erro)
+	api.Get("/erros", func(c *fiber.Ctx) error {
+		return c.JSON(arqerrors.Catalog())
+	})
 
 	// Setup das rotas usando a nova arquitetura
-	setupCategoriaRoutes(api, db, log)
-	setupProdutoRoutes(api, db, log)
+	setupCategoriaRoutes(api, db, service.CategoriaCacheConfig{
+		Cache:     appCache,
+		AtivasTTL: time.Duration(cfg.CacheAtivasTTL) * time.Second,
+	}, service.CategoriaAuthzConfig{
+		DeleteRoles: cfg.CategoriaDeleteRoles,
+	}, adminGuard, log)
+	setupProdutoRoutes(api, db, service.ProdutoCacheConfig{
+		Cache: appCache,
+		TTL:   time.Duration(cfg.CacheProdutosTTL) * time.Second,
+	}, produtoOutboxConfig(db, cfg), adminGuard, log)
+	setupEstatisticasRoutes(api, db, adminGuard, log)
+	setupAuditoriaRoutes(api, db, adminGuard, log)
+	setupRelatorioRoutes(api, db, cfg, log)
+	return nil
+}
+
+// setupAdminRoutes configura as rotas administrativas
+func setupAdminRoutes(router fiber.Router, cfg *config.Config, appCache cache.Cache, log *logrus.Logger) {
+	// Configurações em uso (segredos mascarados)
+	router.Get("/config", func(c *fiber.Ctx) error {
+		return c.JSON(cfg.Redacted())
+	})
+
+	// Requisições gravadas pelo modo de debug
+	router.Get("/debug/recordings", recorder.Default().ListHandler())
+	router.Delete("/debug/recordings", recorder.Default().ClearHandler())
+
+	// Modo de manutenção
+	router.Get("/maintenance", maintenance.Default().StatusHandler())
+	router.Put("/maintenance", maintenance.Default().UpdateHandler())
+
+	// Nível de log em tempo de execução
+	levelSwitch := logging.NewLevelSwitch(log)
+	router.Get("/log-level", levelSwitch.StatusHandler())
+	router.Put("/log-level", levelSwitch.UpdateHandler())
+
+	// Limpeza do cache compartilhado (ex: após correção manual de dados no banco)
+	router.Delete("/cache", func(c *fiber.Ctx) error {
+		if err := appCache.Clear(c.UserContext()); err != nil {
+			log.WithError(err).Error("Erro ao limpar o cache")
+			return err
+		}
+		log.WithField("ip", c.IP()).Warn("Cache limpo via /admin")
+		return c.SendStatus(fiber.StatusNoContent)
+	})
+
+	// Estado dos jobs em segundo plano
+	router.Get("/jobs", jobs.Default().StatusHandler())
+
+	// Rotas montadas e resultado da verificação da montagem
+	router.Get("/routes", selfcheck.RoutesHandler())
 }
 
 // setupCategoriaRoutes configura as rotas de categorias
-func setupCategoriaRoutes(router fiber.Router, db *gorm.DB, log *logrus.Logger) {
+func setupCategoriaRoutes(router fiber.Router, db *gorm.DB, cacheConfig service.CategoriaCacheConfig, authzConfig service.CategoriaAuthzConfig, adminGuard fiber.Handler, log *logrus.Logger) {
 	// Cria o serviço (que já configura repositório, mapper e validator internamente)
-	categoriaService := service.NewCategoriaService(db, log)
+	categoriaService := service.NewCategoriaService(db, cacheConfig, authzConfig, log)
 
 	// Cria o handler
 	categoriaHandler := handler.NewCategoriaHandler(categoriaService, log)
+	categoriaHandler.WithPermanentDeleteGuard(adminGuard).
+		WithTransaction(middleware.TransactionMiddleware(db, log))
 
 	// Registra as rotas
 	categorias := router.Group("/categorias")
@@ -51,14 +195,67 @@ func setupCategoriaRoutes(router fiber.Router, db *gorm.DB, log *logrus.Logger)
 }
 
 // setupProdutoRoutes configura as rotas de produtos
-func setupProdutoRoutes(router fiber.Router, db *gorm.DB, log *logrus.Logger) {
+func setupProdutoRoutes(router fiber.Router, db *gorm.DB, cacheConfig service.ProdutoCacheConfig, outboxConfig service.ProdutoOutboxConfig, adminGuard fiber.Handler, log *logrus.Logger) {
 	// Cria o serviço (que já configura repositório, mapper e validator internamente)
-	produtoService := service.NewProdutoService(db, log)
+	produtoService := service.NewProdutoService(db, cacheConfig, outboxConfig, log)
 
 	// Cria o handler
 	produtoHandler := handler.NewProdutoHandler(produtoService, log)
+	produtoHandler.WithPermanentDeleteGuard(adminGuard).
+		WithTransaction(middleware.TransactionMiddleware(db, log))
 
 	// Registra as rotas
 	produtos := router.Group("/produtos")
 	produtoHandler.RegisterRoutes(produtos)
+
+	// Produtos como sub-recurso da categoria (o ID da categoria é validado e injetado em categoria_id)
+	produtoCategoriaHandler := handler.NewProdutoCategoriaHandler(produtoService, log)
+	produtoCategoriaHandler.WithTransaction(middleware.TransactionMiddleware(db, log))
+	produtoCategoriaHandler.RegisterRoutes(router.Group("/categorias/:categoria_id/produtos"))
+}
+
+// produtoOutboxConfig configura os eventos de integração de produtos (desabilitados com OUTBOX_BROKER=none)
+func produtoOutboxConfig(db *gorm.DB, cfg *config.Config) service.ProdutoOutboxConfig {
+	if !cfg.Outbox().Enabled() {
+		return service.ProdutoOutboxConfig{}
+	}
+	return service.ProdutoOutboxConfig{Outbox: outbox.New(db), Topic: cfg.OutboxTopicProdutos}
+}
+
+// setupEstatisticasRoutes configura a rota de estatísticas do painel administrativo
+// Protegida pelo token administrativo (adminGuard), como as rotas /admin
+func setupEstatisticasRoutes(router fiber.Router, db *gorm.DB, adminGuard fiber.Handler, log *logrus.Logger) {
+	estatisticasService := service.NewEstatisticasService(db, log)
+	estatisticasHandler := handler.NewEstatisticasHandler(estatisticasService, log)
+
+	estatisticas := router.Group("/estatisticas", adminGuard)
+	estatisticasHandler.RegisterRoutes(estatisticas)
+}
+
+// setupAuditoriaRoutes configura a consulta à trilha de auditoria de todas as entidades
+// Protegida pelo token administrativo (adminGuard): a trilha expõe usuários e valores de todas as entidades
+func setupAuditoriaRoutes(router fiber.Router, db *gorm.DB, adminGuard fiber.Handler, log *logrus.Logger) {
+	auditoriaService := service.NewAuditoriaService(db, log)
+	auditoriaHandler := handler.NewAuditoriaHandler(auditoriaService, log)
+
+	auditoria := router.Group("/auditoria", adminGuard)
+	auditoriaHandler.RegisterRoutes(auditoria)
+}
+
+// setupRelatorioRoutes configura as rotas de relatórios (JSON/CSV, síncronos ou assíncronos)
+func setupRelatorioRoutes(router fiber.Router, db *gorm.DB, cfg *config.Config, log *logrus.Logger) {
+	registry := report.NewRegistry()
+	service.RegisterRelatorios(registry, db, log)
+	reportLog := arqlogging.NewLogrus(log)
+
+	// Gerações assíncronas em andamento são canceladas no encerramento
+	runner := report.NewRunner(report.RunnerConfig{
+		MaxConcurrent: cfg.ReportMaxConcurrent,
+		Timeout:       time.Duration(cfg.ReportTimeout) * time.Second,
+		Retention:     time.Duration(cfg.ReportRetention) * time.Minute,
+	}, reportLog)
+	shutdown.Default().Register("reports", runner.Close)
+
+	relatorios := router.Group("/relatorios")
+	report.NewHandler(registry, runner, reportLog).RegisterRoutes(relatorios)
 }
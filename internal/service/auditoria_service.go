@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// AuditoriaMaxPageSize tamanho máximo da página da consulta à trilha de auditoria
+const AuditoriaMaxPageSize = 100
+
+// AuditoriaEntidades entidades registradas na trilha de auditoria (nomes das tabelas)
+var AuditoriaEntidades = []string{"categorias", "produtos"}
+
+// AuditoriaService define a interface da consulta à trilha de auditoria de todas as entidades
+type AuditoriaService interface {
+	List(ctx context.Context, query audit.Query, page, pageSize int) (*arqdto.PaginatedResponse[audit.LogResponse], error)
+}
+
+// auditoriaService consulta os registros gravados pelos serviços com WithAuditor
+type auditoriaService struct {
+	auditor *audit.Auditor
+	log     *logrus.Logger
+}
+
+// NewAuditoriaService cria uma nova instância do serviço de auditoria
+func NewAuditoriaService(db *gorm.DB, log *logrus.Logger) AuditoriaService {
+	return &auditoriaService{
+		auditor: audit.NewAuditor(db, arqlogging.NewLogrus(log)),
+		log:     log,
+	}
+}
+
+// List retorna os registros da trilha de auditoria que atendem aos filtros, do mais recente para o mais antigo
+func (s *auditoriaService) List(ctx context.Context, query audit.Query, page, pageSize int) (*arqdto.PaginatedResponse[audit.LogResponse], error) {
+	s.log.WithFields(logrus.Fields{
+		"entity":    query.EntityName,
+		"id":        query.EntityID,
+		"actor":     query.Actor,
+		"operation": query.Operation,
+		"field":     query.Field,
+		"page":      page,
+		"pageSize":  pageSize,
+	}).Info("Consultando trilha de auditoria")
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > AuditoriaMaxPageSize {
+		pageSize = AuditoriaMaxPageSize
+	}
+
+	entries, total, err := s.auditor.List(ctx, query, page, pageSize)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao consultar trilha de auditoria")
+		return nil, err
+	}
+
+	responses := make([]audit.LogResponse, len(entries))
+	for i := range entries {
+		responses[i] = entries[i].ToLogResponse()
+	}
+
+	return arqdto.NewPaginatedResponse(responses, total, page, pageSize), nil
+}
@@ -2,13 +2,22 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"api_fibergorm/internal/authz"
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/mapper"
+	"api_fibergorm/internal/messages"
 	"api_fibergorm/internal/models"
 	"api_fibergorm/internal/repository"
 	"api_fibergorm/internal/validator"
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/cache"
+	arqdto "api_fibergorm/pkg/arquitetura/dto"
 	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
 	"api_fibergorm/pkg/arquitetura/service"
 
 	"github.com/sirupsen/logrus"
@@ -25,18 +34,35 @@ type CategoriaService interface {
 	// Métodos específicos de Categoria
 	GetByIDWithProdutos(ctx context.Context, id uint) (*dto.CategoriaWithProdutosResponse, error)
 	GetAllActive(ctx context.Context) ([]dto.CategoriaResponse, error)
+	GetAllWithProdutos(ctx context.Context, page, pageSize, produtosLimit int, countMode arqrepository.CountMode) (*arqdto.PaginatedResponse[dto.CategoriaWithProdutosResponse], error)
 }
 
 // categoriaService é a implementação do serviço usando a arquitetura base
 type categoriaService struct {
 	*service.BaseServiceImpl[*models.Categoria, dto.CreateCategoriaRequest, dto.UpdateCategoriaRequest, dto.CategoriaResponse]
-	repo   *repository.CategoriaRepository
-	mapper *mapper.CategoriaMapper
-	log    *logrus.Logger
+	repo        *repository.CategoriaRepository
+	produtoRepo *repository.ProdutoRepository
+	mapper      *mapper.CategoriaMapper
+	cache       CategoriaCacheConfig
+	log         *logrus.Logger
 }
 
+// CategoriaCacheConfig configurações de cache do serviço de categorias
+type CategoriaCacheConfig struct {
+	Cache     cache.Cache   // Armazenamento do cache (nil desabilita)
+	AtivasTTL time.Duration // Tempo de cache da lista de categorias ativas (0 desabilita)
+}
+
+// CategoriaAuthzConfig configurações de autorização do serviço de categorias
+type CategoriaAuthzConfig struct {
+	DeleteRoles []string // Papéis que podem excluir categorias (vazio = sem restrição)
+}
+
+// ativasCacheKey chave do cache da lista de categorias ativas
+const ativasCacheKey = "categorias:ativas"
+
 // NewCategoriaService cria uma nova instância do serviço de categorias
-func NewCategoriaService(db *gorm.DB, log *logrus.Logger) CategoriaService {
+func NewCategoriaService(db *gorm.DB, cacheConfig CategoriaCacheConfig, authzConfig CategoriaAuthzConfig, log *logrus.Logger) CategoriaService {
 	// Cria o repositório específico de categoria
 	repo := repository.NewCategoriaRepository(db)
 
@@ -44,22 +70,30 @@ func NewCategoriaService(db *gorm.DB, log *logrus.Logger) CategoriaService {
 	categoriaMapper := mapper.NewCategoriaMapper()
 
 	// Configuração do serviço
-	config := service.DefaultServiceConfig("Categoria")
+	config := service.DefaultServiceConfig(messages.EntityCategoria)
 	config.DefaultOrder = "nome ASC"
+	config.NaturalKeys = []string{"nome"}
+	config.GoneWhenDeleted = true
 
 	// Cria o serviço base usando o repositório base embutido
-	baseService := service.NewBaseService(repo.BaseRepositoryImpl, categoriaMapper, log, config)
+	baseService := service.NewBaseService(repo.AsBaseRepository(), categoriaMapper, arqlogging.NewLogrus(log), config)
 
 	// Cria o validador específico
 	categoriaValidator := validator.NewCategoriaValidator(repo, log)
 
-	// Configura o validador no serviço
-	baseService.WithValidator(categoriaValidator)
+	// Configura o validador, a autorização por papéis e a trilha de auditoria no serviço
+	baseService.
+		WithValidator(categoriaValidator).
+		WithAuthorizer(authz.NewRoleAuthorizer[*models.Categoria]().
+			Require(service.OperationDelete, authzConfig.DeleteRoles...)).
+		WithAuditor(audit.NewAuditor(db, arqlogging.NewLogrus(log)))
 
 	return &categoriaService{
 		BaseServiceImpl: baseService,
 		repo:            repo,
+		produtoRepo:     repository.NewProdutoRepository(db),
 		mapper:          categoriaMapper,
+		cache:           cacheConfig,
 		log:             log,
 	}
 }
@@ -68,11 +102,11 @@ func NewCategoriaService(db *gorm.DB, log *logrus.Logger) CategoriaService {
 func (s *categoriaService) GetByIDWithProdutos(ctx context.Context, id uint) (*dto.CategoriaWithProdutosResponse, error) {
 	s.log.WithField("id", id).Info("Buscando categoria com produtos por ID")
 
-	categoria, err := s.repo.FindByIDWithPreloads(id, "Produtos")
+	categoria, err := s.repo.WithContext(ctx).FindByIDWithPreloads(id, "Produtos")
 	if err != nil {
 		if arqerrors.IsNotFound(err) {
 			s.log.WithField("id", id).Warn("Categoria não encontrada")
-			return nil, arqerrors.NewBusinessError("NOT_FOUND", "Categoria não encontrada")
+			return nil, arqerrors.NewBusinessError("NOT_FOUND", i18n.T(ctx, i18n.MsgNotFound, i18n.Params{"entity": i18n.Entity(ctx, messages.EntityCategoria)}))
 		}
 		s.log.WithError(err).Error("Erro ao buscar categoria com produtos")
 		return nil, err
@@ -82,19 +116,184 @@ func (s *categoriaService) GetByIDWithProdutos(ctx context.Context, id uint) (*d
 }
 
 // GetAllActive retorna todas as categorias ativas
+// A lista é mantida em cache e invalidada a cada criação, atualização, exclusão ou restauração de categoria
 func (s *categoriaService) GetAllActive(ctx context.Context) ([]dto.CategoriaResponse, error) {
 	s.log.Info("Listando categorias ativas")
 
-	categorias, _, err := s.repo.FindAllWhere(1, 1000, "nome ASC", "ativo = ?", true)
+	if s.ativasCacheEnabled() {
+		var cached []dto.CategoriaResponse
+		found, err := s.cache.Cache.Get(ctx, ativasCacheKey, &cached)
+		if err != nil {
+			// Falha no cache não deve impedir a consulta
+			s.log.WithError(err).Warn("Falha ao consultar cache de categorias ativas")
+		} else if found {
+			return cached, nil
+		}
+	}
+
+	// Requisições simultâneas (ex: formulários de produto) compartilham a mesma query
+	result, err := s.CoalesceRead(ctx, "ativas", func(ctx context.Context) (interface{}, error) {
+		result, err := s.repo.Scoped(repository.ScopeAtivas).WithContext(ctx).FindAllWithCountMode(1, 1000, "nome ASC", arqrepository.CountNone)
+		if err != nil {
+			return nil, err
+		}
+
+		responses := service.MapResponses(result.Items, s.mapper.ToResponse)
+
+		if s.ativasCacheEnabled() {
+			if err := s.cache.Cache.Set(ctx, ativasCacheKey, responses, s.cache.AtivasTTL); err != nil {
+				s.log.WithError(err).Warn("Falha ao armazenar cache de categorias ativas")
+			}
+		}
+		return responses, nil
+	})
 	if err != nil {
 		s.log.WithError(err).Error("Erro ao listar categorias ativas")
 		return nil, err
 	}
 
-	responses := make([]dto.CategoriaResponse, len(categorias))
-	for i := range categorias {
-		responses[i] = *s.mapper.ToResponse(categorias[i])
+	return result.([]dto.CategoriaResponse), nil
+}
+
+// Create cria uma categoria e invalida o cache de categorias ativas
+func (s *categoriaService) Create(ctx context.Context, req *dto.CreateCategoriaRequest) (*dto.CategoriaResponse, error) {
+	response, err := s.BaseServiceImpl.Create(ctx, req)
+	if err == nil {
+		s.invalidateAtivas(ctx)
+	}
+	return response, err
+}
+
+// Update atualiza uma categoria e invalida o cache de categorias ativas
+func (s *categoriaService) Update(ctx context.Context, id uint, req *dto.UpdateCategoriaRequest) (*dto.CategoriaResponse, error) {
+	response, err := s.BaseServiceImpl.Update(ctx, id, req)
+	if err == nil {
+		s.invalidateAtivas(ctx)
+	}
+	return response, err
+}
+
+// Patch atualiza os campos da máscara de uma categoria e invalida o cache de categorias ativas
+func (s *categoriaService) Patch(ctx context.Context, id uint, fieldMask []string, req *dto.UpdateCategoriaRequest) (*dto.CategoriaResponse, error) {
+	response, err := s.BaseServiceImpl.Patch(ctx, id, fieldMask, req)
+	if err == nil {
+		s.invalidateAtivas(ctx)
+	}
+	return response, err
+}
+
+// Delete exclui uma categoria e invalida o cache de categorias ativas
+func (s *categoriaService) Delete(ctx context.Context, id uint) error {
+	err := s.BaseServiceImpl.Delete(ctx, id)
+	if err == nil {
+		s.invalidateAtivas(ctx)
+	}
+	return err
+}
+
+// Restore restaura uma categoria da lixeira e invalida o cache de categorias ativas
+func (s *categoriaService) Restore(ctx context.Context, id uint) (*dto.CategoriaResponse, error) {
+	response, err := s.BaseServiceImpl.Restore(ctx, id)
+	if err == nil {
+		s.invalidateAtivas(ctx)
+	}
+	return response, err
+}
+
+// BulkUpsert insere ou atualiza categorias em lote e invalida o cache de categorias ativas
+func (s *categoriaService) BulkUpsert(ctx context.Context, key string, reqs []dto.CreateCategoriaRequest) (*arqdto.BulkResponse, error) {
+	response, err := s.BaseServiceImpl.BulkUpsert(ctx, key, reqs)
+	if err == nil && response.Failed == 0 {
+		s.invalidateAtivas(ctx)
+	}
+	return response, err
+}
+
+// ativasCacheEnabled indica se o cache de categorias ativas está habilitado
+func (s *categoriaService) ativasCacheEnabled() bool {
+	return s.cache.Cache != nil && s.cache.AtivasTTL > 0
+}
+
+// invalidateAtivas remove a lista de categorias ativas do cache
+// Em caso de falha, a lista permanece desatualizada no máximo até o fim do TTL
+func (s *categoriaService) invalidateAtivas(ctx context.Context) {
+	if !s.ativasCacheEnabled() {
+		return
+	}
+	if err := s.cache.Cache.Delete(ctx, ativasCacheKey); err != nil {
+		s.log.WithError(err).Warn("Falha ao invalidar cache de categorias ativas")
+	}
+}
+
+// Limites de produtos retornados por categoria nas listagens
+const (
+	DefaultProdutosLimit = 5
+	MaxProdutosLimit     = 50
+)
+
+// GetAllWithProdutos lista as categorias com uma prévia limitada de seus produtos
+// O carregamento é feito em duas fases para evitar N+1 e preloads ilimitados:
+// a página de categorias e, em seguida, uma única query (IN) com os produtos de todas elas
+func (s *categoriaService) GetAllWithProdutos(ctx context.Context, page, pageSize, produtosLimit int, countMode arqrepository.CountMode) (*arqdto.PaginatedResponse[dto.CategoriaWithProdutosResponse], error) {
+	s.log.WithFields(logrus.Fields{
+		"page":          page,
+		"pageSize":      pageSize,
+		"produtosLimit": produtosLimit,
+		"countMode":     countMode,
+	}).Info("Listando categorias com produtos")
+
+	// Normaliza paginação
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	if produtosLimit < 1 {
+		produtosLimit = DefaultProdutosLimit
+	}
+	if produtosLimit > MaxProdutosLimit {
+		produtosLimit = MaxProdutosLimit
+	}
+
+	// Fase 1: página de categorias
+	result, err := s.repo.WithContext(ctx).FindAllWithCountMode(page, pageSize, "nome ASC", countMode)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao listar categorias")
+		return nil, err
+	}
+
+	ids := make([]uint, len(result.Items))
+	for i, categoria := range result.Items {
+		ids[i] = categoria.ID
+	}
+
+	// Fase 2: produtos de todas as categorias da página (limitados por categoria) e totais
+	produtos, err := s.produtoRepo.WithContext(ctx).FindByParentIDs("categoria_id", ids, produtosLimit, "id ASC")
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao carregar produtos das categorias")
+		return nil, err
+	}
+	totals, err := s.produtoRepo.WithContext(ctx).CountByParentIDs("categoria_id", ids)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar produtos das categorias")
+		return nil, err
+	}
+
+	responses := make([]dto.CategoriaWithProdutosResponse, len(result.Items))
+	for i, categoria := range result.Items {
+		categoria.Produtos = make([]models.Produto, len(produtos[categoria.ID]))
+		for j, produto := range produtos[categoria.ID] {
+			categoria.Produtos[j] = *produto
+		}
+
+		total := totals[categoria.ID]
+		responses[i] = *s.mapper.ToResponseWithProdutos(categoria)
+		responses[i].ProdutosTotal = &total
 	}
 
-	return responses, nil
+	return service.ToPaginatedResponse(responses, result, page, pageSize), nil
 }
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"api_fibergorm/internal/dto"
+	"api_fibergorm/internal/models"
+	"api_fibergorm/internal/repository"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// EstatisticasDias quantidade de dias da série de registros criados por dia
+const EstatisticasDias = 30
+
+// EstatisticasService define a interface do serviço de estatísticas do painel administrativo
+type EstatisticasService interface {
+	Get(ctx context.Context) (*dto.EstatisticasResponse, error)
+}
+
+// estatisticasService calcula as estatísticas com os helpers de agregação dos repositórios
+type estatisticasService struct {
+	categoriaRepo *repository.CategoriaRepository
+	produtoRepo   *repository.ProdutoRepository
+	log           *logrus.Logger
+}
+
+// NewEstatisticasService cria uma nova instância do serviço de estatísticas
+func NewEstatisticasService(db *gorm.DB, log *logrus.Logger) EstatisticasService {
+	return &estatisticasService{
+		categoriaRepo: repository.NewCategoriaRepository(db),
+		produtoRepo:   repository.NewProdutoRepository(db),
+		log:           log,
+	}
+}
+
+// Get retorna os totais de categorias e produtos, o resumo dos preços, a quantidade e o preço médio
+// dos produtos por categoria e os registros criados por dia nos últimos EstatisticasDias dias
+// Registros excluídos logicamente (lixeira) não são considerados
+func (s *estatisticasService) Get(ctx context.Context) (*dto.EstatisticasResponse, error) {
+	s.log.Info("Calculando estatísticas")
+
+	categorias := s.categoriaRepo.WithContext(ctx)
+	produtos := s.produtoRepo.WithContext(ctx)
+
+	response := &dto.EstatisticasResponse{GeradoEm: time.Now()}
+
+	// Categorias ativas x inativas
+	porStatus, err := arqrepository.GroupByCount[bool](categorias, "ativo")
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar categorias por status")
+		return nil, err
+	}
+	response.Categorias = dto.CategoriasEstatisticas{
+		Total:    porStatus[true] + porStatus[false],
+		Ativas:   porStatus[true],
+		Inativas: porStatus[false],
+	}
+
+	// Total de produtos e resumo dos preços
+	preco, err := produtos.StatsWhere("preco", nil)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao calcular estatísticas de preço")
+		return nil, err
+	}
+	response.Produtos = dto.ProdutosEstatisticas{
+		Total:      preco.Count,
+		PrecoMedio: preco.Avg,
+		PrecoMin:   preco.Min,
+		PrecoMax:   preco.Max,
+	}
+
+	// Produtos e preço médio por categoria (categorias sem produtos aparecem com zero)
+	porCategoria, err := arqrepository.GroupByCount[uint](produtos, "categoria_id")
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar produtos por categoria")
+		return nil, err
+	}
+	precoMedioPorCategoria, err := arqrepository.GroupByAggregate[uint](produtos, "categoria_id", arqrepository.AggregateAvg, "preco")
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao calcular preço médio por categoria")
+		return nil, err
+	}
+	response.ProdutosPorCategoria = make([]dto.ProdutosPorCategoriaItem, 0, response.Categorias.Total)
+	err = categorias.FindInBatches(500, func(batch []*models.Categoria) error {
+		for _, categoria := range batch {
+			response.ProdutosPorCategoria = append(response.ProdutosPorCategoria, dto.ProdutosPorCategoriaItem{
+				CategoriaID: categoria.ID,
+				Nome:        categoria.Nome,
+				Ativo:       categoria.Ativo,
+				Total:       porCategoria[categoria.ID],
+				PrecoMedio:  precoMedioPorCategoria[categoria.ID],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao listar categorias")
+		return nil, err
+	}
+	sort.SliceStable(response.ProdutosPorCategoria, func(i, j int) bool {
+		a, b := response.ProdutosPorCategoria[i], response.ProdutosPorCategoria[j]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		return a.Nome < b.Nome
+	})
+
+	// Registros criados por dia (dias sem registros aparecem com zero)
+	response.CriadosPorDia, err = s.criadosPorDia(categorias, produtos)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// criadosPorDia monta a série dos últimos EstatisticasDias dias, do mais antigo para o mais recente
+func (s *estatisticasService) criadosPorDia(categorias *arqrepository.BaseRepositoryImpl[*models.Categoria], produtos *arqrepository.BaseRepositoryImpl[*models.Produto]) ([]dto.CriadosPorDiaItem, error) {
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -(EstatisticasDias - 1))
+
+	categoriasPorDia, err := categorias.CountByDay("created_at", since)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar categorias criadas por dia")
+		return nil, err
+	}
+	produtosPorDia, err := produtos.CountByDay("created_at", since)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar produtos criados por dia")
+		return nil, err
+	}
+
+	items := make([]dto.CriadosPorDiaItem, EstatisticasDias)
+	index := make(map[string]int, EstatisticasDias)
+	for i := range items {
+		day := since.AddDate(0, 0, i).Format(time.DateOnly)
+		items[i].Data = day
+		index[day] = i
+	}
+	for _, row := range categoriasPorDia {
+		if i, ok := index[row.Day.Format(time.DateOnly)]; ok {
+			items[i].Categorias = row.Total
+		}
+	}
+	for _, row := range produtosPorDia {
+		if i, ok := index[row.Day.Format(time.DateOnly)]; ok {
+			items[i].Produtos = row.Total
+		}
+	}
+	return items, nil
+}
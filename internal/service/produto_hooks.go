@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/internal/dto"
+	"api_fibergorm/internal/models"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
+	"api_fibergorm/pkg/arquitetura/service"
+
+	"gorm.io/gorm"
+)
+
+// produtoHooks ganchos do ciclo de vida de produto: inicializa o estoque na criação e registra o
+// histórico de preços nas atualizações, na mesma transação da escrita do produto
+type produtoHooks struct {
+	service.NoOpHooks[*models.Produto, dto.CreateProdutoRequest, dto.UpdateProdutoRequest]
+	db *gorm.DB
+}
+
+// newProdutoHooks cria os ganchos de produto
+func newProdutoHooks(db *gorm.DB) *produtoHooks {
+	return &produtoHooks{db: db}
+}
+
+// AfterCreate cria o estoque do produto com quantidade zero
+func (h *produtoHooks) AfterCreate(ctx context.Context, produto *models.Produto) error {
+	estoque := &models.Estoque{ProdutoID: produto.ID}
+	return arqrepository.NewBaseRepository[*models.Estoque](h.db).WithContext(ctx).Create(estoque)
+}
+
+// AfterUpdate registra o histórico quando o preço foi alterado
+func (h *produtoHooks) AfterUpdate(ctx context.Context, before, after *models.Produto) error {
+	if before.Preco == after.Preco {
+		return nil
+	}
+	historico := &models.PrecoHistorico{
+		ProdutoID:     after.ID,
+		PrecoAnterior: before.Preco,
+		PrecoNovo:     after.Preco,
+	}
+	return arqrepository.NewBaseRepository[*models.PrecoHistorico](h.db).WithContext(ctx).Create(historico)
+}
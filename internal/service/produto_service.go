@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/mapper"
+	"api_fibergorm/internal/messages"
 	"api_fibergorm/internal/models"
 	"api_fibergorm/internal/repository"
 	"api_fibergorm/internal/validator"
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/cache"
 	arqdto "api_fibergorm/pkg/arquitetura/dto"
-	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/outbox"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
 	"api_fibergorm/pkg/arquitetura/service"
 
 	"github.com/sirupsen/logrus"
@@ -24,7 +30,8 @@ type ProdutoService interface {
 	service.BaseService[*models.Produto, dto.CreateProdutoRequest, dto.UpdateProdutoRequest, dto.ProdutoResponse]
 
 	// Métodos específicos de Produto
-	GetByCategoriaID(ctx context.Context, categoriaID uint, page, pageSize int) (*arqdto.PaginatedResponse[dto.ProdutoResponse], error)
+	GetByCategoriaID(ctx context.Context, categoriaID uint, page, pageSize int, countMode arqrepository.CountMode) (*arqdto.PaginatedResponse[dto.ProdutoResponse], error)
+	CategoriaRelation() service.ParentRelation
 }
 
 // produtoService é a implementação do serviço usando a arquitetura base
@@ -36,8 +43,20 @@ type produtoService struct {
 	log    *logrus.Logger
 }
 
+// ProdutoCacheConfig configurações de cache do serviço de produtos
+type ProdutoCacheConfig struct {
+	Cache cache.Cache   // Armazenamento do cache (nil desabilita)
+	TTL   time.Duration // Tempo de cache das buscas por ID e listagens sem filtros (0 desabilita)
+}
+
+// ProdutoOutboxConfig configurações dos eventos de integração do serviço de produtos
+type ProdutoOutboxConfig struct {
+	Outbox *outbox.Outbox // Outbox dos eventos (nil desabilita)
+	Topic  string         // Tópico (Kafka) ou routing key (RabbitMQ) dos eventos de produtos
+}
+
 // NewProdutoService cria uma nova instância do serviço de produtos
-func NewProdutoService(db *gorm.DB, log *logrus.Logger) ProdutoService {
+func NewProdutoService(db *gorm.DB, cacheConfig ProdutoCacheConfig, outboxConfig ProdutoOutboxConfig, log *logrus.Logger) ProdutoService {
 	// Cria o repositório específico de produto
 	repo := repository.NewProdutoRepository(db)
 
@@ -45,16 +64,24 @@ func NewProdutoService(db *gorm.DB, log *logrus.Logger) ProdutoService {
 	produtoMapper := mapper.NewProdutoMapper()
 
 	// Configuração do serviço
-	config := service.DefaultServiceConfig("Produto")
+	config := service.DefaultServiceConfig(messages.EntityProduto)
+	config.NaturalKeys = []string{"codigo"}
+	config.GoneWhenDeleted = true
 
 	// Cria o serviço base usando o repositório base embutido
-	baseService := service.NewBaseService(repo.BaseRepositoryImpl, produtoMapper, log, config)
+	baseService := service.NewBaseService(repo.AsBaseRepository(), produtoMapper, arqlogging.NewLogrus(log), config)
 
 	// Cria o validador específico
 	produtoValidator := validator.NewProdutoValidator(repo, db, log)
 
-	// Configura o validador no serviço
-	baseService.WithValidator(produtoValidator)
+	// Configura o validador, os ganchos (estoque e histórico de preços), a trilha de auditoria, os eventos de
+	// integração e o cache de leitura no serviço
+	baseService.
+		WithValidator(produtoValidator).
+		WithHooks(newProdutoHooks(db)).
+		WithAuditor(audit.NewAuditor(db, arqlogging.NewLogrus(log))).
+		WithOutbox(outboxConfig.Outbox, outboxConfig.Topic).
+		WithCache(cacheConfig.Cache, cacheConfig.TTL)
 
 	return &produtoService{
 		BaseServiceImpl: baseService,
@@ -73,8 +100,8 @@ func (s *produtoService) Create(ctx context.Context, req *dto.CreateProdutoReque
 		return nil, err
 	}
 
-	// Recarrega com categoria para garantir dados completos
-	produto, err := s.repo.FindByID(response.ID)
+	// Recarrega com categoria para garantir dados completos (no primário: a réplica pode estar atrasada)
+	produto, err := s.repo.WithPrimary().WithContext(ctx).FindByID(response.ID)
 	if err != nil {
 		return response, nil // Retorna o response original se falhar
 	}
@@ -90,8 +117,8 @@ func (s *produtoService) Update(ctx context.Context, id uint, req *dto.UpdatePro
 		return nil, err
 	}
 
-	// Recarrega com categoria para garantir dados completos
-	produto, err := s.repo.FindByID(response.ID)
+	// Recarrega com categoria para garantir dados completos (no primário: a réplica pode estar atrasada)
+	produto, err := s.repo.WithPrimary().WithContext(ctx).FindByID(response.ID)
 	if err != nil {
 		return response, nil // Retorna o response original se falhar
 	}
@@ -99,47 +126,34 @@ func (s *produtoService) Update(ctx context.Context, id uint, req *dto.UpdatePro
 	return s.mapper.ToResponse(produto), nil
 }
 
-// GetByCategoriaID retorna produtos de uma categoria específica
-func (s *produtoService) GetByCategoriaID(ctx context.Context, categoriaID uint, page, pageSize int) (*arqdto.PaginatedResponse[dto.ProdutoResponse], error) {
-	s.log.WithFields(logrus.Fields{
-		"categoria_id": categoriaID,
-		"page":         page,
-		"pageSize":     pageSize,
-	}).Info("Listando produtos por categoria")
-
-	// Normaliza paginação
-	if page < 1 {
-		page = 1
-	}
-	if pageSize < 1 {
-		pageSize = 10
-	}
-	if pageSize > 100 {
-		pageSize = 100
-	}
-
-	// Verifica se a categoria existe
-	var count int64
-	if err := s.db.Model(&models.Categoria{}).Where("id = ?", categoriaID).Count(&count).Error; err != nil {
-		s.log.WithError(err).Error("Erro ao verificar categoria")
+// Patch sobrescreve o Patch base para recarregar com categoria
+func (s *produtoService) Patch(ctx context.Context, id uint, fieldMask []string, req *dto.UpdateProdutoRequest) (*dto.ProdutoResponse, error) {
+	response, err := s.BaseServiceImpl.Patch(ctx, id, fieldMask, req)
+	if err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		return nil, arqerrors.NewBusinessError("NOT_FOUND", "Categoria não encontrada")
-	}
 
-	// Busca produtos da categoria
-	produtos, total, err := s.repo.FindAllWhere(page, pageSize, "id ASC", "categoria_id = ?", categoriaID)
+	// Recarrega com categoria para garantir dados completos (no primário: a réplica pode estar atrasada)
+	produto, err := s.repo.WithPrimary().WithContext(ctx).FindByID(response.ID)
 	if err != nil {
-		s.log.WithError(err).Error("Erro ao listar produtos por categoria")
-		return nil, err
+		return response, nil // Retorna o response original se falhar
 	}
 
-	// Converte para responses
-	responses := make([]dto.ProdutoResponse, len(produtos))
-	for i := range produtos {
-		responses[i] = *s.mapper.ToResponse(produtos[i])
-	}
+	return s.mapper.ToResponse(produto), nil
+}
 
-	return arqdto.NewPaginatedResponse(responses, total, page, pageSize), nil
+// GetByCategoriaID retorna produtos de uma categoria específica
+func (s *produtoService) GetByCategoriaID(ctx context.Context, categoriaID uint, page, pageSize int, countMode arqrepository.CountMode) (*arqdto.PaginatedResponse[dto.ProdutoResponse], error) {
+	return s.GetAllByParent(ctx, s.CategoriaRelation(), categoriaID, page, pageSize, countMode)
+}
+
+// CategoriaRelation descreve produtos como sub-recurso da categoria (/categorias/:categoria_id/produtos)
+func (s *produtoService) CategoriaRelation() service.ParentRelation {
+	return service.ParentRelation{
+		ParentName: messages.EntityCategoria,
+		ForeignKey: "categoria_id",
+		ParentExists: func(ctx context.Context, categoriaID uint) (bool, error) {
+			return arqrepository.NewBaseRepository[*models.Categoria](s.db).WithContext(ctx).ExistsByID(categoriaID)
+		},
+	}
 }
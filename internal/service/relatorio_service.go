@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"api_fibergorm/internal/repository"
+	"api_fibergorm/pkg/arquitetura/audit"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	arqlogging "api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/report"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// relatorioService implementa os relatórios da aplicação (/api/v1/relatorios)
+type relatorioService struct {
+	produtoRepo *repository.ProdutoRepository
+	auditor     *audit.Auditor
+	log         *logrus.Logger
+}
+
+// RegisterRelatorios registra os relatórios da aplicação no registro informado
+func RegisterRelatorios(registry *report.Registry, db *gorm.DB, log *logrus.Logger) {
+	s := &relatorioService{
+		produtoRepo: repository.NewProdutoRepository(db),
+		auditor:     audit.NewAuditor(db, arqlogging.NewLogrus(log)),
+		log:         log,
+	}
+
+	registry.Register(report.Definition{
+		Name:        "produtos-por-categoria",
+		Description: "Quantidade, preço médio/mínimo/máximo e valor total dos produtos de cada categoria, na faixa de preço informada",
+		Params: []report.ParamSpec{
+			{Name: "preco_min", Type: report.ParamNumber, Description: "Preço mínimo dos produtos considerados"},
+			{Name: "preco_max", Type: report.ParamNumber, Description: "Preço máximo dos produtos considerados"},
+			{Name: "categoria_id", Type: report.ParamID, Description: "Apenas a categoria informada"},
+		},
+		Run: s.produtosPorCategoria,
+	})
+
+	registry.Register(report.Definition{
+		Name:        "movimento-por-periodo",
+		Description: "Criações, alterações, exclusões e restaurações por dia no período, a partir da trilha de auditoria",
+		Params: []report.ParamSpec{
+			{Name: "from", Type: report.ParamDate, Required: true, Description: "Início do período (RFC3339 ou AAAA-MM-DD)"},
+			{Name: "to", Type: report.ParamDateEnd, Required: true, Description: "Fim do período (uma data sem horário inclui o dia inteiro)"},
+			{Name: "entidade", Type: report.ParamString, Values: []string{"produtos", "categorias"}, Description: "Entidade (padrão: produtos)"},
+		},
+		Run: s.movimentoPorPeriodo,
+	})
+}
+
+// produtosPorCategoria resume os produtos de cada categoria na faixa de preço informada
+func (s *relatorioService) produtosPorCategoria(ctx context.Context, params report.Params) (*report.Result, error) {
+	var filtro repository.FiltroResumoCategoria
+	if value, ok := params.Number("preco_min"); ok {
+		filtro.PrecoMin = &value
+	}
+	if value, ok := params.Number("preco_max"); ok {
+		filtro.PrecoMax = &value
+	}
+	if value, ok := params.ID("categoria_id"); ok {
+		filtro.CategoriaID = &value
+	}
+	if filtro.PrecoMin != nil && filtro.PrecoMax != nil && *filtro.PrecoMin > *filtro.PrecoMax {
+		return nil, arqerrors.NewBusinessError(arqerrors.CodeInvalidParameter,
+			i18n.T(ctx, i18n.MsgInvalidRangeOrder, i18n.Params{"from": "preco_min", "to": "preco_max"}))
+	}
+
+	rows, err := s.produtoRepo.ResumoPorCategoria(ctx, filtro)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao gerar relatório de produtos por categoria")
+		return nil, err
+	}
+
+	result := report.NewResult(
+		report.Column{Key: "categoria_id", Title: "ID da categoria"},
+		report.Column{Key: "categoria", Title: "Categoria"},
+		report.Column{Key: "ativa", Title: "Ativa"},
+		report.Column{Key: "produtos", Title: "Produtos"},
+		report.Column{Key: "preco_medio", Title: "Preço médio"},
+		report.Column{Key: "preco_min", Title: "Preço mínimo"},
+		report.Column{Key: "preco_max", Title: "Preço máximo"},
+		report.Column{Key: "valor_total", Title: "Valor total"},
+	)
+	for _, row := range rows {
+		result.AddRow(row.CategoriaID, row.Nome, row.Ativo, row.Produtos, row.PrecoMedio, row.PrecoMin, row.PrecoMax, row.ValorTotal)
+	}
+	return result, nil
+}
+
+// movimentoPorPeriodo conta as operações registradas na auditoria por dia (dias sem operações aparecem zerados)
+// O saldo é a variação de registros ativos no dia: criados - excluídos + restaurados
+// (a exclusão definitiva remove da lixeira registros que já não estavam ativos)
+func (s *relatorioService) movimentoPorPeriodo(ctx context.Context, params report.Params) (*report.Result, error) {
+	from, _ := params.Time("from")
+	to, _ := params.Time("to")
+	if from.After(to) {
+		return nil, arqerrors.NewBusinessError(arqerrors.CodeInvalidParameter,
+			i18n.T(ctx, i18n.MsgInvalidRangeOrder, i18n.Params{"from": "from", "to": "to"}))
+	}
+
+	entidade := params.String("entidade")
+	if entidade == "" {
+		entidade = "produtos"
+	}
+
+	counts, err := s.auditor.CountByDay(ctx, entidade, audit.Filter{From: &from, To: &to})
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao gerar relatório de movimento por período")
+		return nil, err
+	}
+
+	byDay := make(map[string]map[audit.Operation]int64)
+	for _, count := range counts {
+		day := count.Day.Format(time.DateOnly)
+		if byDay[day] == nil {
+			byDay[day] = make(map[audit.Operation]int64)
+		}
+		byDay[day][count.Operation] = count.Total
+	}
+
+	result := report.NewResult(
+		report.Column{Key: "data", Title: "Data"},
+		report.Column{Key: "criados", Title: "Criados"},
+		report.Column{Key: "alterados", Title: "Alterados"},
+		report.Column{Key: "excluidos", Title: "Excluídos"},
+		report.Column{Key: "restaurados", Title: "Restaurados"},
+		report.Column{Key: "excluidos_definitivamente", Title: "Excluídos definitivamente"},
+		report.Column{Key: "saldo", Title: "Saldo"},
+	)
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	for day := first; !day.After(to); day = day.AddDate(0, 0, 1) {
+		ops := byDay[day.Format(time.DateOnly)]
+		saldo := ops[audit.OperationCreate] - ops[audit.OperationDelete] + ops[audit.OperationRestore]
+		result.AddRow(
+			day.Format(time.DateOnly),
+			ops[audit.OperationCreate],
+			ops[audit.OperationUpdate],
+			ops[audit.OperationDelete],
+			ops[audit.OperationRestore],
+			ops[audit.OperationDeletePermanently],
+			saldo,
+		)
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package shutdown
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Step representa uma etapa do encerramento da aplicação
+type Step struct {
+	Name string                          // Nome exibido nos logs
+	Fn   func(ctx context.Context) error // Libera o recurso respeitando o prazo do contexto
+}
+
+// Sequence executa as etapas de encerramento na ordem de registro, com log e duração de cada etapa
+// Componentes com trabalho em segundo plano (jobs, barramento de eventos) registram suas etapas
+// em Default(); o main registra as etapas de infraestrutura (HTTP, Redis, banco, logs)
+type Sequence struct {
+	steps []Step
+	mutex sync.Mutex
+}
+
+// defaultSequence etapas registradas pelos componentes da aplicação
+var defaultSequence = &Sequence{}
+
+// Default retorna a sequência de encerramento da aplicação
+func Default() *Sequence {
+	return defaultSequence
+}
+
+// Register adiciona uma etapa ao final da sequência
+func (s *Sequence) Register(name string, fn func(ctx context.Context) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.steps = append(s.steps, Step{Name: name, Fn: fn})
+}
+
+// Run executa as etapas na ordem de registro dentro do prazo total informado
+// Uma etapa com erro é registrada no log e não impede as seguintes (os recursos restantes ainda são liberados)
+func (s *Sequence) Run(timeout time.Duration, log *logrus.Logger) {
+	s.mutex.Lock()
+	steps := append([]Step(nil), s.steps...)
+	s.mutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	for i, step := range steps {
+		stepStart := time.Now()
+		entry := log.WithFields(logrus.Fields{
+			"step":  step.Name,
+			"order": i + 1,
+			"total": len(steps),
+		})
+		entry.Info("Encerrando")
+
+		if err := step.Fn(ctx); err != nil {
+			entry.WithError(err).WithField("duration", time.Since(stepStart).String()).Error("Falha no encerramento")
+			continue
+		}
+		entry.WithField("duration", time.Since(stepStart).String()).Info("Encerrado")
+	}
+
+	log.WithField("duration", time.Since(start).String()).Info("Encerramento concluído")
+}
@@ -0,0 +1,131 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Event representa um erro reportado ao rastreador de erros
+type Event struct {
+	Message   string            `json:"message"`
+	Stack     string            `json:"stack,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"` // Contexto da requisição (request_id, trace_id, method, route...)
+	Timestamp time.Time         `json:"timestamp"`
+}
+
+// Reporter envia eventos de erro a um rastreador externo
+// Implementações não devem bloquear a requisição que originou o erro
+type Reporter interface {
+	Report(ctx context.Context, event Event)
+	Flush(ctx context.Context) error // Aguarda os envios pendentes (usado no encerramento)
+}
+
+// noopReporter descarta os eventos (rastreador não configurado)
+type noopReporter struct{}
+
+func (noopReporter) Report(context.Context, Event) {}
+
+func (noopReporter) Flush(context.Context) error { return nil }
+
+// defaultReporter rastreador utilizado pela aplicação
+var defaultReporter Reporter = noopReporter{}
+
+// Setup configura o rastreador padrão da aplicação
+// Com url vazia os eventos são descartados (continuam apenas nos logs e métricas)
+func Setup(url string, timeout time.Duration, log *logrus.Logger) {
+	if url == "" {
+		defaultReporter = noopReporter{}
+		return
+	}
+	defaultReporter = NewHTTPReporter(url, timeout, log)
+	log.WithField("url", url).Info("Rastreador de erros configurado")
+}
+
+// Default retorna o rastreador padrão da aplicação
+func Default() Reporter {
+	return defaultReporter
+}
+
+// HTTPReporter envia cada evento como JSON (POST) para um endpoint de ingestão
+// O envio é assíncrono; Flush aguarda os envios em andamento
+type HTTPReporter struct {
+	url     string
+	client  *http.Client
+	log     *logrus.Logger
+	pending sync.WaitGroup
+}
+
+// NewHTTPReporter cria um novo rastreador HTTP
+func NewHTTPReporter(url string, timeout time.Duration, log *logrus.Logger) *HTTPReporter {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &HTTPReporter{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		log:    log,
+	}
+}
+
+// Report envia o evento em segundo plano
+func (r *HTTPReporter) Report(ctx context.Context, event Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	r.pending.Add(1)
+	go func() {
+		defer r.pending.Done()
+		if err := r.send(context.WithoutCancel(ctx), event); err != nil {
+			r.log.WithError(err).Warn("Falha ao reportar erro ao rastreador")
+		}
+	}()
+}
+
+// Flush aguarda os envios pendentes até o prazo do contexto
+func (r *HTTPReporter) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		r.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// send envia um evento ao endpoint de ingestão
+func (r *HTTPReporter) send(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("rastreador retornou status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package validator
 
 import (
+	"context"
+
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/models"
 	"api_fibergorm/internal/repository"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
 	"api_fibergorm/pkg/arquitetura/service"
 
 	"github.com/sirupsen/logrus"
@@ -33,27 +36,27 @@ func (v *CategoriaValidator) ValidateCreate(ctx *service.ValidationContext, req
 	// Validação: nome obrigatório
 	if req.Nome == "" {
 		v.log.Warn("Tentativa de criar categoria sem nome")
-		result.AddError("nome", "O nome da categoria é obrigatório")
+		result.AddCodedError(ctx.Context, "nome", CodeCategoriaNomeObrigatorio, nil)
 		return result
 	}
 
 	// Validação: nome mínimo
 	if len(req.Nome) < 2 {
 		v.log.WithField("nome", req.Nome).Warn("Nome muito curto")
-		result.AddError("nome", "O nome deve ter pelo menos 2 caracteres")
+		result.AddCodedError(ctx.Context, "nome", CodeCategoriaNomeCurto, nil)
 		return result
 	}
 
 	// Validação: nome único
-	exists, err := v.repo.ExistsWhere("nome = ?", req.Nome)
+	exists, err := v.repo.WithContext(ctx.Context).ExistsBySpec(arqrepository.Eq("nome", req.Nome))
 	if err != nil {
 		v.log.WithError(err).Error("Erro ao verificar nome duplicado")
-		result.AddError("nome", "Erro ao verificar nome")
+		result.AddCodedError(ctx.Context, "nome", CodeVerificacaoIndisponivel, nil)
 		return result
 	}
 	if exists {
 		v.log.WithField("nome", req.Nome).Warn("Tentativa de criar categoria com nome duplicado")
-		result.AddError("nome", "Já existe uma categoria com este nome")
+		result.AddCodedError(ctx.Context, "nome", CodeCategoriaNomeDuplicado, nil)
 	}
 
 	return result
@@ -67,19 +70,19 @@ func (v *CategoriaValidator) ValidateUpdate(ctx *service.ValidationContext, enti
 	if req.Nome != "" && req.Nome != entity.Nome {
 		if len(req.Nome) < 2 {
 			v.log.WithField("nome", req.Nome).Warn("Nome muito curto")
-			result.AddError("nome", "O nome deve ter pelo menos 2 caracteres")
+			result.AddCodedError(ctx.Context, "nome", CodeCategoriaNomeCurto, nil)
 			return result
 		}
 
-		exists, err := v.repo.ExistsWhereExcludingID(ctx.EntityID, "nome = ?", req.Nome)
+		exists, err := v.repo.WithContext(ctx.Context).ExistsUnique(map[string]interface{}{"nome": req.Nome}, ctx.EntityID)
 		if err != nil {
 			v.log.WithError(err).Error("Erro ao verificar nome duplicado")
-			result.AddError("nome", "Erro ao verificar nome")
+			result.AddCodedError(ctx.Context, "nome", CodeVerificacaoIndisponivel, nil)
 			return result
 		}
 		if exists {
 			v.log.WithField("nome", req.Nome).Warn("Tentativa de atualizar para nome duplicado")
-			result.AddError("nome", "Já existe outra categoria com este nome")
+			result.AddCodedError(ctx.Context, "nome", CodeCategoriaNomeDuplicado, nil)
 		}
 	}
 
@@ -91,23 +94,22 @@ func (v *CategoriaValidator) ValidateDelete(ctx *service.ValidationContext, enti
 	result := service.NewValidationResult()
 
 	// Validação: não permitir exclusão se houver produtos
-	count, err := v.countProdutos(entity.ID)
+	produtoIDs, err := v.produtoIDs(ctx.Context, entity.ID)
 	if err != nil {
 		v.log.WithError(err).Error("Erro ao verificar produtos da categoria")
-		result.AddError("categoria", "Erro ao verificar produtos relacionados")
+		result.AddCodedError(ctx.Context, "categoria", CodeVerificacaoIndisponivel, nil)
 		return result
 	}
-	if count > 0 {
-		v.log.WithField("id", entity.ID).Warn("Tentativa de excluir categoria com produtos")
-		result.AddError("categoria", "Não é possível excluir uma categoria que possui produtos")
+	if len(produtoIDs) > 0 {
+		v.log.WithFields(logrus.Fields{"id": entity.ID, "produtos": produtoIDs}).Warn("Tentativa de excluir categoria com produtos")
+		result.AddCodedError(ctx.Context, "categoria", CodeCategoriaPossuiProdutos, nil)
 	}
 
 	return result
 }
 
-// countProdutos conta os produtos de uma categoria
-func (v *CategoriaValidator) countProdutos(categoriaID uint) (int64, error) {
-	var count int64
-	err := v.repo.GetDB().Model(&models.Produto{}).Where("categoria_id = ?", categoriaID).Count(&count).Error
-	return count, err
+// produtoIDs retorna os IDs dos produtos de uma categoria
+func (v *CategoriaValidator) produtoIDs(ctx context.Context, categoriaID uint) ([]uint, error) {
+	produtos := arqrepository.NewBaseRepository[*models.Produto](v.repo.WithContext(ctx).GetDB())
+	return produtos.PluckIDs("categoria_id = ?", categoriaID)
 }
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"net/http"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+)
+
+// Códigos de erro de negócio de categorias e produtos (catálogo em GET /api/v1/erros)
+const (
+	CodeCategoriaNomeObrigatorio    = "CATEGORIA_NOME_OBRIGATORIO"
+	CodeCategoriaNomeCurto          = "CATEGORIA_NOME_CURTO"
+	CodeCategoriaNomeDuplicado      = "CATEGORIA_NOME_DUPLICADO"
+	CodeCategoriaPossuiProdutos     = "CATEGORIA_POSSUI_PRODUTOS"
+	CodeProdutoCodigoObrigatorio    = "PRODUTO_CODIGO_OBRIGATORIO"
+	CodeProdutoCodigoDuplicado      = "PRODUTO_CODIGO_DUPLICADO"
+	CodeProdutoPrecoInvalido        = "PRODUTO_PRECO_INVALIDO"
+	CodeProdutoDescricaoCurta       = "PRODUTO_DESCRICAO_CURTA"
+	CodeProdutoCategoriaObrigatoria = "PRODUTO_CATEGORIA_OBRIGATORIA"
+	CodeProdutoCategoriaInexistente = "PRODUTO_CATEGORIA_INEXISTENTE"
+	CodeProdutoCategoriaInativa     = "PRODUTO_CATEGORIA_INATIVA"
+	CodeVerificacaoIndisponivel     = "VERIFICACAO_INDISPONIVEL"
+)
+
+func init() {
+	arqerrors.RegisterCode(CodeCategoriaNomeObrigatorio, http.StatusBadRequest, "O nome da categoria é obrigatório")
+	arqerrors.RegisterCode(CodeCategoriaNomeCurto, http.StatusBadRequest, "O nome da categoria deve ter pelo menos 2 caracteres")
+	arqerrors.RegisterCode(CodeCategoriaNomeDuplicado, http.StatusBadRequest, "Já existe uma categoria com este nome")
+	arqerrors.RegisterCode(CodeCategoriaPossuiProdutos, http.StatusBadRequest, "A categoria possui produtos e não pode ser excluída")
+	arqerrors.RegisterCode(CodeProdutoCodigoObrigatorio, http.StatusBadRequest, "O código do produto é obrigatório")
+	arqerrors.RegisterCode(CodeProdutoCodigoDuplicado, http.StatusBadRequest, "Já existe um produto com este código")
+	arqerrors.RegisterCode(CodeProdutoPrecoInvalido, http.StatusBadRequest, "O preço do produto deve ser maior que zero")
+	arqerrors.RegisterCode(CodeProdutoDescricaoCurta, http.StatusBadRequest, "A descrição do produto deve ter pelo menos 3 caracteres")
+	arqerrors.RegisterCode(CodeProdutoCategoriaObrigatoria, http.StatusBadRequest, "A categoria do produto é obrigatória")
+	arqerrors.RegisterCode(CodeProdutoCategoriaInexistente, http.StatusBadRequest, "A categoria informada não existe")
+	arqerrors.RegisterCode(CodeProdutoCategoriaInativa, http.StatusBadRequest, "A categoria informada está inativa")
+	arqerrors.RegisterCode(CodeVerificacaoIndisponivel, http.StatusBadRequest, "Não foi possível concluir uma verificação no banco de dados; tente novamente")
+
+	// Mensagens dos códigos nas respostas de validação, no idioma da requisição (ver AddCodedError)
+	i18n.RegisterMessages("pt-BR", map[string]string{
+		i18n.CodeKey(CodeCategoriaNomeObrigatorio):    "O nome da categoria é obrigatório",
+		i18n.CodeKey(CodeCategoriaNomeCurto):          "O nome deve ter pelo menos 2 caracteres",
+		i18n.CodeKey(CodeCategoriaNomeDuplicado):      "Já existe uma categoria com este nome",
+		i18n.CodeKey(CodeCategoriaPossuiProdutos):     "Não é possível excluir uma categoria que possui produtos",
+		i18n.CodeKey(CodeProdutoCodigoObrigatorio):    "O código do produto é obrigatório",
+		i18n.CodeKey(CodeProdutoCodigoDuplicado):      "Já existe um produto com este código",
+		i18n.CodeKey(CodeProdutoPrecoInvalido):        "O preço deve ser maior que zero",
+		i18n.CodeKey(CodeProdutoDescricaoCurta):       "A descrição deve ter pelo menos 3 caracteres",
+		i18n.CodeKey(CodeProdutoCategoriaObrigatoria): "A categoria é obrigatória",
+		i18n.CodeKey(CodeProdutoCategoriaInexistente): "Categoria não encontrada",
+		i18n.CodeKey(CodeProdutoCategoriaInativa):     "Categoria inativa não pode ser utilizada",
+		i18n.CodeKey(CodeVerificacaoIndisponivel):     "Não foi possível concluir a verificação; tente novamente",
+	})
+	i18n.RegisterMessages("en", map[string]string{
+		i18n.CodeKey(CodeCategoriaNomeObrigatorio):    "The category name is required",
+		i18n.CodeKey(CodeCategoriaNomeCurto):          "The name must have at least 2 characters",
+		i18n.CodeKey(CodeCategoriaNomeDuplicado):      "A category with this name already exists",
+		i18n.CodeKey(CodeCategoriaPossuiProdutos):     "A category that has products cannot be deleted",
+		i18n.CodeKey(CodeProdutoCodigoObrigatorio):    "The product code is required",
+		i18n.CodeKey(CodeProdutoCodigoDuplicado):      "A product with this code already exists",
+		i18n.CodeKey(CodeProdutoPrecoInvalido):        "The price must be greater than zero",
+		i18n.CodeKey(CodeProdutoDescricaoCurta):       "The description must have at least 3 characters",
+		i18n.CodeKey(CodeProdutoCategoriaObrigatoria): "The category is required",
+		i18n.CodeKey(CodeProdutoCategoriaInexistente): "Category not found",
+		i18n.CodeKey(CodeProdutoCategoriaInativa):     "An inactive category cannot be used",
+		i18n.CodeKey(CodeVerificacaoIndisponivel):     "The check could not be completed; please try again",
+	})
+	i18n.RegisterMessages("es", map[string]string{
+		i18n.CodeKey(CodeCategoriaNomeObrigatorio):    "El nombre de la categoría es obligatorio",
+		i18n.CodeKey(CodeCategoriaNomeCurto):          "El nombre debe tener al menos 2 caracteres",
+		i18n.CodeKey(CodeCategoriaNomeDuplicado):      "Ya existe una categoría con este nombre",
+		i18n.CodeKey(CodeCategoriaPossuiProdutos):     "No es posible eliminar una categoría que tiene productos",
+		i18n.CodeKey(CodeProdutoCodigoObrigatorio):    "El código del producto es obligatorio",
+		i18n.CodeKey(CodeProdutoCodigoDuplicado):      "Ya existe un producto con este código",
+		i18n.CodeKey(CodeProdutoPrecoInvalido):        "El precio debe ser mayor que cero",
+		i18n.CodeKey(CodeProdutoDescricaoCurta):       "La descripción debe tener al menos 3 caracteres",
+		i18n.CodeKey(CodeProdutoCategoriaObrigatoria): "La categoría es obligatoria",
+		i18n.CodeKey(CodeProdutoCategoriaInexistente): "Categoría no encontrada",
+		i18n.CodeKey(CodeProdutoCategoriaInativa):     "No se puede utilizar una categoría inactiva",
+		i18n.CodeKey(CodeVerificacaoIndisponivel):     "No fue posible completar la verificación; inténtelo de nuevo",
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"api_fibergorm/internal/dto"
 	"api_fibergorm/internal/models"
 	"api_fibergorm/internal/repository"
+	arqrepository "api_fibergorm/pkg/arquitetura/repository"
 	"api_fibergorm/pkg/arquitetura/service"
 
 	"github.com/sirupsen/logrus"
@@ -37,41 +38,41 @@ func (v *ProdutoValidator) ValidateCreate(ctx *service.ValidationContext, req *d
 	// Validação: código obrigatório
 	if req.Codigo == "" {
 		v.log.Warn("Tentativa de criar produto sem código")
-		result.AddError("codigo", "O código do produto é obrigatório")
+		result.AddCodedError(ctx.Context, "codigo", CodeProdutoCodigoObrigatorio, nil)
 		return result
 	}
 
 	// Validação: código único
-	exists, err := v.repo.ExistsWhere("codigo = ?", req.Codigo)
+	exists, err := v.repo.WithContext(ctx.Context).ExistsBySpec(arqrepository.Eq("codigo", req.Codigo))
 	if err != nil {
 		v.log.WithError(err).Error("Erro ao verificar código duplicado")
-		result.AddError("codigo", "Erro ao verificar código")
+		result.AddCodedError(ctx.Context, "codigo", CodeVerificacaoIndisponivel, nil)
 		return result
 	}
 	if exists {
 		v.log.WithField("codigo", req.Codigo).Warn("Tentativa de criar produto com código duplicado")
-		result.AddError("codigo", "Já existe um produto com este código")
+		result.AddCodedError(ctx.Context, "codigo", CodeProdutoCodigoDuplicado, nil)
 		return result
 	}
 
 	// Validação: preço positivo
 	if req.Preco <= 0 {
 		v.log.WithField("preco", req.Preco).Warn("Tentativa de criar produto com preço inválido")
-		result.AddError("preco", "O preço deve ser maior que zero")
+		result.AddCodedError(ctx.Context, "preco", CodeProdutoPrecoInvalido, nil)
 		return result
 	}
 
 	// Validação: descrição mínima
 	if len(req.Descricao) < 3 {
 		v.log.WithField("descricao", req.Descricao).Warn("Descrição muito curta")
-		result.AddError("descricao", "A descrição deve ter pelo menos 3 caracteres")
+		result.AddCodedError(ctx.Context, "descricao", CodeProdutoDescricaoCurta, nil)
 		return result
 	}
 
 	// Validação: categoria obrigatória
 	if req.CategoriaID == 0 {
 		v.log.Warn("Tentativa de criar produto sem categoria")
-		result.AddError("categoria_id", "A categoria é obrigatória")
+		result.AddCodedError(ctx.Context, "categoria_id", CodeProdutoCategoriaObrigatoria, nil)
 		return result
 	}
 
@@ -79,12 +80,12 @@ func (v *ProdutoValidator) ValidateCreate(ctx *service.ValidationContext, req *d
 	categoria, err := v.findCategoria(req.CategoriaID)
 	if err != nil {
 		v.log.WithField("categoria_id", req.CategoriaID).Warn("Categoria não encontrada")
-		result.AddError("categoria_id", "Categoria não encontrada")
+		result.AddCodedError(ctx.Context, "categoria_id", CodeProdutoCategoriaInexistente, nil)
 		return result
 	}
 	if !categoria.Ativo {
 		v.log.WithField("categoria_id", req.CategoriaID).Warn("Categoria inativa")
-		result.AddError("categoria_id", "Categoria inativa não pode ser utilizada")
+		result.AddCodedError(ctx.Context, "categoria_id", CodeProdutoCategoriaInativa, nil)
 	}
 
 	return result
@@ -95,45 +96,45 @@ func (v *ProdutoValidator) ValidateUpdate(ctx *service.ValidationContext, entity
 	result := service.NewValidationResult()
 
 	// Validação: código único (se alterado)
-	if req.Codigo != "" && req.Codigo != entity.Codigo {
-		exists, err := v.repo.ExistsWhereExcludingID(ctx.EntityID, "codigo = ?", req.Codigo)
+	if req.Codigo != nil && *req.Codigo != entity.Codigo {
+		exists, err := v.repo.WithContext(ctx.Context).ExistsUnique(map[string]interface{}{"codigo": *req.Codigo}, ctx.EntityID)
 		if err != nil {
 			v.log.WithError(err).Error("Erro ao verificar código duplicado")
-			result.AddError("codigo", "Erro ao verificar código")
+			result.AddCodedError(ctx.Context, "codigo", CodeVerificacaoIndisponivel, nil)
 			return result
 		}
 		if exists {
-			v.log.WithField("codigo", req.Codigo).Warn("Tentativa de atualizar para código duplicado")
-			result.AddError("codigo", "Já existe outro produto com este código")
+			v.log.WithField("codigo", *req.Codigo).Warn("Tentativa de atualizar para código duplicado")
+			result.AddCodedError(ctx.Context, "codigo", CodeProdutoCodigoDuplicado, nil)
 			return result
 		}
 	}
 
-	// Validação: preço positivo (se informado)
-	if req.Preco != 0 && req.Preco <= 0 {
-		v.log.WithField("preco", req.Preco).Warn("Tentativa de atualizar com preço inválido")
-		result.AddError("preco", "O preço deve ser maior que zero")
+	// Validação: preço positivo (se informado; zero informado explicitamente também é rejeitado)
+	if req.Preco != nil && *req.Preco <= 0 {
+		v.log.WithField("preco", *req.Preco).Warn("Tentativa de atualizar com preço inválido")
+		result.AddCodedError(ctx.Context, "preco", CodeProdutoPrecoInvalido, nil)
 		return result
 	}
 
-	// Validação: descrição mínima (se informada)
-	if req.Descricao != "" && len(req.Descricao) < 3 {
-		v.log.WithField("descricao", req.Descricao).Warn("Descrição muito curta")
-		result.AddError("descricao", "A descrição deve ter pelo menos 3 caracteres")
+	// Validação: descrição mínima (se informada e não vazia; vazia limpa a descrição)
+	if req.Descricao != nil && *req.Descricao != "" && len(*req.Descricao) < 3 {
+		v.log.WithField("descricao", *req.Descricao).Warn("Descrição muito curta")
+		result.AddCodedError(ctx.Context, "descricao", CodeProdutoDescricaoCurta, nil)
 		return result
 	}
 
 	// Validação: categoria (se informada)
-	if req.CategoriaID != 0 && req.CategoriaID != entity.CategoriaID {
-		categoria, err := v.findCategoria(req.CategoriaID)
+	if req.CategoriaID != nil && *req.CategoriaID != entity.CategoriaID {
+		categoria, err := v.findCategoria(*req.CategoriaID)
 		if err != nil {
-			v.log.WithField("categoria_id", req.CategoriaID).Warn("Categoria não encontrada")
-			result.AddError("categoria_id", "Categoria não encontrada")
+			v.log.WithField("categoria_id", *req.CategoriaID).Warn("Categoria não encontrada")
+			result.AddCodedError(ctx.Context, "categoria_id", CodeProdutoCategoriaInexistente, nil)
 			return result
 		}
 		if !categoria.Ativo {
-			v.log.WithField("categoria_id", req.CategoriaID).Warn("Categoria inativa")
-			result.AddError("categoria_id", "Categoria inativa não pode ser utilizada")
+			v.log.WithField("categoria_id", *req.CategoriaID).Warn("Categoria inativa")
+			result.AddCodedError(ctx.Context, "categoria_id", CodeProdutoCategoriaInativa, nil)
 		}
 	}
 
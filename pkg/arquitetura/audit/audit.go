@@ -0,0 +1,320 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"gorm.io/gorm"
+)
+
+// Operation identifica a operação auditada
+type Operation string
+
+const (
+	OperationCreate            Operation = "create"
+	OperationUpdate            Operation = "update"
+	OperationDelete            Operation = "delete"
+	OperationRestore           Operation = "restore"
+	OperationDeletePermanently Operation = "delete_permanently"
+)
+
+// ParseOperation converte o valor informado (ex: query string) para Operation
+func ParseOperation(value string) (Operation, bool) {
+	switch op := Operation(value); op {
+	case OperationCreate, OperationUpdate, OperationDelete, OperationRestore, OperationDeletePermanently:
+		return op, true
+	}
+	return "", false
+}
+
+// ignoredFields campos que não geram diferença na auditoria (alterados em toda escrita ou já registrados em Actor)
+var ignoredFields = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"deleted_at": true,
+	"created_by": true,
+	"updated_by": true,
+}
+
+// FieldChange representa a alteração de um campo
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// Entry representa um registro da trilha de auditoria (tabela audit_logs)
+// Snapshot guarda o estado da entidade após a operação (antes, na exclusão),
+// permitindo comparar quaisquer duas versões
+type Entry struct {
+	ID         uint      `gorm:"primaryKey"`
+	EntityName string    `gorm:"type:varchar(100);not null;index:idx_audit_logs_entity"`
+	EntityID   uint      `gorm:"not null;index:idx_audit_logs_entity"`
+	Operation  Operation `gorm:"type:varchar(30);not null"`
+	Actor      string    `gorm:"type:varchar(100);index"`
+	RequestID  string    `gorm:"type:varchar(100);index"`
+	Changes    string    `gorm:"type:jsonb"`
+	Snapshot   string    `gorm:"type:jsonb"`
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+// TableName define o nome da tabela no banco de dados
+func (Entry) TableName() string {
+	return "audit_logs"
+}
+
+// Filter filtros da consulta ao histórico
+type Filter struct {
+	From      *time.Time // Registros a partir desta data (inclusive)
+	To        *time.Time // Registros até esta data (inclusive)
+	Operation Operation  // Apenas a operação informada (vazio = todas)
+}
+
+// Query filtros da consulta à trilha de auditoria de todas as entidades
+// Campos vazios não filtram
+type Query struct {
+	Filter
+	EntityName string // Tabela da entidade (ex: produtos)
+	EntityID   uint   // Registro da entidade (requer EntityName)
+	Actor      string // Usuário que realizou a operação
+	RequestID  string // Requisição que originou a operação
+	Field      string // Apenas operações que alteraram o campo (ex: preco)
+}
+
+// Auditor grava e consulta a trilha de auditoria das entidades
+type Auditor struct {
+	db  *gorm.DB
+	log logging.Logger
+}
+
+// NewAuditor cria um novo auditor
+func NewAuditor(db *gorm.DB, log logging.Logger) *Auditor {
+	return &Auditor{
+		db:  db,
+		log: log,
+	}
+}
+
+// Snapshot converte a entidade para o mapa de campos auditados (apenas valores escalares;
+// relacionamentos são ignorados). Deve ser chamado antes de alterar a entidade para obter o estado anterior
+func Snapshot(entity interface{}) map[string]interface{} {
+	if entity == nil || (reflect.ValueOf(entity).Kind() == reflect.Ptr && reflect.ValueOf(entity).IsNil()) {
+		return nil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	for key, value := range fields {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			delete(fields, key)
+		}
+	}
+	return fields
+}
+
+// Diff retorna os campos alterados entre dois snapshots
+func Diff(before, after map[string]interface{}) map[string]FieldChange {
+	changes := make(map[string]FieldChange)
+
+	for key, newValue := range after {
+		if ignoredFields[key] {
+			continue
+		}
+		oldValue, ok := before[key]
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			changes[key] = FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	for key, oldValue := range before {
+		if ignoredFields[key] {
+			continue
+		}
+		if _, ok := after[key]; !ok {
+			changes[key] = FieldChange{Old: oldValue, New: nil}
+		}
+	}
+	return changes
+}
+
+// Record grava a operação na trilha de auditoria
+// before e after são os snapshots anterior e posterior (nil na criação/exclusão, respectivamente)
+// Falhas são registradas no log e não interrompem a operação auditada
+func (a *Auditor) Record(ctx context.Context, entityName string, entityID uint, op Operation, before, after map[string]interface{}) {
+	snapshot := after
+	if snapshot == nil {
+		snapshot = before
+	}
+
+	changes, err := json.Marshal(Diff(before, after))
+	if err != nil {
+		a.log.WithError(err).Warn("Falha ao serializar alterações da auditoria")
+		return
+	}
+	state, err := json.Marshal(snapshot)
+	if err != nil {
+		a.log.WithError(err).Warn("Falha ao serializar snapshot da auditoria")
+		return
+	}
+
+	info := InfoFromContext(ctx)
+	entry := &Entry{
+		EntityName: entityName,
+		EntityID:   entityID,
+		Operation:  op,
+		Actor:      info.Actor,
+		RequestID:  info.RequestID,
+		Changes:    string(changes),
+		Snapshot:   string(state),
+	}
+
+	// Dentro de uma transação da requisição, o registro acompanha o commit/rollback da operação
+	db := a.db
+	if tx, ok := repository.TxFromContext(ctx); ok {
+		db = tx
+	}
+	if err := db.WithContext(context.WithoutCancel(ctx)).Create(entry).Error; err != nil {
+		a.log.WithError(err).WithFields(logging.Fields{
+			"entity":    entityName,
+			"id":        entityID,
+			"operation": op,
+		}).Error("Falha ao gravar auditoria")
+	}
+}
+
+// History retorna os registros de auditoria de uma entidade, do mais recente para o mais antigo
+func (a *Auditor) History(ctx context.Context, entityName string, entityID uint, filter Filter, page, pageSize int) ([]Entry, int64, error) {
+	query := a.db.WithContext(ctx).Model(&Entry{}).
+		Where("entity_name = ? AND entity_id = ?", entityName, entityID)
+
+	return a.page(applyFilter(query, filter), page, pageSize)
+}
+
+// List consulta a trilha de auditoria de todas as entidades, do mais recente para o mais antigo
+// Usado pela consulta geral (ex: quem alterou os preços dos produtos e quando)
+func (a *Auditor) List(ctx context.Context, q Query, page, pageSize int) ([]Entry, int64, error) {
+	query := applyFilter(a.db.WithContext(ctx).Model(&Entry{}), q.Filter)
+
+	if q.EntityName != "" {
+		query = query.Where("entity_name = ?", q.EntityName)
+	}
+	if q.EntityID != 0 {
+		query = query.Where("entity_id = ?", q.EntityID)
+	}
+	if q.Actor != "" {
+		query = query.Where("actor = ?", q.Actor)
+	}
+	if q.RequestID != "" {
+		query = query.Where("request_id = ?", q.RequestID)
+	}
+	if q.Field != "" {
+		// jsonb_exists equivale ao operador ?, que conflitaria com os placeholders do GORM
+		query = query.Where("jsonb_exists(changes, ?)", q.Field)
+	}
+
+	return a.page(query, page, pageSize)
+}
+
+// page conta os registros da consulta e retorna a página solicitada, do mais recente para o mais antigo
+func (a *Auditor) page(query *gorm.DB, page, pageSize int) ([]Entry, int64, error) {
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []Entry
+	err := query.Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error
+	return entries, total, err
+}
+
+// DayCount representa a quantidade de operações de um tipo em um dia
+type DayCount struct {
+	Day       time.Time
+	Operation Operation
+	Total     int64
+}
+
+// CountByDay retorna a quantidade de operações por dia e tipo de uma entidade (todas as instâncias)
+// Usado nos relatórios de movimento por período; dias sem operações não aparecem no resultado
+func (a *Auditor) CountByDay(ctx context.Context, entityName string, filter Filter) ([]DayCount, error) {
+	query := a.db.WithContext(ctx).Model(&Entry{}).
+		Select("DATE(created_at) AS day, operation, COUNT(*) AS total").
+		Where("entity_name = ?", entityName)
+
+	var rows []DayCount
+	err := applyFilter(query, filter).Group("DATE(created_at), operation").
+		Order("day, operation").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// applyFilter aplica os filtros de período e operação à consulta
+func applyFilter(query *gorm.DB, filter Filter) *gorm.DB {
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	return query
+}
+
+// VersionRef identifica uma versão da entidade na trilha de auditoria:
+// pelo ID do registro de auditoria, pelo estado vigente em uma data ou, vazio, pela versão atual
+type VersionRef struct {
+	EntryID uint
+	At      *time.Time
+}
+
+// Version retorna o registro de auditoria correspondente à versão informada
+// Retorna nil quando a entidade ainda não existia na data ou o registro não pertence à entidade
+func (a *Auditor) Version(ctx context.Context, entityName string, entityID uint, ref VersionRef) (*Entry, error) {
+	query := a.db.WithContext(ctx).
+		Where("entity_name = ? AND entity_id = ?", entityName, entityID)
+
+	if ref.EntryID != 0 {
+		query = query.Where("id = ?", ref.EntryID)
+	} else if ref.At != nil {
+		query = query.Where("created_at <= ?", *ref.At)
+	}
+
+	var entries []Entry
+	if err := query.Order("created_at DESC, id DESC").Limit(1).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// State retorna o estado da entidade registrado na versão (vazio após a exclusão definitiva)
+func (e *Entry) State() map[string]interface{} {
+	if e == nil || e.Operation == OperationDeletePermanently {
+		return nil
+	}
+
+	var state map[string]interface{}
+	if e.Snapshot != "" {
+		_ = json.Unmarshal([]byte(e.Snapshot), &state)
+	}
+	return state
+}
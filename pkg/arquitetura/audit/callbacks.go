@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Colunas preenchidas pelos callbacks (ver entity.AuditedEntity)
+const (
+	createdByField = "CreatedBy"
+	updatedByField = "UpdatedBy"
+)
+
+// RegisterCallbacks registra no GORM os callbacks que preenchem CreatedBy/UpdatedBy das entidades que
+// embutem entity.AuditedEntity com o usuário do contexto (Info.Actor, header X-User-ID)
+//   - Criação: CreatedBy e UpdatedBy, exceto os já informados
+//   - Atualização (Save, Updates, UpdateFields, UpdateWhere...): UpdatedBy sempre, inclusive vazio, para
+//     que uma alteração sem usuário identificado (jobs, scripts) não seja atribuída ao último usuário
+//
+// Entidades sem essas colunas não são afetadas
+func RegisterCallbacks(db *gorm.DB) error {
+	if err := db.Callback().Create().Before("gorm:create").Register("audit:created_by", setCreatedBy); err != nil {
+		return err
+	}
+	return db.Callback().Update().Before("gorm:update").Register("audit:updated_by", setUpdatedBy)
+}
+
+// setCreatedBy preenche o usuário da criação (também em lotes)
+func setCreatedBy(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return
+	}
+	actor := InfoFromContext(db.Statement.Context).Actor
+	if actor == "" {
+		return
+	}
+	for _, name := range []string{createdByField, updatedByField} {
+		field := db.Statement.Schema.LookUpField(name)
+		if field == nil {
+			continue
+		}
+		switch value := db.Statement.ReflectValue; value.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				fillIfZero(db.Statement.Context, field, value.Index(i), actor)
+			}
+		case reflect.Struct:
+			fillIfZero(db.Statement.Context, field, value, actor)
+		}
+	}
+}
+
+// fillIfZero preenche o campo da entidade quando ainda não informado
+func fillIfZero(ctx context.Context, field *schema.Field, value reflect.Value, actor string) {
+	value = reflect.Indirect(value)
+	if _, isZero := field.ValueOf(ctx, value); isZero {
+		_ = field.Set(ctx, value, actor)
+	}
+}
+
+// setUpdatedBy preenche o usuário da alteração
+func setUpdatedBy(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return
+	}
+	if field := db.Statement.Schema.LookUpField(updatedByField); field != nil {
+		db.Statement.SetColumn(field.DBName, InfoFromContext(db.Statement.Context).Actor, true)
+	}
+}
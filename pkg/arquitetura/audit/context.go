@@ -0,0 +1,27 @@
+package audit
+
+import "context"
+
+// Info identifica quem executou a operação auditada
+type Info struct {
+	Actor     string // Usuário responsável (ex: header X-User-ID repassado pelo gateway)
+	RequestID string // ID da requisição (X-Request-ID)
+}
+
+// infoKey chave das informações de auditoria no context.Context
+type infoKey struct{}
+
+// WithInfo retorna um contexto com as informações de auditoria
+func WithInfo(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// InfoFromContext retorna as informações de auditoria armazenadas no contexto
+func InfoFromContext(ctx context.Context) Info {
+	if ctx != nil {
+		if info, ok := ctx.Value(infoKey{}).(Info); ok {
+			return info
+		}
+	}
+	return Info{}
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// EntryResponse representa um registro do histórico de uma entidade
+// @Description Registro do histórico de alterações
+type EntryResponse struct {
+	ID        uint                   `json:"id" example:"1"`
+	Operation Operation              `json:"operation" example:"update"`
+	Actor     string                 `json:"actor,omitempty" example:"maria"`
+	RequestID string                 `json:"request_id,omitempty" example:"3f2c9a7e-1b2d-4c5e-8f90-123456789abc"`
+	Changes   map[string]FieldChange `json:"changes"`
+	CreatedAt string                 `json:"created_at" example:"2024-01-01T10:00:00Z"`
+}
+
+// ToResponse converte o registro de auditoria para response
+func (e *Entry) ToResponse() EntryResponse {
+	changes := make(map[string]FieldChange)
+	if e.Changes != "" {
+		_ = json.Unmarshal([]byte(e.Changes), &changes)
+	}
+
+	return EntryResponse{
+		ID:        e.ID,
+		Operation: e.Operation,
+		Actor:     e.Actor,
+		RequestID: e.RequestID,
+		Changes:   changes,
+		CreatedAt: entity.FormatTime(e.CreatedAt),
+	}
+}
+
+// LogResponse representa um registro da trilha de auditoria na consulta geral (todas as entidades)
+// @Description Registro da trilha de auditoria
+type LogResponse struct {
+	Entity   string `json:"entity" example:"produtos"`
+	EntityID uint   `json:"entity_id" example:"1"`
+	EntryResponse
+}
+
+// ToLogResponse converte o registro de auditoria para response da consulta geral
+func (e *Entry) ToLogResponse() LogResponse {
+	return LogResponse{
+		Entity:        e.EntityName,
+		EntityID:      e.EntityID,
+		EntryResponse: e.ToResponse(),
+	}
+}
+
+// VersionResponse identifica uma versão comparada
+// @Description Versão do histórico usada na comparação
+type VersionResponse struct {
+	ID        uint      `json:"id" example:"12"`
+	Operation Operation `json:"operation" example:"update"`
+	Actor     string    `json:"actor,omitempty" example:"maria"`
+	CreatedAt string    `json:"created_at" example:"2024-01-01T10:00:00Z"`
+}
+
+// DiffResponse representa as diferenças entre duas versões de uma entidade
+// From é nulo quando a entidade ainda não existia na versão inicial
+// @Description Diferenças campo a campo entre duas versões
+type DiffResponse struct {
+	From    *VersionResponse       `json:"from"`
+	To      *VersionResponse       `json:"to"`
+	Changes map[string]FieldChange `json:"changes"`
+}
+
+// NewDiffResponse compara os estados das duas versões
+func NewDiffResponse(from, to *Entry) *DiffResponse {
+	return &DiffResponse{
+		From:    from.toVersionResponse(),
+		To:      to.toVersionResponse(),
+		Changes: Diff(from.State(), to.State()),
+	}
+}
+
+// toVersionResponse converte o registro para a identificação da versão
+func (e *Entry) toVersionResponse() *VersionResponse {
+	if e == nil {
+		return nil
+	}
+	return &VersionResponse{
+		ID:        e.ID,
+		Operation: e.Operation,
+		Actor:     e.Actor,
+		CreatedAt: entity.FormatTime(e.CreatedAt),
+	}
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Cache é a interface de armazenamento de valores com expiração
+// Os valores são serializados em JSON, de modo que quem lê recebe sempre uma cópia
+type Cache interface {
+	// Get preenche dest com o valor armazenado; retorna false se a chave não existir
+	Get(ctx context.Context, key string, dest interface{}) (bool, error)
+
+	// Set armazena o valor pelo tempo informado
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+
+	// Delete remove as chaves informadas
+	Delete(ctx context.Context, keys ...string) error
+
+	// Clear remove todos os valores do cache
+	Clear(ctx context.Context) error
+}
+
+// New retorna um cache no Redis quando o cliente é informado, senão um cache em memória
+func New(client *redis.Client) Cache {
+	if client != nil {
+		return NewRedisCache(client)
+	}
+	return NewMemoryCache()
+}
+
+// memoryItem representa um valor armazenado em memória com expiração
+type memoryItem struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// MemoryCache armazena os valores em memória (adequado para uma única instância)
+type MemoryCache struct {
+	items map[string]memoryItem
+	mutex sync.RWMutex
+}
+
+// NewMemoryCache cria um novo cache em memória
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		items: make(map[string]memoryItem),
+	}
+}
+
+// Get preenche dest com o valor armazenado; retorna false se a chave não existir ou tiver expirado
+func (c *MemoryCache) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
+	c.mutex.RLock()
+	item, ok := c.items[key]
+	c.mutex.RUnlock()
+
+	if !ok || time.Now().After(item.expiresAt) {
+		return false, nil
+	}
+	return true, json.Unmarshal(item.data, dest)
+}
+
+// Set armazena o valor pelo tempo informado
+func (c *MemoryCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.evictExpired()
+	c.items[key] = memoryItem{
+		data:      data,
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// Delete remove as chaves informadas
+func (c *MemoryCache) Delete(ctx context.Context, keys ...string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(c.items, key)
+	}
+	return nil
+}
+
+// Clear remove todos os valores armazenados
+func (c *MemoryCache) Clear(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.items = make(map[string]memoryItem)
+	return nil
+}
+
+// evictExpired remove os itens expirados (deve ser chamado com o mutex bloqueado)
+func (c *MemoryCache) evictExpired() {
+	now := time.Now()
+	for key, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, key)
+		}
+	}
+}
+
+// RedisCache armazena os valores no Redis (compartilhado entre instâncias)
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisCache cria um novo cache no Redis
+func NewRedisCache(client *redis.Client) *RedisCache {
+	return &RedisCache{
+		client: client,
+		prefix: "cache:",
+	}
+}
+
+// Get preenche dest com o valor armazenado; retorna false se a chave não existir
+func (c *RedisCache) Get(ctx context.Context, key string, dest interface{}) (bool, error) {
+	data, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, json.Unmarshal(data, dest)
+}
+
+// Set armazena o valor pelo tempo informado
+func (c *RedisCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, c.prefix+key, data, ttl).Err()
+}
+
+// Delete remove as chaves informadas
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.prefix + key
+	}
+	return c.client.Del(ctx, prefixed...).Err()
+}
+
+// Clear remove as chaves do cache (apenas as do prefixo, preservando os demais dados do Redis)
+// As chaves são localizadas com SCAN, sem bloquear o servidor como KEYS
+func (c *RedisCache) Clear(ctx context.Context) error {
+	iter := c.client.Scan(ctx, 0, c.prefix+"*", 500).Iterator()
+	keys := make([]string, 0, 500)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == cap(keys) {
+			if err := c.client.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+			keys = keys[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.client.Del(ctx, keys...).Err()
+}
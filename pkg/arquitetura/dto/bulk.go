@@ -0,0 +1,30 @@
+package dto
+
+// Status dos itens de uma operação em lote
+const (
+	BulkStatusCreated = "created"
+	BulkStatusUpdated = "updated"
+	BulkStatusError   = "error"
+)
+
+// BulkItemResult representa o resultado de um item da operação em lote
+// @Description Resultado de um item do lote
+type BulkItemResult struct {
+	Index  int               `json:"index" example:"0"`
+	Key    interface{}       `json:"key" swaggertype:"string" example:"PROD001"`
+	Status string            `json:"status" example:"created"`
+	ID     uint              `json:"id,omitempty" example:"1"`
+	Errors map[string]string `json:"errors,omitempty"`
+	Codes  map[string]string `json:"codes,omitempty"`
+}
+
+// BulkResponse representa o resultado de uma operação em lote
+// Quando algum item falha na validação, nenhum item é gravado
+// @Description Resultado da operação em lote
+type BulkResponse struct {
+	Total   int              `json:"total" example:"2"`
+	Created int              `json:"created" example:"1"`
+	Updated int              `json:"updated" example:"1"`
+	Failed  int              `json:"failed" example:"0"`
+	Items   []BulkItemResult `json:"items"`
+}
@@ -1,12 +1,43 @@
 package dto
 
-import "api_fibergorm/pkg/arquitetura/entity"
+import (
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+)
 
 // ErrorResponse representa uma resposta de erro padrão da API
 // @Description Resposta de erro padrão da API
+// Code é estável (catálogo em GET /api/v1/erros) e deve ser usado pelos clientes no lugar da mensagem
+// Codes traz o código de cada campo inválido, quando houver
+// RequestID e TraceID identificam a requisição nos logs (informados ao suporte)
 type ErrorResponse struct {
-	Error   string            `json:"error" example:"Erro de validação"`
-	Details map[string]string `json:"details,omitempty"`
+	Code      string            `json:"code,omitempty" example:"VALIDATION_ERROR"`
+	Error     string            `json:"error" example:"Erro de validação"`
+	Details   map[string]string `json:"details,omitempty"`
+	Codes     map[string]string `json:"codes,omitempty"`
+	RequestID string            `json:"request_id,omitempty" example:"3f2c9a7e-1b2d-4c5e-8f90-123456789abc"`
+	TraceID   string            `json:"trace_id,omitempty" example:"4bf92f3577b34da6a3ce929d0e0e4736"`
+	Timestamp string            `json:"timestamp,omitempty" example:"2024-01-15T10:30:00Z"`
+}
+
+// ProblemDetails representa uma resposta de erro no formato RFC 7807 (application/problem+json)
+// @Description Resposta de erro no formato RFC 7807, usada quando ERROR_FORMAT=problem
+// Type identifica o código de erro (catálogo em GET /api/v1/erros), Title é o texto do HTTP status,
+// Detail a mensagem no idioma da requisição e Instance o caminho da requisição
+// Os demais campos são extensões com o mesmo significado dos campos de ErrorResponse
+type ProblemDetails struct {
+	Type      string            `json:"type" example:"/api/v1/erros#VALIDATION_ERROR"`
+	Title     string            `json:"title" example:"Bad Request"`
+	Status    int               `json:"status" example:"400"`
+	Detail    string            `json:"detail,omitempty" example:"Erro de validação"`
+	Instance  string            `json:"instance,omitempty" example:"/api/v1/produtos"`
+	Code      string            `json:"code,omitempty" example:"VALIDATION_ERROR"`
+	Details   map[string]string `json:"details,omitempty"`
+	Codes     map[string]string `json:"codes,omitempty"`
+	RequestID string            `json:"request_id,omitempty" example:"3f2c9a7e-1b2d-4c5e-8f90-123456789abc"`
+	TraceID   string            `json:"trace_id,omitempty" example:"4bf92f3577b34da6a3ce929d0e0e4736"`
+	Timestamp string            `json:"timestamp,omitempty" example:"2024-01-15T10:30:00Z"`
 }
 
 // SuccessResponse representa uma resposta de sucesso genérica
@@ -15,14 +46,24 @@ type SuccessResponse struct {
 	Message string `json:"message" example:"Operação realizada com sucesso"`
 }
 
+// CountResponse representa o total de registros de uma listagem (GET /count)
+// @Description Total de registros que atendem aos filtros da listagem
+type CountResponse struct {
+	Total     int64 `json:"total" example:"100"`
+	Estimated bool  `json:"estimated,omitempty" example:"false"`
+}
+
 // PaginatedResponse representa uma resposta paginada genérica
 // @Description Resposta paginada com lista de itens
+// Total e TotalPages são omitidos quando a contagem não é solicitada (?count=none)
 type PaginatedResponse[T any] struct {
-	Data       []T   `json:"data"`
-	Total      int64 `json:"total" example:"100"`
-	Page       int   `json:"page" example:"1"`
-	PageSize   int   `json:"page_size" example:"10"`
-	TotalPages int   `json:"total_pages" example:"10"`
+	Data           []T    `json:"data"`
+	Total          *int64 `json:"total,omitempty" example:"100"`
+	Page           int    `json:"page" example:"1"`
+	PageSize       int    `json:"page_size" example:"10"`
+	TotalPages     *int   `json:"total_pages,omitempty" example:"10"`
+	HasNext        bool   `json:"has_next" example:"true"`
+	TotalEstimated bool   `json:"total_estimated,omitempty" example:"false"`
 }
 
 // NewPaginatedResponse cria uma resposta paginada
@@ -37,25 +78,71 @@ func NewPaginatedResponse[T any](data []T, total int64, page, pageSize int) *Pag
 
 	return &PaginatedResponse[T]{
 		Data:       data,
-		Total:      total,
+		Total:      &total,
 		Page:       page,
 		PageSize:   pageSize,
-		TotalPages: totalPages,
+		TotalPages: &totalPages,
+		HasNext:    page < totalPages,
+	}
+}
+
+// NewPaginatedResponseWithoutTotal cria uma resposta paginada sem contagem total
+// hasNext indica se existe uma próxima página
+func NewPaginatedResponseWithoutTotal[T any](data []T, page, pageSize int, hasNext bool) *PaginatedResponse[T] {
+	return &PaginatedResponse[T]{
+		Data:     data,
+		Page:     page,
+		PageSize: pageSize,
+		HasNext:  hasNext,
 	}
 }
 
+// CursorPaginatedResponse representa uma resposta paginada por cursor (keyset)
+// @Description Resposta paginada por cursor; next_cursor deve ser enviado em ?cursor= para obter a próxima página
+type CursorPaginatedResponse[T any] struct {
+	Data       []T    `json:"data"`
+	PageSize   int    `json:"page_size" example:"10"`
+	HasNext    bool   `json:"has_next" example:"true"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJ2IjoiMjAyNC0wMS0xNVQxMDowMDowMFoiLCJpZCI6NDJ9"`
+}
+
+// SearchHit representa um resultado da busca textual (GET /search)
+// @Description Resultado da busca com a relevância e os trechos com os termos encontrados entre <mark></mark>
+// Highlights traz os trechos por campo (apenas os campos com termos encontrados), já escapados para HTML
+type SearchHit[T any] struct {
+	Item       T                 `json:"item"`
+	Rank       float64           `json:"rank" example:"0.6079"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+// LastModifiedResponse é implementada pelos responses que expõem a data da última alteração
+// Usada pelo handler base para emitir Last-Modified e responder If-Modified-Since
+type LastModifiedResponse interface {
+	LastModified() time.Time
+}
+
 // Mapper é uma interface genérica para mapeamento entre entidades e DTOs
 // E é o tipo ponteiro da entidade (ex: *models.Categoria)
+//
+// ToEntity e ApplyUpdate podem falhar em conversões reais (datas, valores monetários, enums);
+// use arqerrors.NewMappingError(campo, err) para que a falha seja reportada como erro de validação do campo
 type Mapper[E entity.Entity, CreateReq any, UpdateReq any, Resp any] interface {
 	// ToEntity converte um request de criação para a entidade
 	// Retorna E (que já é ponteiro, ex: *models.Categoria)
-	ToEntity(req *CreateReq) E
+	ToEntity(req *CreateReq) (E, error)
 
 	// ToResponse converte uma entidade para response
-	// Recebe E (que já é ponteiro, ex: *models.Categoria)
+	// Recebe E (que já é ponteiro, ex: *models.Categoria); entidade nil resulta em response nil
 	ToResponse(entity E) *Resp
 
 	// ApplyUpdate aplica as alterações do request na entidade
 	// Recebe E (que já é ponteiro, ex: *models.Categoria)
-	ApplyUpdate(entity E, req *UpdateReq)
+	ApplyUpdate(entity E, req *UpdateReq) error
+}
+
+// PatchMapper é implementado opcionalmente pelo mapper para controlar a atualização com máscara (PATCH)
+// ApplyPatch aplica apenas os campos da máscara (nomes JSON do request), inclusive os valores zero
+// Mappers que não o implementam usam mapper.ApplyMask (correspondência por nome, como o AutoMapper)
+type PatchMapper[E entity.Entity, UpdateReq any] interface {
+	ApplyPatch(entity E, req *UpdateReq, mask []string) error
 }
@@ -0,0 +1,27 @@
+package dto
+
+// ImportRow representa uma linha do arquivo de importação convertida para o request de criação
+// Errors traz os campos cujos valores não puderam ser convertidos (a linha é rejeitada sem validação)
+type ImportRow[T any] struct {
+	Line    int
+	Request *T
+	Errors  map[string]string
+}
+
+// ImportRowError representa uma linha rejeitada na importação
+// @Description Linha rejeitada com os erros por campo
+type ImportRowError struct {
+	Line   int               `json:"line" example:"3"`
+	Errors map[string]string `json:"errors"`
+	Codes  map[string]string `json:"codes,omitempty"`
+}
+
+// ImportResponse representa o resultado de uma importação de arquivo
+// As linhas válidas são gravadas mesmo quando outras são rejeitadas
+// @Description Resultado da importação com as linhas rejeitadas
+type ImportResponse struct {
+	Total    int              `json:"total" example:"120"`
+	Imported int              `json:"imported" example:"118"`
+	Rejected int              `json:"rejected" example:"2"`
+	Errors   []ImportRowError `json:"errors"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+// ValidationResponse representa o resultado da validação sem gravação (?dry_run=true e /validate)
+// @Description Resultado da validação do request
+type ValidationResponse struct {
+	Valid  bool              `json:"valid" example:"false"`
+	Errors map[string]string `json:"errors,omitempty"`
+	Codes  map[string]string `json:"codes,omitempty"`
+}
@@ -0,0 +1,22 @@
+package entity
+
+// AuditedEntity estende BaseEntity com o usuário que criou e o que alterou o registro por último
+// Opcional: embutida no lugar de BaseEntity nas entidades que precisam dessa informação (ex: Produto)
+//
+// As colunas são preenchidas pelos callbacks do GORM registrados com audit.RegisterCallbacks, a partir do
+// usuário da requisição no contexto das queries (audit.InfoFromContext); as escritas devem usar WithContext
+type AuditedEntity struct {
+	BaseEntity
+	CreatedBy string `gorm:"type:varchar(100)" json:"created_by,omitempty"`
+	UpdatedBy string `gorm:"type:varchar(100)" json:"updated_by,omitempty"`
+}
+
+// GetCreatedBy retorna o usuário que criou a entidade
+func (e *AuditedEntity) GetCreatedBy() string {
+	return e.CreatedBy
+}
+
+// GetUpdatedBy retorna o usuário que alterou a entidade por último
+func (e *AuditedEntity) GetUpdatedBy() string {
+	return e.UpdatedBy
+}
@@ -3,6 +3,7 @@ package entity
 import (
 	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -10,18 +11,30 @@ import (
 type Entity interface {
 	GetID() uint
 	SetID(id uint)
+	GetPublicID() uuid.UUID
 	GetCreatedAt() string
 	GetUpdatedAt() string
 	TableName() string
 }
 
+// Modifiable é implementada pelas entidades com data de atualização (BaseEntity e BaseEntityUUID)
+// Usada na pré-condição das atualizações (If-Unmodified-Since / expected_updated_at)
+type Modifiable interface {
+	LastModified() time.Time
+}
+
 // BaseEntity contém os campos comuns a todas as entidades
 // Deve ser embutida em todas as entidades do sistema
 // NOTA: As entidades que embutem BaseEntity devem implementar TableName()
+//
+// PublicID é o identificador público (UUID) para sistemas externos referenciarem registros sem
+// expor os IDs sequenciais. É gerado na criação (BeforeCreate); o default do banco preenche os
+// registros já existentes quando a coluna é adicionada pela migração
 type BaseEntity struct {
 	ID        uint           `gorm:"primaryKey" json:"id"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	PublicID  uuid.UUID      `gorm:"type:uuid;default:gen_random_uuid();uniqueIndex;not null" json:"public_id"`
+	CreatedAt time.Time      `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"index" json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 }
 
@@ -35,14 +48,33 @@ func (e *BaseEntity) SetID(id uint) {
 	e.ID = id
 }
 
-// GetCreatedAt retorna a data de criação formatada
+// GetPublicID retorna o identificador público (UUID) da entidade
+func (e *BaseEntity) GetPublicID() uuid.UUID {
+	return e.PublicID
+}
+
+// BeforeCreate gera o identificador público quando não informado
+// Entidades que definem seu próprio BeforeCreate devem chamar e.BaseEntity.BeforeCreate(tx)
+func (e *BaseEntity) BeforeCreate(tx *gorm.DB) error {
+	if e.PublicID == uuid.Nil {
+		e.PublicID = uuid.New()
+	}
+	return nil
+}
+
+// GetCreatedAt retorna a data de criação formatada (ver SetTimeFormat)
 func (e *BaseEntity) GetCreatedAt() string {
-	return e.CreatedAt.Format("2006-01-02 15:04:05")
+	return FormatTime(e.CreatedAt)
 }
 
-// GetUpdatedAt retorna a data de atualização formatada
+// GetUpdatedAt retorna a data de atualização formatada (ver SetTimeFormat)
 func (e *BaseEntity) GetUpdatedAt() string {
-	return e.UpdatedAt.Format("2006-01-02 15:04:05")
+	return FormatTime(e.UpdatedAt)
+}
+
+// LastModified retorna a data da última atualização (entity.Modifiable)
+func (e *BaseEntity) LastModified() time.Time {
+	return e.UpdatedAt
 }
 
 // TableName deve ser implementado pelas entidades que embutem BaseEntity
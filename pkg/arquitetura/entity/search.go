@@ -0,0 +1,14 @@
+package entity
+
+// Searchable é implementada pelas entidades que participam da busca textual (GET /search)
+// As colunas declaradas compõem o vetor de busca (tsvector) e os índices trigram criados na migração
+// (ver repository.EnsureSearchIndexes); entidades que não a implementam não expõem a busca
+type Searchable interface {
+	SearchColumns() []SearchColumn
+}
+
+// SearchColumn descreve uma coluna de texto da busca
+type SearchColumn struct {
+	Name   string // Nome da coluna no banco (ex: descricao)
+	Weight string // Peso na relevância: A (maior) a D; vazio = D
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+// Layouts nomeados aceitos por SetTimeFormat (além de qualquer layout Go)
+var namedTimeLayouts = map[string]string{
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"DateTime":    time.DateTime,
+}
+
+// Formato das datas nas respostas (padrão: RFC3339 em UTC, que inclui o fuso e é seguro entre horários de verão)
+var (
+	timeMu       sync.RWMutex
+	timeLayout   = time.RFC3339
+	timeLocation = time.UTC
+)
+
+// SetTimeFormat define o layout e o fuso usados nas datas das respostas (BaseEntity e mappers)
+// layout aceita os nomes RFC3339, RFC3339Nano e DateTime ou um layout Go (ex: 2006-01-02 15:04:05);
+// vazio mantém RFC3339. loc nil usa UTC
+func SetTimeFormat(layout string, loc *time.Location) {
+	if named, ok := namedTimeLayouts[layout]; ok {
+		layout = named
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	timeMu.Lock()
+	defer timeMu.Unlock()
+	timeLayout = layout
+	timeLocation = loc
+}
+
+// FormatTime formata a data no layout e no fuso configurados
+// Data zero (coluna não carregada, ex: listagem com ?fields=) resulta em string vazia
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	timeMu.RLock()
+	layout, loc := timeLayout, timeLocation
+	timeMu.RUnlock()
+	return t.In(loc).Format(layout)
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EntityUUID é a interface das entidades com chave primária UUID (ver BaseEntityUUID)
+type EntityUUID interface {
+	GetID() uuid.UUID
+	SetID(id uuid.UUID)
+	GetCreatedAt() string
+	GetUpdatedAt() string
+	TableName() string
+}
+
+// BaseEntityUUID contém os campos comuns das entidades com chave primária UUID
+// Alternativa a BaseEntity para entidades expostas a sistemas externos: IDs sequenciais revelam o volume
+// do negócio (quantos pedidos, clientes...) e permitem enumerar registros; o UUID é o próprio ID
+// NOTA: As entidades que embutem BaseEntityUUID devem implementar TableName() e usar o
+// repository.UUIDRepositoryImpl
+type BaseEntityUUID struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	CreatedAt time.Time      `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"index" json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+}
+
+// GetID retorna o ID (UUID) da entidade
+func (e *BaseEntityUUID) GetID() uuid.UUID {
+	return e.ID
+}
+
+// SetID define o ID (UUID) da entidade
+func (e *BaseEntityUUID) SetID(id uuid.UUID) {
+	e.ID = id
+}
+
+// BeforeCreate gera o ID quando não informado, para que fique disponível antes do INSERT
+// Entidades que definem seu próprio BeforeCreate devem chamar e.BaseEntityUUID.BeforeCreate(tx)
+func (e *BaseEntityUUID) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
+// GetCreatedAt retorna a data de criação formatada (ver SetTimeFormat)
+func (e *BaseEntityUUID) GetCreatedAt() string {
+	return FormatTime(e.CreatedAt)
+}
+
+// GetUpdatedAt retorna a data de atualização formatada (ver SetTimeFormat)
+func (e *BaseEntityUUID) GetUpdatedAt() string {
+	return FormatTime(e.UpdatedAt)
+}
+
+// LastModified retorna a data da última atualização (entity.Modifiable)
+func (e *BaseEntityUUID) LastModified() time.Time {
+	return e.UpdatedAt
+}
@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// Códigos de erro da arquitetura
+// Os clientes devem usar o campo code das respostas de erro em vez de interpretar as mensagens
+const (
+	CodeValidation          = "VALIDATION_ERROR"
+	CodeInvalidBody         = "INVALID_BODY"
+	CodeInvalidID           = "INVALID_ID"
+	CodeInvalidParameter    = "INVALID_PARAMETER"
+	CodeNotFound            = "NOT_FOUND"
+	CodeRouteNotFound       = "ROUTE_NOT_FOUND"
+	CodeMethodNotAllowed    = "METHOD_NOT_ALLOWED"
+	CodeDuplicate           = "DUPLICATE"
+	CodeHasRelations        = "HAS_RELATIONS"
+	CodeUnauthorized        = "UNAUTHORIZED"
+	CodeForbidden           = "FORBIDDEN"
+	CodeBodyTooLarge        = "BODY_TOO_LARGE"
+	CodeTooManyRequests     = "TOO_MANY_REQUESTS"
+	CodeTimeout             = "REQUEST_TIMEOUT"
+	CodeServiceUnavailable  = "SERVICE_UNAVAILABLE"
+	CodeInternal            = "INTERNAL_ERROR"
+	CodeUnsupportedVersion  = "UNSUPPORTED_API_VERSION"
+	CodeMaintenance         = "MAINTENANCE"
+	CodeAdminDisabled       = "ADMIN_DISABLED"
+	CodeIdempotencyInvalid  = "IDEMPOTENCY_KEY_INVALID"
+	CodeIdempotencyMismatch = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyPending  = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	CodeConcurrentUpdate    = "CONCURRENT_UPDATE"
+	CodeStaleUpdate         = "STALE_UPDATE"
+	CodeGone                = "GONE"
+)
+
+// CodeInfo descreve um código de erro do catálogo
+// @Description Código de erro documentado
+type CodeInfo struct {
+	Code        string `json:"code" example:"NOT_FOUND"`
+	Status      int    `json:"status" example:"404"`
+	Description string `json:"description" example:"Registro não encontrado"`
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   = map[string]CodeInfo{}
+)
+
+func init() {
+	RegisterCode(CodeValidation, http.StatusBadRequest, "Erro de validação; os campos inválidos estão em details (e seus códigos em codes)")
+	RegisterCode(CodeInvalidBody, http.StatusBadRequest, "Body da requisição malformado")
+	RegisterCode(CodeInvalidID, http.StatusBadRequest, "Parâmetro de ID inválido")
+	RegisterCode(CodeInvalidParameter, http.StatusBadRequest, "Parâmetro de query ou de rota inválido")
+	RegisterCode(CodeNotFound, http.StatusNotFound, "Registro não encontrado")
+	RegisterCode(CodeRouteNotFound, http.StatusNotFound, "Rota não encontrada")
+	RegisterCode(CodeMethodNotAllowed, http.StatusMethodNotAllowed, "Método HTTP não permitido na rota")
+	RegisterCode(CodeDuplicate, http.StatusConflict, "Registro já existe com esta chave")
+	RegisterCode(CodeHasRelations, http.StatusConflict, "Existem registros relacionados que impedem a operação")
+	RegisterCode(CodeUnauthorized, http.StatusUnauthorized, "Credenciais ausentes ou inválidas")
+	RegisterCode(CodeForbidden, http.StatusForbidden, "Operação não permitida")
+	RegisterCode(CodeBodyTooLarge, http.StatusRequestEntityTooLarge, "Body acima do limite permitido para a rota")
+	RegisterCode(CodeTooManyRequests, http.StatusTooManyRequests, "Limite de requisições excedido")
+	RegisterCode(CodeTimeout, http.StatusServiceUnavailable, "Tempo limite da requisição excedido")
+	RegisterCode(CodeServiceUnavailable, http.StatusServiceUnavailable, "Serviço temporariamente indisponível")
+	RegisterCode(CodeInternal, http.StatusInternalServerError, "Erro interno do servidor")
+	RegisterCode(CodeUnsupportedVersion, http.StatusBadRequest, "Versão da API não suportada")
+	RegisterCode(CodeMaintenance, http.StatusServiceUnavailable, "API em manutenção (ver Retry-After)")
+	RegisterCode(CodeAdminDisabled, http.StatusForbidden, "Área administrativa desabilitada")
+	RegisterCode(CodeIdempotencyInvalid, http.StatusBadRequest, "Idempotency-Key inválida")
+	RegisterCode(CodeIdempotencyMismatch, http.StatusUnprocessableEntity, "Idempotency-Key já utilizada com outro payload")
+	RegisterCode(CodeIdempotencyPending, http.StatusConflict, "Requisição com a mesma Idempotency-Key ainda em processamento")
+	RegisterCode(CodeConcurrentUpdate, http.StatusConflict, "Conflito com uma atualização concorrente do mesmo registro; a operação pode ser repetida")
+	RegisterCode(CodeGone, http.StatusGone, "Registro excluído (na lixeira); pode ser restaurado em POST /:id/restaurar")
+	RegisterCode(CodeStaleUpdate, http.StatusConflict, "Registro alterado após a versão informada (If-Unmodified-Since ou expected_updated_at); recarregue antes de atualizar")
+}
+
+// RegisterCode adiciona (ou substitui) um código ao catálogo de erros
+// status é o HTTP status usado quando o código é retornado por um BusinessError
+func RegisterCode(code string, status int, description string) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	catalog[code] = CodeInfo{Code: code, Status: status, Description: description}
+}
+
+// Catalog retorna os códigos de erro registrados, ordenados pelo código
+func Catalog() []CodeInfo {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	codes := make([]CodeInfo, 0, len(catalog))
+	for _, info := range catalog {
+		codes = append(codes, info)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i].Code < codes[j].Code })
+	return codes
+}
+
+// StatusForCode retorna o HTTP status do código (400 para códigos não registrados)
+func StatusForCode(code string) int {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	if info, ok := catalog[code]; ok {
+		return info.Status
+	}
+	return http.StatusBadRequest
+}
+
+// CodeForStatus retorna o código genérico de um HTTP status (erros sem código específico)
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidParameter
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeRouteNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeDuplicate
+	case http.StatusRequestEntityTooLarge:
+		return CodeBodyTooLarge
+	case http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	}
+	return CodeInternal
+}
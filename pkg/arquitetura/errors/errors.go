@@ -30,6 +30,12 @@ var (
 
 	// ErrInternalServer indica erro interno do servidor
 	ErrInternalServer = errors.New("erro interno do servidor")
+
+	// ErrMapping indica falha na conversão dos dados do request pelo mapper
+	ErrMapping = errors.New("erro na conversão dos dados")
+
+	// ErrConcurrentUpdate indica conflito com uma transação concorrente (serialização ou deadlock)
+	ErrConcurrentUpdate = errors.New("conflito com uma atualização concorrente")
 )
 
 // BusinessError representa um erro de negócio customizado
@@ -79,9 +85,44 @@ func WrapError(err error, code, message string) *BusinessError {
 	}
 }
 
+// MappingError representa a falha de um mapper ao converter um campo do request
+// (data, valor monetário, enum...). O serviço base a converte em erro de validação do campo
+type MappingError struct {
+	Field string
+	Err   error
+}
+
+// Error implementa a interface error
+func (e *MappingError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s: %v", ErrMapping, e.Field, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", ErrMapping, e.Err)
+}
+
+// Unwrap permite que errors.Is e errors.As funcionem
+func (e *MappingError) Unwrap() error {
+	return e.Err
+}
+
+// Is faz com que errors.Is(err, ErrMapping) reconheça qualquer MappingError
+func (e *MappingError) Is(target error) bool {
+	return target == ErrMapping
+}
+
+// NewMappingError cria um erro de conversão associado a um campo do request
+func NewMappingError(field string, err error) *MappingError {
+	return &MappingError{
+		Field: field,
+		Err:   err,
+	}
+}
+
 // ValidationErrors representa uma coleção de erros de validação
+// Codes associa os campos a códigos do catálogo (ex: PRODUTO_CODIGO_DUPLICADO), quando informados
 type ValidationErrors struct {
 	Errors map[string]string
+	Codes  map[string]string
 }
 
 // Error implementa a interface error
@@ -97,6 +138,32 @@ func (v *ValidationErrors) Add(field, message string) {
 	v.Errors[field] = message
 }
 
+// AddWithCode adiciona um erro de validação com o código do catálogo
+func (v *ValidationErrors) AddWithCode(field, code, message string) {
+	v.Add(field, message)
+	if v.Codes == nil {
+		v.Codes = make(map[string]string)
+	}
+	v.Codes[field] = code
+}
+
+// Code retorna o código do erro: o código do campo quando todos os erros compartilham
+// o mesmo código, senão VALIDATION_ERROR
+func (v *ValidationErrors) Code() string {
+	code := ""
+	for field := range v.Errors {
+		fieldCode, ok := v.Codes[field]
+		if !ok || (code != "" && fieldCode != code) {
+			return CodeValidation
+		}
+		code = fieldCode
+	}
+	if code == "" {
+		return CodeValidation
+	}
+	return code
+}
+
 // HasErrors retorna true se há erros
 func (v *ValidationErrors) HasErrors() bool {
 	return len(v.Errors) > 0
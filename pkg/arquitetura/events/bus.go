@@ -0,0 +1,192 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// handler assinatura registrada no barramento
+type handler struct {
+	id uint64
+	fn func(ctx context.Context, event Event)
+}
+
+// Bus barramento de eventos em processo: entrega cada evento publicado aos assinantes do seu tipo
+// (Subscribe) e aos de todos os tipos (SubscribeAll), na ordem das assinaturas
+// A entrega é síncrona, na goroutine de quem publica: assinantes lentos devem repassar o trabalho
+// (ex: fila ou goroutine). Um panic em um assinante é registrado no log e não interrompe os demais
+type Bus struct {
+	mutex    sync.RWMutex
+	handlers map[reflect.Type][]handler
+	all      []handler
+	nextID   uint64
+	log      logging.Logger
+	closed   bool           // Encerrado: novos eventos são descartados
+	inflight sync.WaitGroup // Entregas em andamento, aguardadas por Close
+}
+
+// NewBus cria um barramento sem assinantes
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[reflect.Type][]handler)}
+}
+
+// defaultBus barramento utilizado pelos serviços base
+var defaultBus = NewBus()
+
+// Default retorna o barramento padrão da aplicação
+func Default() *Bus {
+	return defaultBus
+}
+
+// WithLogger configura o logger dos panics dos assinantes (retorna o próprio barramento para chaining)
+func (b *Bus) WithLogger(log logging.Logger) *Bus {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.log = log
+	return b
+}
+
+// Subscribe assina os eventos do tipo T (ex: events.EntityUpdated[*models.Produto])
+// Retorna a função que cancela a assinatura
+func Subscribe[T Event](b *Bus, fn func(ctx context.Context, event T)) func() {
+	eventType := reflect.TypeOf((*T)(nil)).Elem()
+	return b.subscribe(eventType, func(ctx context.Context, event Event) {
+		fn(ctx, event.(T))
+	})
+}
+
+// SubscribeAll assina os eventos de todas as entidades (ex: webhooks, log de integração)
+// Retorna a função que cancela a assinatura
+func (b *Bus) SubscribeAll(fn func(ctx context.Context, event Event)) func() {
+	return b.subscribe(nil, fn)
+}
+
+// HasSubscribers indica se há alguma assinatura (os serviços não montam eventos sem assinantes)
+func (b *Bus) HasSubscribers() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return len(b.all) > 0 || len(b.handlers) > 0
+}
+
+// Publish entrega o evento aos assinantes do seu tipo e, em seguida, aos de todos os tipos
+// Após Close o evento é descartado (registrado no log)
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if event == nil {
+		return
+	}
+
+	b.mutex.RLock()
+	if b.closed {
+		log := b.log
+		b.mutex.RUnlock()
+		if log != nil {
+			meta := event.Meta()
+			log.WithFields(logging.Fields{
+				"event":  meta.Type,
+				"entity": meta.EntityName,
+				"id":     meta.ID,
+			}).Warn("Evento descartado: barramento encerrado")
+		}
+		return
+	}
+	b.inflight.Add(1)
+	defer b.inflight.Done()
+
+	typed := b.handlers[reflect.TypeOf(event)]
+	targets := make([]handler, 0, len(typed)+len(b.all))
+	targets = append(targets, typed...)
+	targets = append(targets, b.all...)
+	log := b.log
+	b.mutex.RUnlock()
+
+	for _, h := range targets {
+		deliver(ctx, h, event, log)
+	}
+}
+
+// Close encerra o barramento: novos eventos passam a ser descartados e as entregas em andamento são
+// aguardadas dentro do prazo do contexto (registrado em shutdown.Default() pela aplicação)
+func (b *Bus) Close(ctx context.Context) error {
+	b.mutex.Lock()
+	b.closed = true
+	b.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// subscribe registra o assinante do tipo informado (nil = todos os tipos)
+func (b *Bus) subscribe(eventType reflect.Type, fn func(ctx context.Context, event Event)) func() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.nextID++
+	h := handler{id: b.nextID, fn: fn}
+	if eventType == nil {
+		b.all = append(b.all, h)
+	} else {
+		b.handlers[eventType] = append(b.handlers[eventType], h)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { b.unsubscribe(eventType, h.id) })
+	}
+}
+
+// unsubscribe remove a assinatura pelo ID
+func (b *Bus) unsubscribe(eventType reflect.Type, id uint64) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if eventType == nil {
+		b.all = removeHandler(b.all, id)
+		return
+	}
+	if remaining := removeHandler(b.handlers[eventType], id); len(remaining) > 0 {
+		b.handlers[eventType] = remaining
+	} else {
+		delete(b.handlers, eventType)
+	}
+}
+
+// removeHandler retorna uma nova lista sem a assinatura (as listas em entrega não são alteradas)
+func removeHandler(handlers []handler, id uint64) []handler {
+	remaining := make([]handler, 0, len(handlers))
+	for _, h := range handlers {
+		if h.id != id {
+			remaining = append(remaining, h)
+		}
+	}
+	return remaining
+}
+
+// deliver entrega o evento ao assinante, registrando o panic no log
+func deliver(ctx context.Context, h handler, event Event, log logging.Logger) {
+	defer func() {
+		if recovered := recover(); recovered != nil && log != nil {
+			meta := event.Meta()
+			log.WithFields(logging.Fields{
+				"event":  meta.Type,
+				"entity": meta.EntityName,
+				"id":     meta.ID,
+				"panic":  fmt.Sprint(recovered),
+			}).Error("Panic em assinante de evento")
+		}
+	}()
+	h.fn(ctx, event)
+}
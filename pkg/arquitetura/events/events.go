@@ -0,0 +1,62 @@
+// Package events contém os eventos de entidade emitidos pelos serviços base e o barramento em processo
+// que os entrega aos assinantes (ex: invalidação de cache, webhooks, integrações)
+package events
+
+import (
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+
+	"github.com/google/uuid"
+)
+
+// Type tipo do evento de entidade
+type Type string
+
+const (
+	TypeCreated Type = "created"
+	TypeUpdated Type = "updated"
+	TypeDeleted Type = "deleted"
+)
+
+// Metadata dados comuns a todos os eventos de entidade
+type Metadata struct {
+	Type       Type
+	EntityName string    // Nome da entidade (ServiceConfig.EntityName)
+	Table      string    // Tabela da entidade
+	ID         uint      // ID da entidade
+	PublicID   uuid.UUID // Identificador público da entidade
+	Actor      string    // Usuário da requisição (audit.Info), vazio fora de requisições
+	RequestID  string    // ID da requisição (X-Request-ID)
+	OccurredAt time.Time
+}
+
+// Meta retorna os metadados do evento
+func (m Metadata) Meta() Metadata {
+	return m
+}
+
+// Event é implementado por todos os eventos de entidade (EntityCreated, EntityUpdated e EntityDeleted)
+// Permite assinar os eventos de todas as entidades com Bus.SubscribeAll
+type Event interface {
+	Meta() Metadata
+}
+
+// EntityCreated emitido após o commit da criação da entidade
+type EntityCreated[E entity.Entity] struct {
+	Metadata
+	Entity E
+}
+
+// EntityUpdated emitido após o commit da atualização, com o estado anterior e o gravado
+type EntityUpdated[E entity.Entity] struct {
+	Metadata
+	Before E
+	After  E
+}
+
+// EntityDeleted emitido após o commit da exclusão, com o estado da entidade antes dela
+type EntityDeleted[E entity.Entity] struct {
+	Metadata
+	Entity E
+}
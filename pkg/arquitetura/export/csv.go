@@ -0,0 +1,110 @@
+// Package export contém a exportação de registros em CSV, escrita linha a linha (sem carregar tudo em memória)
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// MIMETextCSV tipo de conteúdo das exportações em CSV
+const MIMETextCSV = "text/csv; charset=utf-8"
+
+// RowMapper converte os registros exportados em linhas do CSV
+// T é o item entregue pelo serviço na exportação (ex: dto.ProdutoResponse); Row deve retornar
+// os valores na ordem das colunas de Header (use FormatValue para números e datas)
+type RowMapper[T any] interface {
+	Header() []string
+	Row(item *T) []string
+}
+
+// CSVWriter escreve os registros em CSV a partir de um RowMapper
+// O cabeçalho (com o BOM UTF-8, para o Excel reconhecer a acentuação) é escrito na criação
+type CSVWriter[T any] struct {
+	writer *csv.Writer
+	mapper RowMapper[T]
+	rows   int
+}
+
+// NewCSVWriter cria o escritor e escreve o cabeçalho
+func NewCSVWriter[T any](w io.Writer, mapper RowMapper[T]) (*CSVWriter[T], error) {
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return nil, err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(mapper.Header()); err != nil {
+		return nil, err
+	}
+	return &CSVWriter[T]{writer: writer, mapper: mapper}, nil
+}
+
+// Write escreve a linha do registro (bufferizada; ver Flush), com as células neutralizadas (SafeCell)
+func (w *CSVWriter[T]) Write(item *T) error {
+	row := w.mapper.Row(item)
+	for i, cell := range row {
+		row[i] = SafeCell(cell)
+	}
+	if err := w.writer.Write(row); err != nil {
+		return err
+	}
+	w.rows++
+	return nil
+}
+
+// Flush descarrega as linhas bufferizadas no destino
+func (w *CSVWriter[T]) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Rows retorna a quantidade de linhas de registros escritas (sem o cabeçalho)
+func (w *CSVWriter[T]) Rows() int {
+	return w.rows
+}
+
+// FormatValue converte o valor de uma célula para texto
+// Números sem notação científica, datas no formato das respostas (entity.FormatTime) e nil vazio
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case time.Time:
+		return entity.FormatTime(v)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return entity.FormatTime(*v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// SafeCell neutraliza a célula que o Excel interpretaria como fórmula (injeção de fórmulas em CSV):
+// textos iniciados por =, +, -, @, tabulação ou CR recebem o prefixo '. Números (ex: -5, +1.5) são mantidos
+func SafeCell(value string) string {
+	if value == "" {
+		return value
+	}
+	switch value[0] {
+	case '=', '@', '\t', '\r':
+	case '+', '-':
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return value
+		}
+	default:
+		return value
+	}
+	return "'" + value
+}
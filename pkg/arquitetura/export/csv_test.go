@@ -0,0 +1,77 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+type exportItem struct {
+	Nome  string
+	Saldo float64
+}
+
+type exportItemMapper struct{}
+
+func (exportItemMapper) Header() []string {
+	return []string{"nome", "saldo"}
+}
+
+func (exportItemMapper) Row(item *exportItem) []string {
+	return []string{item.Nome, FormatValue(item.Saldo)}
+}
+
+func TestSafeCell(t *testing.T) {
+	cases := map[string]string{
+		"":                           "",
+		"Teclado":                    "Teclado",
+		"=HYPERLINK(\"http://x\")":   "'=HYPERLINK(\"http://x\")",
+		"@SUM(A1:A2)":                "'@SUM(A1:A2)",
+		"+1+cmd|' /C calc'!A0":       "'+1+cmd|' /C calc'!A0",
+		"-2+3":                       "'-2+3",
+		"\t=1":                       "'\t=1",
+		"\r=1":                       "'\r=1",
+		"-5":                         "-5",
+		"+1.5":                       "+1.5",
+		"a=b":                        "a=b",
+	}
+	for input, expected := range cases {
+		if got := SafeCell(input); got != expected {
+			t.Errorf("SafeCell(%q) = %q, esperado %q", input, got, expected)
+		}
+	}
+}
+
+func TestCSVWriterNeutralizesFormulas(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewCSVWriter[exportItem](&buf, exportItemMapper{})
+	if err != nil {
+		t.Fatalf("NewCSVWriter: %v", err)
+	}
+	for _, item := range []exportItem{
+		{Nome: "=HYPERLINK(\"http://x\",\"clique\")", Saldo: -10.5},
+		{Nome: "Mouse", Saldo: 3},
+	} {
+		if err := writer.Write(&item); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(buf.String(), "\uFEFF"))).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV inválido: %v", err)
+	}
+	if len(records) != 3 || writer.Rows() != 2 {
+		t.Fatalf("linhas = %d (Rows = %d), esperado cabeçalho e dois registros", len(records), writer.Rows())
+	}
+	if records[1][0] != "'=HYPERLINK(\"http://x\",\"clique\")" || records[1][1] != "-10.5" {
+		t.Errorf("primeira linha = %q", records[1])
+	}
+	if records[2][0] != "Mouse" || records[2][1] != "3" {
+		t.Errorf("segunda linha = %q", records[2])
+	}
+}
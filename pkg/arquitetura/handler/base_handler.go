@@ -2,37 +2,91 @@ package handler
 
 import (
 	"context"
-	"errors"
+	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"api_fibergorm/pkg/arquitetura/audit"
 	"api_fibergorm/pkg/arquitetura/dto"
 	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/export"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/repository"
 	"api_fibergorm/pkg/arquitetura/service"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/google/uuid"
 )
 
 // BaseService define a interface que os serviços devem implementar para o handler base
 type BaseService[CreateReq any, UpdateReq any, Resp any] interface {
 	Create(ctx context.Context, req *CreateReq) (*Resp, error)
+	ValidateCreate(ctx context.Context, req *CreateReq) error
+	ValidateUpdate(ctx context.Context, id uint, req *UpdateReq) error
 	GetByID(ctx context.Context, id uint) (*Resp, error)
-	GetAll(ctx context.Context, page, pageSize int) (*dto.PaginatedResponse[Resp], error)
+	GetByPublicID(ctx context.Context, publicID uuid.UUID) (*Resp, error)
+	GetAll(ctx context.Context, opts repository.ListOptions) (*dto.PaginatedResponse[Resp], error)
+	Count(ctx context.Context, opts repository.ListOptions) (*dto.CountResponse, error)
+	Search(ctx context.Context, query string, opts repository.ListOptions) (*dto.PaginatedResponse[dto.SearchHit[Resp]], error)
+	Searchable() bool
+	GetAllAfterCursor(ctx context.Context, cursor *repository.Cursor, pageSize int) (*dto.CursorPaginatedResponse[Resp], error)
+	StreamAll(ctx context.Context, batchSize int, fn func(resp *Resp) error) error
 	Update(ctx context.Context, id uint, req *UpdateReq) (*Resp, error)
+	Patch(ctx context.Context, id uint, fieldMask []string, req *UpdateReq) (*Resp, error)
 	Delete(ctx context.Context, id uint) error
+	GetDeleted(ctx context.Context, page, pageSize int, countMode repository.CountMode, dateRange repository.DateRange) (*dto.PaginatedResponse[Resp], error)
+	Restore(ctx context.Context, id uint) (*Resp, error)
+	Duplicate(ctx context.Context, id uint, overrides map[string]interface{}) (*Resp, error)
+	DeletePermanently(ctx context.Context, id uint) error
+	GetHistory(ctx context.Context, id uint, filter audit.Filter, page, pageSize int) (*dto.PaginatedResponse[audit.EntryResponse], error)
+	DiffVersions(ctx context.Context, id uint, from, to audit.VersionRef) (*audit.DiffResponse, error)
+	BulkUpsert(ctx context.Context, key string, reqs []CreateReq) (*dto.BulkResponse, error)
+	Import(ctx context.Context, rows []dto.ImportRow[CreateReq]) (*dto.ImportResponse, error)
 }
 
 // HandlerConfig contém configurações do handler
 type HandlerConfig struct {
 	EntityName     string // Nome da entidade para mensagens
-	SuccessMessage string // Mensagem de sucesso para delete
+	SuccessMessage string // Mensagem de sucesso para delete (vazio = mensagem traduzida do catálogo)
+
+	// Deprecation marca todas as rotas do handler como descontinuadas (nil = não descontinuadas)
+	Deprecation *Deprecation
+	// DeprecatedRoutes marca rotas específicas como descontinuadas
+	// A chave é o método e o path relativo ao grupo (ex: "GET /:id", "GET /ativas")
+	DeprecatedRoutes map[string]*Deprecation
+
+	// PermanentDeleteGuard protege a exclusão definitiva (DELETE /:id/definitivo)
+	// Quando nil, a rota não é registrada
+	PermanentDeleteGuard fiber.Handler
+
+	// Transaction executa as rotas de escrita em uma transação por requisição (nil = sem transação)
+	// Respostas não-2xx desfazem todas as escritas da requisição
+	Transaction fiber.Handler
+
+	// FilterableFields campos aceitos como filtro na listagem (ex: ?preco_gte=10); vazio = sem filtros
+	FilterableFields []string
+	// SortableFields campos aceitos na ordenação da listagem (ex: ?sort=preco,-created_at); vazio = ordenação padrão
+	SortableFields []string
+	// SelectableFields campos aceitos na seleção parcial da listagem (ex: ?fields=id,codigo,preco); devem ser
+	// colunas da entidade com o mesmo nome JSON na resposta. Vazio = seleção parcial desabilitada
+	SelectableFields []string
+}
+
+// Deprecation descreve a descontinuação de uma rota
+// As respostas passam a carregar os cabeçalhos Deprecation (RFC 9745), Sunset (RFC 8594) e Link
+type Deprecation struct {
+	Date      time.Time // Data a partir da qual a rota é considerada descontinuada
+	Sunset    time.Time // Data prevista para remoção da rota (opcional)
+	Link      string    // URL da documentação sobre a descontinuação (opcional)
+	Successor string    // URL da rota substituta (opcional)
 }
 
 // DefaultHandlerConfig retorna configuração padrão
 func DefaultHandlerConfig(entityName string) *HandlerConfig {
 	return &HandlerConfig{
-		EntityName:     entityName,
-		SuccessMessage: entityName + " excluído(a) com sucesso",
+		EntityName: entityName,
 	}
 }
 
@@ -40,51 +94,66 @@ func DefaultHandlerConfig(entityName string) *HandlerConfig {
 type BaseHandlerImpl[CreateReq any, UpdateReq any, Resp any] struct {
 	Service         BaseService[CreateReq, UpdateReq, Resp]
 	StructValidator *service.StructValidator
-	Log             *logrus.Logger
+	Log             logging.Logger
 	Config          *HandlerConfig
+
+	csvFileName string                 // Prefixo do arquivo da exportação em CSV (WithCSVExport)
+	csvMapper   export.RowMapper[Resp] // Linhas da exportação em CSV; nil = apenas JSON
 }
 
 // NewBaseHandler cria uma nova instância do handler base
 func NewBaseHandler[CreateReq any, UpdateReq any, Resp any](
 	svc BaseService[CreateReq, UpdateReq, Resp],
-	log *logrus.Logger,
+	log logging.Logger,
 	config *HandlerConfig,
 ) *BaseHandlerImpl[CreateReq, UpdateReq, Resp] {
 	return &BaseHandlerImpl[CreateReq, UpdateReq, Resp]{
 		Service:         svc,
-		StructValidator: service.NewStructValidator(),
+		StructValidator: service.DefaultStructValidator(),
 		Log:             log,
 		Config:          config,
 	}
 }
 
 // Create cria uma nova entidade
+// Responde 201 com o registro e o cabeçalho Location; com Prefer: return=minimal, 204 sem body (ver SendCreated)
+// Com ?dry_run=true apenas valida o body, sem gravar (ver ValidateCreate)
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Create(c *fiber.Ctx) error {
+	dryRun, err := ParseDryRun(c)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return h.ValidateCreate(c)
+	}
+
 	var req CreateReq
 
 	if err := c.BodyParser(&req); err != nil {
 		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error: "Erro ao processar requisição",
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
 		})
 	}
 
 	// Validação dos campos com validator (tags)
-	if validationErrors := h.StructValidator.Validate(req); len(validationErrors) > 0 {
+	if validationErrors := h.StructValidator.ValidateContext(c.UserContext(), req); len(validationErrors) > 0 {
 		h.Log.WithField("errors", validationErrors).Warn("Erro de validação na criação")
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "Erro de validação",
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:    arqerrors.CodeValidation,
+			Error:   Message(c, i18n.MsgValidation, nil),
 			Details: validationErrors,
 		})
 	}
 
-	ctx := context.Background()
+	ctx := c.UserContext()
 	result, err := h.Service.Create(ctx, &req)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(result)
+	return SendCreated(c, c.Path(), result)
 }
 
 // GetByID busca uma entidade pelo ID
@@ -94,31 +163,120 @@ func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) GetByID(c *fiber.Ctx) erro
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := c.UserContext()
 	result, err := h.Service.GetByID(ctx, id)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(result)
+	if h.NotModified(c, result) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	return SendResponse(c, result)
+}
+
+// GetByPublicID busca uma entidade pelo identificador público (UUID)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) GetByPublicID(c *fiber.Ctx) error {
+	publicID, err := uuid.Parse(c.Params("uuid"))
+	if err != nil {
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:    arqerrors.CodeInvalidID,
+			Error:   Message(c, i18n.MsgInvalidID, nil),
+			Details: map[string]string{"uuid": Message(c, i18n.MsgInvalidUUIDParam, i18n.Params{"param": "uuid"})},
+		})
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.GetByPublicID(ctx, publicID)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	if h.NotModified(c, result) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	return SendResponse(c, result)
 }
 
 // GetAll retorna todas as entidades com paginação
+// As opções da listagem vêm da query string (ver ParseListOptions): filtros nos campos de
+// Config.FilterableFields, ordenação nos de Config.SortableFields e ?fields= nos de Config.SelectableFields
+// Com ?cursor= (vazio na primeira página) usa a paginação por cursor em vez de page/count
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) GetAll(c *fiber.Ctx) error {
-	page, _ := strconv.Atoi(c.Query("page", "1"))
+	if c.Context().QueryArgs().Has("cursor") {
+		return h.getAllByCursor(c)
+	}
+
+	opts, err := h.ParseListOptions(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.GetAll(ctx, opts)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	if len(opts.Fields) > 0 {
+		return SendPaginated(c, SelectFields(result, opts.Fields))
+	}
+	return SendPaginated(c, result)
+}
+
+// Count retorna o total de registros da listagem, com os mesmos filtros de GetAll (filtros por campo,
+// período e include_deleted); também informado no cabeçalho X-Total-Count
+// Com ?count=estimated e sem filtros, retorna a estimativa da tabela (estimated: true)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Count(c *fiber.Ctx) error {
+	opts, err := h.ParseListOptions(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.Count(ctx, opts)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	c.Set(HeaderTotalCount, strconv.FormatInt(result.Total, 10))
+	return SendResponse(c, result)
+}
+
+// getAllByCursor retorna a página seguinte ao cursor informado em ?cursor=
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) getAllByCursor(c *fiber.Ctx) error {
+	cursor, err := repository.DecodeCursor(c.Query("cursor"))
+	if err != nil {
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidParameter,
+			Error: Message(c, i18n.MsgInvalidCursor, nil),
+		})
+	}
 	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
 
-	ctx := context.Background()
-	result, err := h.Service.GetAll(ctx, page, pageSize)
+	ctx := c.UserContext()
+	result, err := h.Service.GetAllAfterCursor(ctx, cursor, pageSize)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(result)
+	return SendCursorPaginated(c, result)
 }
 
 // Update atualiza uma entidade existente
+// Aceita a pré-condição ?expected_updated_at= ou If-Unmodified-Since (ver PreconditionContext)
+// Com ?dry_run=true apenas valida o body, sem gravar (ver ValidateUpdate)
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Update(c *fiber.Ctx) error {
+	dryRun, err := ParseDryRun(c)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return h.ValidateUpdate(c)
+	}
+
 	id, err := h.ParseID(c, "id")
 	if err != nil {
 		return err
@@ -127,27 +285,32 @@ func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Update(c *fiber.Ctx) error
 	var req UpdateReq
 	if err := c.BodyParser(&req); err != nil {
 		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error: "Erro ao processar requisição",
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
 		})
 	}
 
 	// Validação dos campos com validator (tags)
-	if validationErrors := h.StructValidator.Validate(req); len(validationErrors) > 0 {
+	if validationErrors := h.StructValidator.ValidateContext(c.UserContext(), req); len(validationErrors) > 0 {
 		h.Log.WithField("errors", validationErrors).Warn("Erro de validação na atualização")
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "Erro de validação",
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:    arqerrors.CodeValidation,
+			Error:   Message(c, i18n.MsgValidation, nil),
 			Details: validationErrors,
 		})
 	}
 
-	ctx := context.Background()
+	ctx, err := PreconditionContext(c)
+	if err != nil {
+		return err
+	}
 	result, err := h.Service.Update(ctx, id, &req)
 	if err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(result)
+	return SendResponse(c, result)
 }
 
 // Delete remove uma entidade pelo ID
@@ -157,77 +320,166 @@ func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Delete(c *fiber.Ctx) error
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := c.UserContext()
 	if err := h.Service.Delete(ctx, id); err != nil {
 		return h.HandleError(c, err)
 	}
 
-	return c.JSON(dto.SuccessResponse{
-		Message: h.Config.SuccessMessage,
+	message := h.Config.SuccessMessage
+	if message == "" {
+		message = h.entityMessage(c, i18n.MsgDeleted)
+	}
+	return SendResponse(c, dto.SuccessResponse{
+		Message: message,
 	})
 }
 
+// ParseCountMode extrai o modo de contagem do parâmetro ?count=none|exact|estimated
+// (exportado para uso em handlers filhos)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ParseCountMode(c *fiber.Ctx) (repository.CountMode, error) {
+	countMode, ok := repository.ParseCountMode(c.Query("count"))
+	if !ok {
+		return "", fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "count", "values": "none, exact, estimated"}))
+	}
+	return countMode, nil
+}
+
+// NotModified emite o cabeçalho Last-Modified quando o response implementa dto.LastModifiedResponse
+// e retorna true se o cliente já possui a versão atual (If-Modified-Since)
+// Exportado para uso em handlers filhos
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) NotModified(c *fiber.Ctx, result interface{}) bool {
+	lm, ok := result.(dto.LastModifiedResponse)
+	if !ok {
+		return false
+	}
+
+	// HTTP-date tem precisão de segundos
+	modified := lm.LastModified().UTC().Truncate(time.Second)
+	if modified.IsZero() {
+		return false
+	}
+	c.Set(fiber.HeaderLastModified, modified.Format(http.TimeFormat))
+
+	since := c.Get(fiber.HeaderIfModifiedSince)
+	if since == "" {
+		return false
+	}
+	sinceTime, err := http.ParseTime(since)
+	if err != nil {
+		return false
+	}
+	return !modified.After(sinceTime)
+}
+
 // ParseID extrai e valida um ID da URL (exportado para uso em handlers filhos)
+// Reaproveita o valor validado por ValidateIDParams quando disponível; IDs inválidos
+// retornam um *fiber.Error 400, tratado pelo ErrorHandler global no formato padrão
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ParseID(c *fiber.Ctx, param string) (uint, error) {
-	id, err := strconv.ParseUint(c.Params(param), 10, 32)
-	if err != nil {
-		h.Log.WithError(err).Warn("ID inválido")
-		return 0, c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error: "ID inválido",
-		})
+	if id, ok := IDParam(c, param); ok {
+		return id, nil
 	}
-	return uint(id), nil
+
+	id, ok := parseID(c.Params(param))
+	if !ok {
+		h.Log.WithField(param, c.Params(param)).Warn("ID inválido")
+		return 0, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidID, nil))
+	}
+	return id, nil
+}
+
+// ParseUUIDParam extrai e valida um ID UUID da URL (entidades com entity.BaseEntityUUID, exportado para uso
+// em handlers filhos). UUIDs inválidos retornam um *fiber.Error 400, tratado pelo ErrorHandler global
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ParseUUIDParam(c *fiber.Ctx, param string) (uuid.UUID, error) {
+	id, err := uuid.Parse(c.Params(param))
+	if err != nil || id == uuid.Nil {
+		h.Log.WithField(param, c.Params(param)).Warn("UUID inválido")
+		return uuid.Nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidUUIDParam, i18n.Params{"param": param}))
+	}
+	return id, nil
 }
 
 // HandleError trata os erros retornados pelo serviço (exportado para uso em handlers filhos)
+// A resposta segue ErrorResponseFor, o mesmo formato do ErrorHandler global
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) HandleError(c *fiber.Ctx, err error) error {
-	// Erros de validação
-	var validationErrors *arqerrors.ValidationErrors
-	if errors.As(err, &validationErrors) {
-		return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-			Error:   "Erro de validação",
-			Details: validationErrors.Errors,
-		})
+	status, resp := ErrorResponseFor(c, err)
+	switch {
+	case resp.Code == arqerrors.CodeTimeout:
+		h.Log.WithError(err).Warn("Tempo limite da requisição excedido")
+	case status >= fiber.StatusInternalServerError:
+		h.Log.WithError(err).Error("Erro interno do servidor")
 	}
+	return SendError(c, status, resp)
+}
 
-	// Erros de negócio
-	if businessErr, ok := arqerrors.GetBusinessError(err); ok {
-		switch businessErr.Code {
-		case "NOT_FOUND":
-			return c.Status(fiber.StatusNotFound).JSON(dto.ErrorResponse{
-				Error: businessErr.Message,
-			})
-		case "DUPLICATE":
-			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error: businessErr.Message,
-			})
-		case "FORBIDDEN":
-			return c.Status(fiber.StatusForbidden).JSON(dto.ErrorResponse{
-				Error: businessErr.Message,
-			})
-		case "HAS_RELATIONS":
-			return c.Status(fiber.StatusConflict).JSON(dto.ErrorResponse{
-				Error: businessErr.Message,
-			})
-		default:
-			return c.Status(fiber.StatusBadRequest).JSON(dto.ErrorResponse{
-				Error: businessErr.Message,
-			})
-		}
-	}
-
-	// Erro genérico
-	h.Log.WithError(err).Error("Erro interno do servidor")
-	return c.Status(fiber.StatusInternalServerError).JSON(dto.ErrorResponse{
-		Error: "Erro interno do servidor",
-	})
+// WithDeprecation envolve o handler adicionando os cabeçalhos de descontinuação quando
+// a rota (ex: "GET /:id") ou o handler inteiro estiverem marcados como descontinuados
+// Exportado para que handlers filhos apliquem a mesma sinalização em suas rotas específicas
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) WithDeprecation(route string, handler fiber.Handler) fiber.Handler {
+	deprecation := h.Config.Deprecation
+	if d, ok := h.Config.DeprecatedRoutes[route]; ok {
+		deprecation = d
+	}
+	if deprecation == nil {
+		return handler
+	}
+
+	return func(c *fiber.Ctx) error {
+		deprecation.apply(c)
+		return handler(c)
+	}
+}
+
+// apply escreve os cabeçalhos de descontinuação na resposta
+func (d *Deprecation) apply(c *fiber.Ctx) {
+	date := d.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	c.Set("Deprecation", "@"+strconv.FormatInt(date.Unix(), 10))
+
+	if !d.Sunset.IsZero() {
+		c.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+
+	var links []string
+	if d.Link != "" {
+		links = append(links, "<"+d.Link+">; rel=\"deprecation\"")
+	}
+	if d.Successor != "" {
+		links = append(links, "<"+d.Successor+">; rel=\"successor-version\"")
+	}
+	if len(links) > 0 {
+		c.Append(fiber.HeaderLink, strings.Join(links, ", "))
+	}
 }
 
 // RegisterRoutes registra as rotas CRUD padrão
 func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) RegisterRoutes(router fiber.Router) {
-	router.Post("/", h.Create)
-	router.Get("/", h.GetAll)
-	router.Get("/:id", h.GetByID)
-	router.Put("/:id", h.Update)
-	router.Delete("/:id", h.Delete)
+	tx := h.transactional
+	router.Post("/", tx(h.WithDeprecation("POST /", h.Create))...)
+	router.Get("/", h.WithDeprecation("GET /", h.GetAll))
+	router.Get("/count", h.WithDeprecation("GET /count", h.Count))
+	router.Get("/export", h.WithDeprecation("GET /export", h.Export))
+	if h.Service.Searchable() {
+		router.Get("/search", h.WithDeprecation("GET /search", h.Search))
+	}
+	router.Put("/bulk", tx(h.WithDeprecation("PUT /bulk", h.BulkUpsert))...)
+	router.Post("/import", h.WithDeprecation("POST /import", h.Import))
+	router.Post("/validate", h.WithDeprecation("POST /validate", h.ValidateCreate))
+	router.Get("/lixeira", h.WithDeprecation("GET /lixeira", h.GetDeleted))
+	router.Get("/by-uuid/:uuid", h.WithDeprecation("GET /by-uuid/:uuid", h.GetByPublicID))
+	router.Get("/:id", ValidateIDParams("id"), h.WithDeprecation("GET /:id", h.GetByID))
+	router.Put("/:id", tx(ValidateIDParams("id"), h.WithDeprecation("PUT /:id", h.Update))...)
+	router.Patch("/:id", tx(ValidateIDParams("id"), h.WithDeprecation("PATCH /:id", h.Patch))...)
+	router.Delete("/:id", tx(ValidateIDParams("id"), h.WithDeprecation("DELETE /:id", h.Delete))...)
+	router.Post("/:id/validate", ValidateIDParams("id"), h.WithDeprecation("POST /:id/validate", h.ValidateUpdate))
+	router.Get("/:id/historico", ValidateIDParams("id"), h.WithDeprecation("GET /:id/historico", h.GetHistory))
+	router.Get("/:id/historico/diff", ValidateIDParams("id"), h.WithDeprecation("GET /:id/historico/diff", h.DiffVersions))
+	router.Post("/:id/duplicar", tx(ValidateIDParams("id"), h.WithDeprecation("POST /:id/duplicar", h.Duplicate))...)
+	router.Post("/:id/restaurar", tx(ValidateIDParams("id"), h.WithDeprecation("POST /:id/restaurar", h.Restore))...)
+	router.Post("/:id/restore", tx(ValidateIDParams("id"), h.WithDeprecation("POST /:id/restore", h.Restore))...) // Alias de /:id/restaurar
+	if h.Config.PermanentDeleteGuard != nil {
+		router.Delete("/:id/definitivo", tx(h.Config.PermanentDeleteGuard, ValidateIDParams("id"), h.WithDeprecation("DELETE /:id/definitivo", h.DeletePermanently))...)
+		router.Delete("/:id/permanent", tx(h.Config.PermanentDeleteGuard, ValidateIDParams("id"), h.WithDeprecation("DELETE /:id/permanent", h.DeletePermanently))...) // Alias de /:id/definitivo
+	}
 }
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BulkUpsert insere ou atualiza uma lista de registros pela chave natural (?key=codigo)
+// Retorna 200 com o resultado por item ou 422 quando algum item é inválido (nada é gravado)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) BulkUpsert(c *fiber.Ctx) error {
+	key := c.Query("key")
+	if key == "" {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgBulkKeyRequired, nil))
+	}
+
+	var reqs []CreateReq
+	if err := c.BodyParser(&reqs); err != nil {
+		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBodyArray, nil),
+		})
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.BulkUpsert(ctx, key, reqs)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	if result.Failed > 0 {
+		return SendResponse(c.Status(fiber.StatusUnprocessableEntity), result)
+	}
+	return SendResponse(c, result)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Cabeçalhos de preferência do cliente (RFC 7240)
+const (
+	HeaderPrefer            = "Prefer"
+	HeaderPreferenceApplied = "Preference-Applied"
+)
+
+// SendCreated responde a criação de um registro: o cabeçalho Location aponta para o registro criado
+// (collectionPath/<id>, com o ID do response) e o body traz o registro com status 201
+// Com Prefer: return=minimal o body é omitido e o status é 204, para integrações que não usam o eco do registro
+// Exportado para uso em handlers filhos com criações próprias
+func SendCreated(c *fiber.Ctx, collectionPath string, result interface{}) error {
+	if id, ok := responseID(result); ok {
+		c.Location(strings.TrimSuffix(collectionPath, "/") + "/" + strconv.FormatUint(id, 10))
+	}
+
+	switch preference(c, "return") {
+	case "minimal":
+		c.Set(HeaderPreferenceApplied, "return=minimal")
+		return c.SendStatus(fiber.StatusNoContent)
+	case "representation":
+		c.Set(HeaderPreferenceApplied, "return=representation")
+	}
+	return SendResponse(c.Status(fiber.StatusCreated), result)
+}
+
+// preference retorna o valor da preferência informada no cabeçalho Prefer (ex: return=minimal → minimal)
+// Vazio quando a preferência não foi informada
+func preference(c *fiber.Ctx, name string) string {
+	for _, header := range c.GetReqHeaders()[HeaderPrefer] {
+		for _, item := range strings.Split(header, ",") {
+			// Parâmetros da preferência (ex: ; foo=bar) são ignorados
+			token, _, _ := strings.Cut(item, ";")
+			key, value, _ := strings.Cut(strings.TrimSpace(token), "=")
+			if strings.EqualFold(strings.TrimSpace(key), name) {
+				return strings.ToLower(strings.Trim(strings.TrimSpace(value), `"`))
+			}
+		}
+	}
+	return ""
+}
+
+// responseID retorna o campo ID (inteiro sem sinal) do response, usado no Location
+func responseID(result interface{}) (uint64, bool) {
+	v := reflect.ValueOf(result)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+
+	field := v.FieldByName("ID")
+	if !field.IsValid() || !isUintKind(field.Kind()) || field.Uint() == 0 {
+		return 0, false
+	}
+	return field.Uint(), true
+}
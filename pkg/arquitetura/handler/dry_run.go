@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ValidateCreate valida o body da criação sem gravar (POST /validate e POST /?dry_run=true)
+// Executa as mesmas validações do Create (tags do request e regras de negócio) e responde 200 com o
+// resultado: {"valid": true} ou {"valid": false, "errors": {...}, "codes": {...}}
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ValidateCreate(c *fiber.Ctx) error {
+	var req CreateReq
+	if err := c.BodyParser(&req); err != nil {
+		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
+		})
+	}
+
+	ctx := c.UserContext()
+	return h.sendValidation(c, h.Service.ValidateCreate(ctx, &req))
+}
+
+// ValidateUpdate valida o body da atualização sem gravar (POST /:id/validate e PUT/PATCH /:id?dry_run=true)
+// Responde 404 quando a entidade não existe e 200 com o resultado da validação nos demais casos
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ValidateUpdate(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req UpdateReq
+	if err := c.BodyParser(&req); err != nil {
+		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
+		})
+	}
+
+	ctx := c.UserContext()
+	return h.sendValidation(c, h.Service.ValidateUpdate(ctx, id, &req))
+}
+
+// ParseDryRun lê o parâmetro ?dry_run= (true/false, 1/0); ausente = false
+// Exportado para uso em handlers filhos
+func ParseDryRun(c *fiber.Ctx) (bool, error) {
+	value := c.Query("dry_run")
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "dry_run", "values": "true, false"}))
+	}
+	return dryRun, nil
+}
+
+// sendValidation responde o resultado da validação; erros que não são de validação seguem o HandleError
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) sendValidation(c *fiber.Ctx, err error) error {
+	if err == nil {
+		return SendResponse(c, dto.ValidationResponse{Valid: true})
+	}
+
+	var validationErrors *arqerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return SendResponse(c, dto.ValidationResponse{
+			Valid:  false,
+			Errors: validationErrors.Errors,
+			Codes:  validationErrors.Codes,
+		})
+	}
+
+	return h.HandleError(c, err)
+}
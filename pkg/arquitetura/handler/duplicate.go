@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/json"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Duplicate cria uma cópia da entidade (POST /:id/duplicar)
+// O body opcional traz os campos do request de criação que substituem os copiados
+// (ex: {"codigo": "PROD002"}); a cópia passa pelas validações da criação. Ver service.BaseServiceImpl.Duplicate
+// A resposta segue a da criação: Location aponta para a cópia e Prefer: return=minimal omite o body
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Duplicate(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var body map[string]interface{}
+	if len(c.Body()) > 0 {
+		if err := json.Unmarshal(c.Body(), &body); err != nil {
+			h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+			return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+				Code:  arqerrors.CodeInvalidBody,
+				Error: Message(c, i18n.MsgInvalidBody, nil),
+			})
+		}
+	}
+
+	// Os campos seguem os nomes JSON do request (snake_case), independente da convenção da API
+	overrides := make(map[string]interface{}, len(body))
+	for key, value := range body {
+		overrides[jsoncodec.CamelToSnake(key)] = value
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.Duplicate(ctx, id, overrides)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	collection := strings.TrimSuffix(strings.TrimSuffix(c.Path(), "/"), "/"+c.Params("id")+"/duplicar")
+	return SendCreated(c, collection, result)
+}
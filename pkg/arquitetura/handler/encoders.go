@@ -0,0 +1,301 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/export"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// MIMEApplicationMsgPack media type das respostas em MessagePack
+const MIMEApplicationMsgPack = "application/msgpack"
+
+// Encoders embutidos: XML, CSV (listagens) e MessagePack
+func init() {
+	RegisterEncoder(fiber.MIMEApplicationXML, xmlEncoder{})
+	RegisterEncoder(fiber.MIMETextXML, xmlEncoder{})
+	RegisterEncoder("text/csv", csvEncoder{})
+	RegisterEncoder(MIMEApplicationMsgPack, msgPackEncoder{})
+	RegisterEncoder("application/x-msgpack", msgPackEncoder{})
+}
+
+// xmlEncoder serializa a resposta em XML com a raiz <response>: cada campo é um elemento e os itens
+// dos arrays são elementos <item>
+type xmlEncoder struct{}
+
+func (xmlEncoder) ContentType() string { return fiber.MIMEApplicationXMLCharsetUTF8 }
+
+func (xmlEncoder) Encode(c *fiber.Ctx, value interface{}) ([]byte, error) {
+	doc, err := JSONDocument(c, value)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	encoder := xml.NewEncoder(&buf)
+	if err := encodeXML(encoder, "response", doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeXML escreve o valor como o elemento name (null resulta em elemento vazio)
+func encodeXML(encoder *xml.Encoder, name string, value interface{}) error {
+	start := xml.StartElement{Name: xml.Name{Local: xmlName(name)}}
+	if err := encoder.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case Object:
+		for _, member := range v {
+			if err := encodeXML(encoder, member.Key, member.Value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := encodeXML(encoder, "item", item); err != nil {
+				return err
+			}
+		}
+	case nil:
+	default:
+		if err := encoder.EncodeToken(xml.CharData(scalarText(v))); err != nil {
+			return err
+		}
+	}
+	return encoder.EncodeToken(start.End())
+}
+
+// xmlName converte a chave JSON em um nome de elemento válido (caracteres inválidos viram _)
+func xmlName(key string) string {
+	var b strings.Builder
+	for i, r := range key {
+		valid := r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' ||
+			i > 0 && (r == '-' || r == '.' || r >= '0' && r <= '9')
+		if !valid {
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+// csvEncoder serializa listagens em CSV: uma linha por item de data (respostas paginadas) ou do array,
+// com a união das colunas de todos os itens (na ordem em que aparecem) e os textos neutralizados contra
+// fórmulas (export.SafeCell, o mesmo da exportação); objetos aninhados viram colunas pai.filho e arrays
+// são escritos em JSON na célula. Outras respostas retornam ErrUnsupportedValue (enviadas em JSON)
+type csvEncoder struct{}
+
+func (csvEncoder) ContentType() string { return export.MIMETextCSV }
+
+func (csvEncoder) Encode(c *fiber.Ctx, value interface{}) ([]byte, error) {
+	doc, err := JSONDocument(c, value)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := doc.([]interface{})
+	if object, isObject := doc.(Object); isObject {
+		for _, member := range object {
+			if member.Key == "data" {
+				items, ok = member.Value.([]interface{})
+			}
+		}
+	}
+	if !ok {
+		return nil, ErrUnsupportedValue
+	}
+
+	// Campos ausentes ou nulos em parte dos itens (ex: omitempty, relações não carregadas) ainda viram colunas
+	header := []string{}
+	seen := map[string]bool{}
+	rows := make([]map[string]string, 0, len(items))
+	for _, item := range items {
+		row := map[string]string{}
+		var columns []string
+		if err := flattenCSV("", item, row, &columns); err != nil {
+			return nil, err
+		}
+		for _, column := range columns {
+			if !seen[column] {
+				seen[column] = true
+				header = append(header, column)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("\uFEFF")
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		record := make([]string, len(header))
+		for i, column := range header {
+			record[i] = row[column]
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// flattenCSV converte o item nas células da linha, registrando as colunas na ordem dos campos
+func flattenCSV(prefix string, value interface{}, row map[string]string, columns *[]string) error {
+	if object, ok := value.(Object); ok {
+		for _, member := range object {
+			column := member.Key
+			if prefix != "" {
+				column = prefix + "." + member.Key
+			}
+			if err := flattenCSV(column, member.Value, row, columns); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	column := prefix
+	if column == "" {
+		column = "value"
+	}
+	*columns = append(*columns, column)
+
+	if array, ok := value.([]interface{}); ok {
+		data, err := json.Marshal(plainValue(array))
+		if err != nil {
+			return err
+		}
+		row[column] = string(data)
+		return nil
+	}
+	if text, ok := value.(string); ok {
+		row[column] = export.SafeCell(text)
+		return nil
+	}
+	row[column] = scalarText(value)
+	return nil
+}
+
+// plainValue converte Object em map para a serialização dos arrays nas células do CSV
+func plainValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case Object:
+		m := make(map[string]interface{}, len(v))
+		for _, member := range v {
+			m[member.Key] = plainValue(member.Value)
+		}
+		return m
+	case []interface{}:
+		array := make([]interface{}, len(v))
+		for i, item := range v {
+			array[i] = plainValue(item)
+		}
+		return array
+	}
+	return value
+}
+
+// scalarText converte o valor escalar do documento em texto (null vazio)
+func scalarText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	}
+	return fmt.Sprint(value)
+}
+
+// msgPackEncoder serializa a resposta em MessagePack (https://msgpack.org), com os mesmos campos do JSON
+// Números inteiros são codificados na menor representação inteira e os demais como float64
+type msgPackEncoder struct{}
+
+func (msgPackEncoder) ContentType() string { return MIMEApplicationMsgPack }
+
+func (msgPackEncoder) Encode(c *fiber.Ctx, value interface{}) ([]byte, error) {
+	doc, err := JSONDocument(c, value)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	encoder := msgpack.NewEncoder(&buf)
+	encoder.UseCompactInts(true)
+	if err := encodeMsgPack(encoder, doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeMsgPack escreve o valor do documento no encoder, mantendo a ordem dos campos dos objetos
+func encodeMsgPack(encoder *msgpack.Encoder, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return encoder.EncodeNil()
+	case bool:
+		return encoder.EncodeBool(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return encoder.EncodeInt(i)
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		return encoder.EncodeFloat64(f)
+	case string:
+		return encoder.EncodeString(v)
+	case []interface{}:
+		if err := encoder.EncodeArrayLen(len(v)); err != nil {
+			return err
+		}
+		for _, item := range v {
+			if err := encodeMsgPack(encoder, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	case Object:
+		if err := encoder.EncodeMapLen(len(v)); err != nil {
+			return err
+		}
+		for _, member := range v {
+			if err := encoder.EncodeString(member.Key); err != nil {
+				return err
+			}
+			if err := encodeMsgPack(encoder, member.Value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("tipo não suportado no MessagePack: %T", value)
+}
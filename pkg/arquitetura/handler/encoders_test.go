@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type encoderCategoria struct {
+	ID   uint   `json:"id"`
+	Nome string `json:"nome"`
+}
+
+type encoderProduto struct {
+	ID        uint              `json:"id"`
+	Codigo    string            `json:"codigo"`
+	Preco     float64           `json:"preco"`
+	Ativo     bool              `json:"ativo"`
+	Descricao *string           `json:"descricao"`
+	Tags      []string          `json:"tags,omitempty"`
+	Categoria *encoderCategoria `json:"categoria,omitempty"`
+}
+
+// encode executa a resposta em uma aplicação Fiber com o Accept informado
+func encode(t *testing.T, accept string, value interface{}) (string, []byte) {
+	t.Helper()
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return SendResponse(c, value)
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+	req.Header.Set(fiber.HeaderAccept, accept)
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("falha na requisição: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("falha ao ler o body: %v", err)
+	}
+	return resp.Header.Get(fiber.HeaderContentType), body
+}
+
+func TestMsgPackEncoder(t *testing.T) {
+	descricao := "Teclado"
+	value := encoderProduto{
+		ID:        7,
+		Codigo:    "P-001",
+		Preco:     19.9,
+		Ativo:     true,
+		Descricao: &descricao,
+		Tags:      []string{"a", "b"},
+		Categoria: &encoderCategoria{ID: 300, Nome: "Periféricos"},
+	}
+
+	contentType, body := encode(t, MIMEApplicationMsgPack, value)
+	if contentType != MIMEApplicationMsgPack {
+		t.Fatalf("Content-Type = %q, esperado %q", contentType, MIMEApplicationMsgPack)
+	}
+
+	// Os campos do MessagePack seguem os nomes do JSON
+	decoder := msgpack.NewDecoder(bytes.NewReader(body))
+	decoder.SetCustomStructTag("json")
+
+	var decoded encoderProduto
+	if err := decoder.Decode(&decoded); err != nil {
+		t.Fatalf("MessagePack inválido: %v", err)
+	}
+	if decoded.ID != 7 || decoded.Codigo != "P-001" || decoded.Preco != 19.9 || !decoded.Ativo {
+		t.Errorf("campos escalares divergentes: %+v", decoded)
+	}
+	if decoded.Descricao == nil || *decoded.Descricao != descricao {
+		t.Errorf("descricao = %v, esperado %q", decoded.Descricao, descricao)
+	}
+	if len(decoded.Tags) != 2 || decoded.Tags[1] != "b" {
+		t.Errorf("tags = %v", decoded.Tags)
+	}
+	if decoded.Categoria == nil || decoded.Categoria.ID != 300 || decoded.Categoria.Nome != "Periféricos" {
+		t.Errorf("categoria = %+v", decoded.Categoria)
+	}
+}
+
+func TestMsgPackEncoderKeepsFieldOrderAndTypes(t *testing.T) {
+	_, body := encode(t, "application/x-msgpack", fiber.Map{"valor": -5, "nulo": nil})
+
+	decoder := msgpack.NewDecoder(bytes.NewReader(body))
+	n, err := decoder.DecodeMapLen()
+	if err != nil || n != 2 {
+		t.Fatalf("DecodeMapLen = %d, %v", n, err)
+	}
+
+	// fiber.Map é serializado pelo encoding/json com as chaves ordenadas
+	if key, _ := decoder.DecodeString(); key != "nulo" {
+		t.Fatalf("primeira chave = %q, esperado nulo", key)
+	}
+	if err := decoder.DecodeNil(); err != nil {
+		t.Fatalf("nulo: %v", err)
+	}
+	if key, _ := decoder.DecodeString(); key != "valor" {
+		t.Fatalf("segunda chave = %q, esperado valor", key)
+	}
+	if v, err := decoder.DecodeInt64(); err != nil || v != -5 {
+		t.Fatalf("valor = %d, %v", v, err)
+	}
+}
+
+func TestCSVEncoderHeaderUnion(t *testing.T) {
+	descricao := "Mouse"
+	items := []encoderProduto{
+		{ID: 1, Codigo: "P-001"},
+		{ID: 2, Codigo: "P-002", Descricao: &descricao, Categoria: &encoderCategoria{ID: 3, Nome: "Periféricos"}},
+	}
+
+	contentType, body := encode(t, "text/csv", fiber.Map{"data": items})
+	if !strings.HasPrefix(contentType, "text/csv") {
+		t.Fatalf("Content-Type = %q, esperado text/csv", contentType)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(body), "\uFEFF"))).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV inválido: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("linhas = %d, esperado 3 (cabeçalho e dois itens)", len(records))
+	}
+
+	header := strings.Join(records[0], ",")
+	expected := "id,codigo,preco,ativo,descricao,categoria.id,categoria.nome"
+	if header != expected {
+		t.Fatalf("cabeçalho = %q, esperado %q", header, expected)
+	}
+	if first := strings.Join(records[1], ","); first != "1,P-001,0,false,,," {
+		t.Errorf("primeira linha = %q", first)
+	}
+	if second := strings.Join(records[2], ","); second != "2,P-002,0,false,Mouse,3,Periféricos" {
+		t.Errorf("segunda linha = %q", second)
+	}
+}
+
+func TestCSVEncoderNeutralizesFormulas(t *testing.T) {
+	items := []encoderCategoria{{ID: 1, Nome: "=HYPERLINK(\"http://x\")"}, {ID: 2, Nome: "@SUM(A1)"}, {ID: 3, Nome: "-5"}}
+
+	_, body := encode(t, "text/csv", items)
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(body), "\uFEFF"))).ReadAll()
+	if err != nil {
+		t.Fatalf("CSV inválido: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("linhas = %d, esperado 4", len(records))
+	}
+	expected := []string{"'=HYPERLINK(\"http://x\")", "'@SUM(A1)", "-5"}
+	for i, nome := range expected {
+		if records[i+1][1] != nome {
+			t.Errorf("linha %d: nome = %q, esperado %q", i+1, records[i+1][1], nome)
+		}
+	}
+}
+
+func TestCSVEncoderSingleRecordFallsBackToJSON(t *testing.T) {
+	contentType, body := encode(t, "text/csv", encoderCategoria{ID: 1, Nome: "Periféricos"})
+	if contentType != fiber.MIMEApplicationJSON {
+		t.Fatalf("Content-Type = %q, esperado JSON", contentType)
+	}
+	if string(body) != `{"id":1,"nome":"Periféricos"}` {
+		t.Errorf("body = %s", body)
+	}
+}
+
+func TestXMLEncoder(t *testing.T) {
+	contentType, body := encode(t, fiber.MIMEApplicationXML, fiber.Map{
+		"data":  []encoderCategoria{{ID: 1, Nome: "A & B"}},
+		"total": 1,
+	})
+	if contentType != fiber.MIMEApplicationXMLCharsetUTF8 {
+		t.Fatalf("Content-Type = %q", contentType)
+	}
+
+	expected := `<response><data><item><id>1</id><nome>A &amp; B</nome></item></data><total>1</total></response>`
+	if !strings.HasSuffix(string(body), expected) {
+		t.Errorf("body = %s, esperado terminar com %s", body, expected)
+	}
+}
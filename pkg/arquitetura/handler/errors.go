@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderTraceParent cabeçalho de propagação de trace (W3C Trace Context)
+const HeaderTraceParent = "traceparent"
+
+// SendError responde o erro no formato padrão, incluindo o ID da requisição, o trace id (quando presente)
+// e o momento do erro, para que o usuário informe ao suporte um identificador pesquisável nos logs
+// Sem código, usa o código genérico do status (ver arqerrors.CodeForStatus)
+// Com o formato problem (SetErrorFormat), responde application/problem+json (RFC 7807)
+func SendError(c *fiber.Ctx, status int, resp dto.ErrorResponse) error {
+	if resp.Code == "" {
+		resp.Code = arqerrors.CodeForStatus(status)
+	}
+	resp.RequestID = RequestID(c)
+	resp.TraceID = TraceID(c)
+	resp.Timestamp = entity.FormatTime(time.Now())
+
+	if problemEnabled() {
+		return c.Status(status).JSON(problemFor(c, status, resp), MIMEApplicationProblemJSON)
+	}
+	return c.Status(status).JSON(resp)
+}
+
+// SendBusinessError responde o erro de negócio com o status do catálogo de códigos
+// Quando o erro indica o campo (ex: coluna de uma restrição violada), ele é informado em details e codes
+func SendBusinessError(c *fiber.Ctx, err *arqerrors.BusinessError) error {
+	return SendError(c, arqerrors.StatusForCode(err.Code), businessErrorResponse(err))
+}
+
+// ErrorResponseFor converte o erro no status e na resposta padrão: erros de validação, tempo limite,
+// erros de negócio (status do catálogo), *fiber.Error e, para os demais, erro interno sem expor a mensagem
+// Usado pelo HandleError dos handlers e pelo ErrorHandler global do Fiber, para que os erros dos handlers
+// e os do roteamento/middlewares cheguem aos clientes no mesmo formato
+func ErrorResponseFor(c *fiber.Ctx, err error) (int, dto.ErrorResponse) {
+	// Erros de validação
+	var validationErrors *arqerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:    validationErrors.Code(),
+			Error:   Message(c, i18n.MsgValidation, nil),
+			Details: validationErrors.Errors,
+			Codes:   validationErrors.Codes,
+		}
+	}
+
+	// Tempo limite da requisição excedido (deadline propagado pelo contexto)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fiber.StatusServiceUnavailable, dto.ErrorResponse{
+			Code:  arqerrors.CodeTimeout,
+			Error: Message(c, i18n.MsgTimeout, nil),
+		}
+	}
+
+	// Erros de negócio (status conforme o catálogo de códigos)
+	if businessErr, ok := arqerrors.GetBusinessError(err); ok {
+		return arqerrors.StatusForCode(businessErr.Code), businessErrorResponse(businessErr)
+	}
+
+	// Erros do Fiber: rota inexistente, método não permitido e parâmetros inválidos (fiber.NewError)
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		message := fiberErr.Message
+		switch fiberErr.Code {
+		case fiber.StatusNotFound:
+			message = Message(c, i18n.MsgRouteNotFound, i18n.Params{"method": c.Method(), "path": c.Path()})
+		case fiber.StatusMethodNotAllowed:
+			message = Message(c, i18n.MsgMethodNotAllowed, i18n.Params{"method": c.Method(), "path": c.Path()})
+		case fiber.StatusInternalServerError:
+			message = Message(c, i18n.MsgInternal, nil)
+		}
+		return fiberErr.Code, dto.ErrorResponse{
+			Code:  arqerrors.CodeForStatus(fiberErr.Code),
+			Error: message,
+		}
+	}
+
+	// Erro genérico
+	return http.StatusInternalServerError, dto.ErrorResponse{
+		Code:  arqerrors.CodeInternal,
+		Error: Message(c, i18n.MsgInternal, nil),
+	}
+}
+
+// businessErrorResponse monta a resposta do erro de negócio, com o campo em details e codes quando informado
+func businessErrorResponse(err *arqerrors.BusinessError) dto.ErrorResponse {
+	resp := dto.ErrorResponse{
+		Code:  err.Code,
+		Error: err.Message,
+	}
+	if err.Field != "" {
+		resp.Details = map[string]string{err.Field: err.Message}
+		resp.Codes = map[string]string{err.Field: err.Code}
+	}
+	return resp
+}
+
+// RequestID retorna o ID da requisição gerado pelo middleware de Request ID
+func RequestID(c *fiber.Ctx) string {
+	requestID, _ := c.Locals("requestid").(string)
+	return requestID
+}
+
+// TraceID retorna o trace id do cabeçalho traceparent (versão-traceid-spanid-flags)
+// Retorna vazio quando o cabeçalho não existe ou é inválido
+func TraceID(c *fiber.Ctx) string {
+	parts := strings.Split(c.Get(HeaderTraceParent), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || strings.Trim(parts[1], "0") == "" {
+		return ""
+	}
+	for _, r := range parts[1] {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f') {
+			return ""
+		}
+	}
+	return parts[1]
+}
+
+// Message traduz a mensagem do catálogo para o idioma da requisição (Accept-Language)
+func Message(c *fiber.Ctx, key string, params i18n.Params) string {
+	return i18n.T(c.UserContext(), key, params)
+}
+
+// entityMessage traduz a mensagem com o nome da entidade do handler no placeholder {entity}
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) entityMessage(c *fiber.Ctx, key string) string {
+	return Message(c, key, i18n.Params{"entity": i18n.Entity(c.UserContext(), h.Config.EntityName)})
+}
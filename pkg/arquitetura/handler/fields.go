@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ParseFields lê os campos solicitados em ?fields= (ex: fields=id,codigo,preco), aceitando snake_case ou
+// camelCase. Apenas os campos de allowed são aceitos: campo fora da lista retorna 400. Sem o parâmetro,
+// retorna nil (todos os campos)
+// Exportado para uso em handlers filhos
+func ParseFields(c *fiber.Ctx, allowed []string) ([]string, error) {
+	value := c.Query("fields")
+	if value == "" {
+		return nil, nil
+	}
+
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = jsoncodec.CamelToSnake(strings.TrimSpace(field))
+		if field == "" {
+			continue
+		}
+		if !containsString(allowed, field) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgFieldNotAllowed, i18n.Params{"field": field}))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// SelectFields mantém nas respostas da página apenas os campos solicitados (e o id), reduzindo o payload
+// das listagens com ?fields=. Os campos são os nomes JSON das respostas
+func SelectFields[Resp any](result *dto.PaginatedResponse[Resp], fields []string) *dto.PaginatedResponse[map[string]json.RawMessage] {
+	keep := map[string]bool{"id": true}
+	for _, field := range fields {
+		keep[field] = true
+	}
+
+	return MapPaginatedResponse(result, func(resp *Resp) *map[string]json.RawMessage {
+		selected := make(map[string]json.RawMessage, len(keep))
+		data, err := json.Marshal(resp)
+		if err != nil {
+			return &selected
+		}
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			return &selected
+		}
+		for key, value := range all {
+			if keep[key] {
+				selected[key] = value
+			}
+		}
+		return &selected
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ParseFilters converte os parâmetros de filtro da query string na especificação da listagem
+// Cada parâmetro é o campo com um sufixo de operador opcional (ex: ?preco_gte=10&descricao_like=notebook&categoria_id_in=1,2):
+// sem sufixo compara igualdade; _ne, _gt, _gte, _lt e _lte comparam o valor; _like busca o trecho sem
+// diferenciar maiúsculas; _in aceita valores separados por vírgula. Os filtros são combinados com AND
+// Apenas os campos de allowed são filtráveis: parâmetro com sufixo de operador em campo fora da lista retorna
+// 400; os demais parâmetros (page, count...) são ignorados. Retorna nil quando não há filtros
+// Os valores são convertidos para o tipo do campo na consulta (valor inválido retorna 400 INVALID_PARAMETER)
+func ParseFilters(c *fiber.Ctx, allowed []string) (repository.Specification, error) {
+	fields := make(map[string]bool, len(allowed))
+	for _, field := range allowed {
+		fields[field] = true
+	}
+
+	var filters []repository.Specification
+	var parseErr error
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		if parseErr != nil {
+			return
+		}
+		param := string(key)
+		field, operator, ok := splitFilterParam(param, fields)
+		if !ok {
+			if operator != "" {
+				parseErr = fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgFilterNotAllowed, i18n.Params{"param": param}))
+			}
+			return
+		}
+
+		values := []string{string(value)}
+		if operator == repository.FilterIn {
+			values = splitFilterValues(string(value))
+		}
+		filters = append(filters, repository.Filter{Param: param, Field: field, Operator: operator, Values: values})
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	return repository.And(filters...), nil
+}
+
+// splitFilterParam separa o campo e o operador do parâmetro (ex: preco_gte → preco, gte)
+// ok é false quando o campo não é filtrável; operator indica se o parâmetro tinha sufixo de operador
+func splitFilterParam(param string, fields map[string]bool) (field string, operator repository.FilterOperator, ok bool) {
+	if fields[param] {
+		return param, repository.FilterEq, true
+	}
+	for _, op := range repository.FilterOperators {
+		if base, found := strings.CutSuffix(param, "_"+string(op)); found && base != "" {
+			return base, op, fields[base]
+		}
+	}
+	return "", "", false
+}
+
+// splitFilterValues separa os valores do operador _in, ignorando os vazios
+func splitFilterValues(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
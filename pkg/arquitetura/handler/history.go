@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"strconv"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetHistory retorna o histórico de alterações de uma entidade com paginação
+// Filtros opcionais: from/to (RFC3339 ou AAAA-MM-DD) e operation (create, update, delete, restore, delete_permanently)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) GetHistory(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	filter, err := ParseHistoryFilter(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.GetHistory(ctx, id, filter, page, pageSize)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendPaginated(c, result)
+}
+
+// DiffVersions compara duas versões do histórico de uma entidade
+// from (obrigatório) e to (padrão: versão atual) aceitam o ID do registro do histórico ou uma data
+// (RFC3339 ou AAAA-MM-DD), que seleciona a versão vigente naquele momento
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) DiffVersions(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if c.Query("from") == "" {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgRequiredParameter, i18n.Params{"param": "from"}))
+	}
+	from, err := parseVersionRef(c.Query("from"), false)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidVersionRef, i18n.Params{"param": "from"}))
+	}
+	to, err := parseVersionRef(c.Query("to"), true)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidVersionRef, i18n.Params{"param": "to"}))
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.DiffVersions(ctx, id, from, to)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendResponse(c, result)
+}
+
+// parseVersionRef converte a referência de versão: ID do histórico, data ou vazio (versão atual)
+// Com endOfDay, uma data sem horário seleciona a versão vigente ao final do dia
+func parseVersionRef(value string, endOfDay bool) (audit.VersionRef, error) {
+	if value == "" {
+		return audit.VersionRef{}, nil
+	}
+	if entryID, ok := parseID(value); ok {
+		return audit.VersionRef{EntryID: entryID}, nil
+	}
+
+	at, dateOnly, ok := ParseDateParam(value)
+	if !ok {
+		return audit.VersionRef{}, fiber.ErrBadRequest
+	}
+	if dateOnly && endOfDay {
+		at = at.Add(24*time.Hour - time.Nanosecond)
+	}
+	return audit.VersionRef{At: &at}, nil
+}
+
+// ParseHistoryFilter lê os filtros de histórico da query string (from, to e operation)
+// Uma data sem horário em "to" inclui o dia inteiro
+// Exportado para uso em handlers filhos
+func ParseHistoryFilter(c *fiber.Ctx) (audit.Filter, error) {
+	var filter audit.Filter
+
+	if value := c.Query("from"); value != "" {
+		from, _, ok := ParseDateParam(value)
+		if !ok {
+			return filter, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidDate, i18n.Params{"param": "from"}))
+		}
+		filter.From = &from
+	}
+
+	if value := c.Query("to"); value != "" {
+		to, dateOnly, ok := ParseDateParam(value)
+		if !ok {
+			return filter, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidDate, i18n.Params{"param": "to"}))
+		}
+		if dateOnly {
+			to = to.Add(24*time.Hour - time.Nanosecond)
+		}
+		filter.To = &to
+	}
+
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return filter, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidRangeOrder, i18n.Params{"from": "from", "to": "to"}))
+	}
+
+	if value := c.Query("operation"); value != "" {
+		op, ok := audit.ParseOperation(value)
+		if !ok {
+			return filter, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "operation", "values": "create, update, delete, restore, delete_permanently"}))
+		}
+		filter.Operation = op
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"errors"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/importer"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Import importa os registros de um arquivo CSV ou XLSX enviado em multipart/form-data (campo file)
+// A primeira linha é o cabeçalho com os nomes dos campos do request de criação (ex: codigo, descricao);
+// cada linha é validada como uma criação e as válidas são gravadas em lotes (ver service.BaseServiceImpl.Import)
+// Retorna 200 com o relatório das linhas rejeitadas ou 422 quando nenhuma linha foi importada
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Import(c *fiber.Ctx) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgRequiredParameter, i18n.Params{"param": "file"}))
+	}
+
+	format, err := importer.DetectFormat(fileHeader.Filename, fileHeader.Header.Get(fiber.HeaderContentType))
+	if err != nil {
+		return h.importFileError(c, i18n.MsgImportFormat)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.Log.WithError(err).Warn("Erro ao abrir arquivo da importação")
+		return h.importFileError(c, i18n.MsgImportInvalidFile)
+	}
+	defer file.Close()
+
+	sheet, err := importer.Read(file, format)
+	if errors.Is(err, importer.ErrEmpty) {
+		return h.importFileError(c, i18n.MsgImportEmpty)
+	}
+	if err != nil {
+		h.Log.WithError(err).WithField("format", format).Warn("Erro ao ler arquivo da importação")
+		return h.importFileError(c, i18n.MsgImportInvalidFile)
+	}
+
+	rows := make([]dto.ImportRow[CreateReq], len(sheet.Rows))
+	for i, row := range sheet.Rows {
+		req, fieldErrors := importer.Decode[CreateReq](sheet.Header, row.Values)
+		rows[i] = dto.ImportRow[CreateReq]{Line: row.Line, Request: req}
+		if len(fieldErrors) > 0 {
+			rows[i].Errors = make(map[string]string, len(fieldErrors))
+			for _, fieldErr := range fieldErrors {
+				rows[i].Errors[fieldErr.Field] = Message(c, i18n.MsgImportInvalidValue, i18n.Params{"value": fieldErr.Value})
+			}
+		}
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.Import(ctx, rows)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	if result.Imported == 0 {
+		return SendResponse(c.Status(fiber.StatusUnprocessableEntity), result)
+	}
+	return SendResponse(c, result)
+}
+
+// importFileError responde 400 para arquivos de importação ausentes, ilegíveis ou vazios
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) importFileError(c *fiber.Ctx, key string) error {
+	return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+		Code:  arqerrors.CodeInvalidBody,
+		Error: Message(c, key, nil),
+	})
+}
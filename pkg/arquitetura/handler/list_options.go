@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"strconv"
+
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ParseListOptions monta as opções da listagem a partir da query string:
+// ?page= e ?page_size= (padrão 1 e 10), ?count= (ver ParseCountMode), períodos (ver ParseDateRange),
+// filtros em Config.FilterableFields (ver ParseFilters), ?sort= em Config.SortableFields (ver ParseSort),
+// ?fields= em Config.SelectableFields (ver ParseFields) e ?include_deleted=true (inclui a lixeira)
+// Preloads não são aceitos da query string: ficam a cargo do handler ou do serviço
+// Exportado para uso em handlers filhos
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) ParseListOptions(c *fiber.Ctx) (repository.ListOptions, error) {
+	var opts repository.ListOptions
+	opts.Page, _ = strconv.Atoi(c.Query("page", "1"))
+	opts.PageSize, _ = strconv.Atoi(c.Query("page_size", "10"))
+
+	var err error
+	if opts.CountMode, err = h.ParseCountMode(c); err != nil {
+		return opts, err
+	}
+	if opts.DateRange, err = ParseDateRange(c); err != nil {
+		return opts, err
+	}
+	if opts.Filters, err = ParseFilters(c, h.Config.FilterableFields); err != nil {
+		return opts, err
+	}
+	if opts.Sort, err = ParseSort(c, h.Config.SortableFields); err != nil {
+		return opts, err
+	}
+	if opts.Fields, err = ParseFields(c, h.Config.SelectableFields); err != nil {
+		return opts, err
+	}
+
+	if value := c.Query("include_deleted"); value != "" {
+		if opts.IncludeDeleted, err = strconv.ParseBool(value); err != nil {
+			return opts, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "include_deleted", "values": "true, false"}))
+		}
+	}
+	return opts, nil
+}
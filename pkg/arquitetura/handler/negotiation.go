@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ErrUnsupportedValue indica que o encoder não representa a resposta (ex: CSV de um único registro);
+// a resposta é enviada em JSON
+var ErrUnsupportedValue = errors.New("resposta não suportada pelo formato")
+
+// Encoder serializa as respostas dos handlers em um formato negociado pelo cabeçalho Accept
+// Encode recebe a resposta original; use JSONDocument para partir da mesma representação do JSON
+// (nomes dos campos conforme JSON_NAMING e seleção de ?fields=)
+type Encoder interface {
+	ContentType() string
+	Encode(c *fiber.Ctx, value interface{}) ([]byte, error)
+}
+
+// Encoders registrados, pelo media type (JSON é sempre aceito e é o padrão)
+var (
+	encodersMu    sync.RWMutex
+	encoders      = map[string]Encoder{}
+	encoderOffers = []string{fiber.MIMEApplicationJSON}
+)
+
+// RegisterEncoder adiciona (ou substitui) o encoder do media type (ex: application/xml)
+// Registre na inicialização da aplicação, antes de receber requisições
+func RegisterEncoder(mediaType string, encoder Encoder) {
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+	if _, exists := encoders[mediaType]; !exists {
+		encoderOffers = append(encoderOffers, mediaType)
+	}
+	encoders[mediaType] = encoder
+}
+
+// MediaTypes retorna os media types aceitos nas respostas, na ordem de preferência do servidor
+func MediaTypes() []string {
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	return append([]string(nil), encoderOffers...)
+}
+
+// SendResponse envia a resposta no formato negociado pelo cabeçalho Accept (q-values e curingas)
+// Sem Accept, com */* ou sem formato aceito, responde JSON. Exportado para uso em handlers filhos
+func SendResponse(c *fiber.Ctx, value interface{}) error {
+	c.Vary(fiber.HeaderAccept)
+
+	encoder := negotiate(c)
+	if encoder == nil {
+		return c.JSON(value)
+	}
+
+	data, err := encoder.Encode(c, value)
+	if errors.Is(err, ErrUnsupportedValue) {
+		return c.JSON(value)
+	}
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, encoder.ContentType())
+	return c.Send(data)
+}
+
+// negotiate retorna o encoder do formato preferido pelo cliente (nil = JSON)
+func negotiate(c *fiber.Ctx) Encoder {
+	if c.Get(fiber.HeaderAccept) == "" {
+		return nil
+	}
+
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+	return encoders[c.Accepts(encoderOffers...)]
+}
+
+// Object objeto de JSONDocument, com os campos na ordem da resposta JSON
+type Object []Member
+
+// Member campo de um Object
+type Member struct {
+	Key   string
+	Value interface{}
+}
+
+// JSONDocument converte a resposta com o codificador JSON da aplicação e retorna o documento com os campos
+// na ordem original: nil, bool, json.Number, string, []interface{} ou Object
+// Base dos encoders embutidos, para que todos os formatos tenham os mesmos campos da resposta JSON
+func JSONDocument(c *fiber.Ctx, value interface{}) (interface{}, error) {
+	data, err := c.App().Config().JSONEncoder(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	doc, err := decodeValue(decoder)
+	if err != nil {
+		return nil, fmt.Errorf("documento JSON inválido: %w", err)
+	}
+	return doc, nil
+}
+
+// decodeValue lê o próximo valor do decoder preservando a ordem dos campos dos objetos
+func decodeValue(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		object := Object{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, Member{Key: key.(string), Value: value})
+		}
+		_, err = decoder.Token()
+		return object, err
+	case '[':
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := decodeValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = decoder.Token()
+		return array, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/repository"
+	"api_fibergorm/pkg/arquitetura/service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NestedService define os métodos que o serviço deve implementar para o handler de sub-recursos
+type NestedService[CreateReq any, UpdateReq any, Resp any] interface {
+	BaseService[CreateReq, UpdateReq, Resp]
+	CheckParent(ctx context.Context, relation service.ParentRelation, parentID uint) error
+	CheckInParent(ctx context.Context, relation service.ParentRelation, parentID, id uint) error
+	GetAllByParent(ctx context.Context, relation service.ParentRelation, parentID uint, page, pageSize int, countMode repository.CountMode) (*dto.PaginatedResponse[Resp], error)
+	GetByIDInParent(ctx context.Context, relation service.ParentRelation, parentID, id uint) (*Resp, error)
+}
+
+// NestedHandler registra o CRUD de um sub-recurso sob o recurso pai
+// (ex: /categorias/:categoria_id/produtos). O ID do pai é validado em todas as rotas, usado como
+// filtro nas leituras e injetado no campo da chave estrangeira dos requests de criação e atualização
+//
+// O campo da chave estrangeira nos requests é encontrado pela tag json igual a relation.ForeignKey
+// (ex: `json:"categoria_id"`); o valor informado no body é sempre substituído pelo ID da rota
+type NestedHandler[CreateReq any, UpdateReq any, Resp any] struct {
+	*BaseHandlerImpl[CreateReq, UpdateReq, Resp]
+	nested      NestedService[CreateReq, UpdateReq, Resp]
+	relation    service.ParentRelation
+	parentParam string
+	listEnabled bool
+}
+
+// NewNestedHandler cria um handler de sub-recurso
+// O parâmetro de rota do pai tem, por padrão, o nome da chave estrangeira (ex: :categoria_id)
+func NewNestedHandler[CreateReq any, UpdateReq any, Resp any](
+	svc NestedService[CreateReq, UpdateReq, Resp],
+	relation service.ParentRelation,
+	log logging.Logger,
+	config *HandlerConfig,
+) *NestedHandler[CreateReq, UpdateReq, Resp] {
+	return &NestedHandler[CreateReq, UpdateReq, Resp]{
+		BaseHandlerImpl: NewBaseHandler[CreateReq, UpdateReq, Resp](svc, log, config),
+		nested:          svc,
+		relation:        relation,
+		parentParam:     relation.ForeignKey,
+		listEnabled:     true,
+	}
+}
+
+// WithParentParam define o nome do parâmetro de rota com o ID do pai
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) WithParentParam(name string) *NestedHandler[CreateReq, UpdateReq, Resp] {
+	h.parentParam = name
+	return h
+}
+
+// WithoutList não registra a listagem (GET /), para quando o path da coleção já é atendido por outra rota
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) WithoutList() *NestedHandler[CreateReq, UpdateReq, Resp] {
+	h.listEnabled = false
+	return h
+}
+
+// List retorna as entidades do recurso pai com paginação
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) List(c *fiber.Ctx) error {
+	parentID, err := h.ParseID(c, h.parentParam)
+	if err != nil {
+		return err
+	}
+
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	countMode, err := h.ParseCountMode(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.nested.GetAllByParent(ctx, h.relation, parentID, page, pageSize, countMode)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendPaginated(c, result)
+}
+
+// Get busca uma entidade do recurso pai pelo ID
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) Get(c *fiber.Ctx) error {
+	parentID, id, err := h.parseIDs(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.nested.GetByIDInParent(ctx, h.relation, parentID, id)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	if h.NotModified(c, result) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	return SendResponse(c, result)
+}
+
+// Create cria uma entidade vinculada ao recurso pai (Location e Prefer como em BaseHandlerImpl.Create)
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) Create(c *fiber.Ctx) error {
+	parentID, err := h.ParseID(c, h.parentParam)
+	if err != nil {
+		return err
+	}
+
+	var req CreateReq
+	if errResponse := h.parseBody(c, &req, parentID); errResponse != nil {
+		return SendError(c, fiber.StatusBadRequest, *errResponse)
+	}
+
+	ctx := c.UserContext()
+	if err := h.nested.CheckParent(ctx, h.relation, parentID); err != nil {
+		return h.HandleError(c, err)
+	}
+
+	result, err := h.nested.Create(ctx, &req)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendCreated(c, c.Path(), result)
+}
+
+// Update atualiza uma entidade do recurso pai
+// A chave estrangeira é mantida: a entidade não pode ser movida para outro pai por esta rota
+// Aceita a mesma pré-condição do Update do handler base (ver PreconditionContext)
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) Update(c *fiber.Ctx) error {
+	parentID, id, err := h.parseIDs(c)
+	if err != nil {
+		return err
+	}
+
+	var req UpdateReq
+	if errResponse := h.parseBody(c, &req, parentID); errResponse != nil {
+		return SendError(c, fiber.StatusBadRequest, *errResponse)
+	}
+
+	ctx, err := PreconditionContext(c)
+	if err != nil {
+		return err
+	}
+	if err := h.nested.CheckInParent(ctx, h.relation, parentID, id); err != nil {
+		return h.HandleError(c, err)
+	}
+
+	result, err := h.nested.Update(ctx, id, &req)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendResponse(c, result)
+}
+
+// Delete remove uma entidade do recurso pai
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) Delete(c *fiber.Ctx) error {
+	parentID, id, err := h.parseIDs(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	if err := h.nested.CheckInParent(ctx, h.relation, parentID, id); err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return h.BaseHandlerImpl.Delete(c)
+}
+
+// RegisterRoutes registra as rotas do sub-recurso no grupo do pai
+// O grupo deve conter o parâmetro do pai (ex: router.Group("/categorias/:categoria_id/produtos"))
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) RegisterRoutes(router fiber.Router) {
+	tx := h.transactional
+	parent := h.parentParam
+	if h.listEnabled {
+		router.Get("/", ValidateIDParams(parent), h.WithDeprecation("GET /", h.List))
+	}
+	router.Post("/", tx(ValidateIDParams(parent), h.WithDeprecation("POST /", h.Create))...)
+	router.Get("/:id", ValidateIDParams(parent, "id"), h.WithDeprecation("GET /:id", h.Get))
+	router.Put("/:id", tx(ValidateIDParams(parent, "id"), h.WithDeprecation("PUT /:id", h.Update))...)
+	router.Delete("/:id", tx(ValidateIDParams(parent, "id"), h.WithDeprecation("DELETE /:id", h.Delete))...)
+}
+
+// parseIDs extrai o ID do pai e o ID da entidade
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) parseIDs(c *fiber.Ctx) (uint, uint, error) {
+	parentID, err := h.ParseID(c, h.parentParam)
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	return parentID, id, nil
+}
+
+// parseBody faz o parse do body, injeta o ID do pai e valida as tags do request
+// Retorna a resposta de erro (400) quando o body é inválido
+func (h *NestedHandler[CreateReq, UpdateReq, Resp]) parseBody(c *fiber.Ctx, req interface{}, parentID uint) *dto.ErrorResponse {
+	if err := c.BodyParser(req); err != nil {
+		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+		return &dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
+		}
+	}
+
+	if !setParentField(req, h.relation.ForeignKey, parentID) {
+		h.Log.WithField("foreign_key", h.relation.ForeignKey).Warn("Request sem campo para a chave estrangeira do recurso pai")
+	}
+
+	if validationErrors := h.StructValidator.ValidateContext(c.UserContext(), reflect.ValueOf(req).Elem().Interface()); len(validationErrors) > 0 {
+		h.Log.WithField("errors", validationErrors).Warn("Erro de validação no sub-recurso")
+		return &dto.ErrorResponse{
+			Code:    arqerrors.CodeValidation,
+			Error:   Message(c, i18n.MsgValidation, nil),
+			Details: validationErrors,
+		}
+	}
+	return nil
+}
+
+// setParentField atribui o ID do pai ao campo do request cuja tag json é a chave estrangeira
+// Aceita campos inteiros sem sinal e ponteiros para eles; retorna false se o campo não existir
+func setParentField(req interface{}, foreignKey string, parentID uint) bool {
+	v := reflect.ValueOf(req)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	v = v.Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != foreignKey || !field.IsExported() {
+			continue
+		}
+
+		target := v.Field(i)
+		if target.Kind() == reflect.Ptr {
+			if !isUintKind(target.Type().Elem().Kind()) {
+				return false
+			}
+			target.Set(reflect.New(target.Type().Elem()))
+			target = target.Elem()
+		}
+		if !isUintKind(target.Kind()) {
+			return false
+		}
+		target.SetUint(uint64(parentID))
+		return true
+	}
+	return false
+}
+
+// isUintKind verifica se o tipo é um inteiro sem sinal
+func isUintKind(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uint64
+}
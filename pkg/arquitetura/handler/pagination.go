@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderTotalCount cabeçalho com o total de registros da listagem
+const HeaderTotalCount = "X-Total-Count"
+
+// SendPaginated escreve os cabeçalhos de paginação e envia a resposta paginada no formato negociado (SendResponse)
+// Exportado para uso em handlers filhos com listagens próprias
+func SendPaginated[T any](c *fiber.Ctx, result *dto.PaginatedResponse[T]) error {
+	SetPaginationHeaders(c, result)
+	return SendResponse(c, result)
+}
+
+// SetPaginationHeaders adiciona os cabeçalhos Link (RFC 5988: first, prev, next e last) e X-Total-Count,
+// permitindo que clientes HTTP genéricos paginem sem interpretar o envelope da resposta
+// last e X-Total-Count só são enviados quando o total foi calculado (omitidos com ?count=none)
+func SetPaginationHeaders[T any](c *fiber.Ctx, result *dto.PaginatedResponse[T]) {
+	if result == nil {
+		return
+	}
+
+	links := []string{paginationLink(c, 1, "first")}
+	if result.Page > 1 {
+		links = append(links, paginationLink(c, result.Page-1, "prev"))
+	}
+	if result.HasNext {
+		links = append(links, paginationLink(c, result.Page+1, "next"))
+	}
+	if result.TotalPages != nil {
+		links = append(links, paginationLink(c, max(*result.TotalPages, 1), "last"))
+	}
+	c.Append(fiber.HeaderLink, strings.Join(links, ", "))
+
+	if result.Total != nil {
+		c.Set(HeaderTotalCount, strconv.FormatInt(*result.Total, 10))
+	}
+}
+
+// SendCursorPaginated escreve os cabeçalhos Link (first e next) e envia a resposta paginada por cursor
+func SendCursorPaginated[T any](c *fiber.Ctx, result *dto.CursorPaginatedResponse[T]) error {
+	links := []string{queryLink(c, "cursor", "", "first")}
+	if result.HasNext {
+		links = append(links, queryLink(c, "cursor", result.NextCursor, "next"))
+	}
+	c.Append(fiber.HeaderLink, strings.Join(links, ", "))
+	return SendResponse(c, result)
+}
+
+// paginationLink monta o link da página preservando os demais parâmetros da query
+func paginationLink(c *fiber.Ctx, page int, rel string) string {
+	return queryLink(c, "page", strconv.Itoa(page), rel)
+}
+
+// queryLink monta o link da URL atual com o parâmetro substituído, preservando os demais
+func queryLink(c *fiber.Ctx, param, value, rel string) string {
+	query, _ := url.ParseQuery(string(c.Request().URI().QueryString()))
+	query.Set(param, value)
+	return "<" + c.BaseURL() + c.Path() + "?" + query.Encode() + ">; rel=\"" + rel + "\""
+}
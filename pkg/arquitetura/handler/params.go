@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MaxIDLength é o número máximo de dígitos aceitos em um parâmetro de ID
+const MaxIDLength = 10
+
+// ValidateIDParams valida os parâmetros de ID da rota antes de executar o handler
+// Os IDs devem ser inteiros positivos com no máximo MaxIDLength dígitos; IDs inválidos
+// são rejeitados com 400 no formato padrão de erro. Os valores convertidos ficam
+// disponíveis para o handler via IDParam (e ParseID do handler base)
+//
+// Uso: router.Get("/:id", handler.ValidateIDParams("id"), h.GetByID)
+func ValidateIDParams(names ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		details := make(map[string]string)
+
+		for _, name := range names {
+			id, ok := parseID(c.Params(name))
+			if !ok {
+				details[name] = Message(c, i18n.MsgInvalidIDParam, i18n.Params{"param": name, "max": MaxIDLength})
+				continue
+			}
+			c.Locals(idLocalsKey(name), id)
+		}
+
+		if len(details) > 0 {
+			return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+				Code:    arqerrors.CodeInvalidID,
+				Error:   Message(c, i18n.MsgInvalidID, nil),
+				Details: details,
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// IDParam retorna o ID já validado por ValidateIDParams
+func IDParam(c *fiber.Ctx, name string) (uint, bool) {
+	id, ok := c.Locals(idLocalsKey(name)).(uint)
+	return id, ok
+}
+
+// parseID converte e valida o valor de um parâmetro de ID
+func parseID(value string) (uint, bool) {
+	if value == "" || len(value) > MaxIDLength {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 || id > math.MaxUint32 {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// idLocalsKey chave do ID convertido em c.Locals
+func idLocalsKey(name string) string {
+	return "param:" + name
+}
+
+// ParseDateRange lê os filtros de período das listagens (created_after, created_before, updated_after
+// e updated_before) em RFC3339 ou AAAA-MM-DD. After é inclusivo e Before é exclusivo
+// Exportado para uso em handlers filhos
+func ParseDateRange(c *fiber.Ctx) (repository.DateRange, error) {
+	var dateRange repository.DateRange
+	params := []struct {
+		name   string
+		target **time.Time
+	}{
+		{"created_after", &dateRange.CreatedAfter},
+		{"created_before", &dateRange.CreatedBefore},
+		{"updated_after", &dateRange.UpdatedAfter},
+		{"updated_before", &dateRange.UpdatedBefore},
+	}
+
+	for _, param := range params {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+		t, _, ok := ParseDateParam(value)
+		if !ok {
+			return dateRange, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidDate, i18n.Params{"param": param.name}))
+		}
+		*param.target = &t
+	}
+
+	return dateRange, nil
+}
+
+// ParseDateParam converte uma data RFC3339 ou AAAA-MM-DD; dateOnly indica o formato sem horário
+// O "+" do fuso horário não codificado na URL chega como espaço e é restaurado
+// Exportado para uso em handlers filhos
+func ParseDateParam(value string) (t time.Time, dateOnly bool, ok bool) {
+	value = strings.ReplaceAll(value, " ", "+")
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, true
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, true, true
+	}
+	return time.Time{}, false, false
+}
+
+// ParseSort lê a ordenação da listagem em ?sort= (ex: sort=preco,-created_at; "-" para decrescente)
+// Apenas os campos de allowed são aceitos: campo fora da lista retorna 400. Sem o parâmetro, retorna nil
+// (ordenação padrão do serviço)
+// Exportado para uso em handlers filhos
+func ParseSort(c *fiber.Ctx, allowed []string) (repository.Sort, error) {
+	sort := repository.ParseSort(c.Query("sort"))
+	for _, field := range sort {
+		if !containsString(allowed, field.Field) {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgSortNotAllowed, i18n.Params{"field": field.Field}))
+		}
+	}
+	return sort, nil
+}
+
+// containsString verifica se o valor está na lista
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
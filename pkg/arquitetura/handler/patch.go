@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+	"api_fibergorm/pkg/arquitetura/mapper"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Patch atualiza apenas os campos informados (PATCH /:id), inclusive com valores zero ("" e 0) e null
+// Os campos são os do body JSON ou, quando informado, os de ?update_mask= (ex: update_mask=descricao,preco):
+// campos da máscara ausentes do body são limpos. Ver service.BaseServiceImpl.Patch
+// Aceita a mesma pré-condição do Update (?expected_updated_at= ou If-Unmodified-Since)
+// Com ?dry_run=true apenas valida o body, sem gravar (ver ValidateUpdate)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Patch(c *fiber.Ctx) error {
+	dryRun, err := ParseDryRun(c)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return h.ValidateUpdate(c)
+	}
+
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var req UpdateReq
+	if err := c.BodyParser(&req); err != nil {
+		h.Log.WithError(err).Warn("Erro ao fazer parse do body")
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:  arqerrors.CodeInvalidBody,
+			Error: Message(c, i18n.MsgInvalidBody, nil),
+		})
+	}
+
+	mask, err := ParseFieldMask[UpdateReq](c)
+	if err != nil {
+		return err
+	}
+
+	// Validação dos campos com validator (tags)
+	if validationErrors := h.StructValidator.ValidateContext(c.UserContext(), req); len(validationErrors) > 0 {
+		h.Log.WithField("errors", validationErrors).Warn("Erro de validação na atualização parcial")
+		return SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+			Code:    arqerrors.CodeValidation,
+			Error:   Message(c, i18n.MsgValidation, nil),
+			Details: validationErrors,
+		})
+	}
+
+	ctx, err := PreconditionContext(c)
+	if err != nil {
+		return err
+	}
+	result, err := h.Service.Patch(ctx, id, mask, &req)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendResponse(c, result)
+}
+
+// ParseFieldMask retorna a máscara de campos do PATCH: os nomes de ?update_mask= (snake_case ou camelCase,
+// apenas campos do request; outro campo retorna 400) ou, sem o parâmetro, as chaves do body JSON que são
+// campos do request (as demais são ignoradas, como no PUT). Os nomes seguem a ordem dos campos do request
+// Exportado para uso em handlers filhos
+func ParseFieldMask[UpdateReq any](c *fiber.Ctx) ([]string, error) {
+	allowed := mapper.MaskFields(reflect.TypeOf((*UpdateReq)(nil)).Elem())
+
+	requested := make(map[string]bool)
+	if value := c.Query("update_mask"); value != "" {
+		for _, field := range strings.Split(value, ",") {
+			field = jsoncodec.CamelToSnake(strings.TrimSpace(field))
+			if field == "" {
+				continue
+			}
+			if !containsString(allowed, field) {
+				return nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgMaskNotAllowed, i18n.Params{"field": field}))
+			}
+			requested[field] = true
+		}
+	} else {
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(c.Body(), &body); err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidBody, nil))
+		}
+		for key := range body {
+			requested[jsoncodec.CamelToSnake(key)] = true
+		}
+	}
+
+	mask := make([]string, 0, len(requested))
+	for _, field := range allowed {
+		if requested[field] {
+			mask = append(mask, field)
+		}
+	}
+	return mask, nil
+}
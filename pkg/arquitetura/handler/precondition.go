@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/service"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PreconditionContext retorna o contexto da requisição com a pré-condição da atualização, quando informada:
+// ?expected_updated_at= (o updated_at da versão lida pelo cliente, RFC3339) ou o header If-Unmodified-Since
+// (HTTP-date, ex: o Last-Modified do GET). O parâmetro prevalece sobre o header; header com data inválida é
+// ignorado (RFC 9110). Ver service.WithExpectedUpdatedAt
+// Exportado para uso em handlers filhos
+func PreconditionContext(c *fiber.Ctx) (context.Context, error) {
+	ctx := c.UserContext()
+
+	if value := c.Query("expected_updated_at"); value != "" {
+		expected, _, ok := ParseDateParam(value)
+		if !ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidDate, i18n.Params{"param": "expected_updated_at"}))
+		}
+		return service.WithExpectedUpdatedAt(ctx, expected), nil
+	}
+
+	if value := c.Get(fiber.HeaderIfUnmodifiedSince); value != "" {
+		if since, err := http.ParseTime(value); err == nil {
+			return service.WithExpectedUpdatedAt(ctx, since), nil
+		}
+	}
+
+	return ctx, nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Formatos das respostas de erro aceitos por SetErrorFormat
+const (
+	ErrorFormatDefault = "default" // dto.ErrorResponse (application/json)
+	ErrorFormatProblem = "problem" // dto.ProblemDetails (application/problem+json, RFC 7807)
+)
+
+// MIMEApplicationProblemJSON content type das respostas de erro no formato RFC 7807
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// Formato das respostas de erro (padrão: ErrorResponse)
+var (
+	errorFormatMu   sync.RWMutex
+	problemFormat   bool
+	problemTypeBase string
+)
+
+// SetErrorFormat define o formato de todas as respostas de erro (SendError, HandleError e ErrorHandler global)
+// format aceita default ou problem; vazio mantém default
+// typeBase é o prefixo do campo type no formato problem, completado com o código do erro
+// (ex: /api/v1/erros# → /api/v1/erros#NOT_FOUND); vazio usa about:blank
+func SetErrorFormat(format, typeBase string) error {
+	var problem bool
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", ErrorFormatDefault:
+	case ErrorFormatProblem:
+		problem = true
+	default:
+		return fmt.Errorf("formato de erro desconhecido: %q (use %s ou %s)", format, ErrorFormatDefault, ErrorFormatProblem)
+	}
+
+	errorFormatMu.Lock()
+	defer errorFormatMu.Unlock()
+	problemFormat = problem
+	problemTypeBase = typeBase
+	return nil
+}
+
+// problemEnabled indica se as respostas de erro usam o formato RFC 7807
+func problemEnabled() bool {
+	errorFormatMu.RLock()
+	defer errorFormatMu.RUnlock()
+	return problemFormat
+}
+
+// problemFor converte a resposta de erro padrão no formato RFC 7807
+// Os campos de ErrorResponse sem equivalente na RFC (código, erros de validação e identificadores) são extensões
+func problemFor(c *fiber.Ctx, status int, resp dto.ErrorResponse) dto.ProblemDetails {
+	errorFormatMu.RLock()
+	typeBase := problemTypeBase
+	errorFormatMu.RUnlock()
+
+	problemType := "about:blank"
+	if typeBase != "" {
+		problemType = typeBase + resp.Code
+	}
+
+	return dto.ProblemDetails{
+		Type:      problemType,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    resp.Error,
+		Instance:  c.Path(),
+		Code:      resp.Code,
+		Details:   resp.Details,
+		Codes:     resp.Codes,
+		RequestID: resp.RequestID,
+		TraceID:   resp.TraceID,
+		Timestamp: resp.Timestamp,
+	}
+}
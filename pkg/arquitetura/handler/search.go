@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Search busca as entidades pelo texto de ?q= (GET /search), ordenadas pela relevância
+// Aceita as mesmas opções da listagem (paginação, contagem, filtros, período, campos e lixeira), exceto
+// ?sort=. Registrada apenas para as entidades que declaram colunas de busca (entity.Searchable)
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Search(c *fiber.Ctx) error {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgRequiredParameter, i18n.Params{"param": "q"}))
+	}
+
+	opts, err := h.ParseListOptions(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.Search(ctx, query, opts)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendPaginated(c, result)
+}
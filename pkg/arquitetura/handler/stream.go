@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/export"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// exportBatchSize quantidade de registros carregados por lote nas exportações
+const exportBatchSize = 500
+
+// StreamJSONArray escreve um array JSON na resposta codificando um item por vez (SetBodyStreamWriter)
+// O uso de memória permanece constante independentemente do volume de dados.
+//
+// produce é executado após o retorno do handler, quando o Fiber escreve o body; por isso recebe
+// um contexto desvinculado do cancelamento da requisição e não deve acessar o *fiber.Ctx.
+// Como o status 200 já foi enviado, um erro durante a produção interrompe o array (JSON inválido),
+// sinalizando ao cliente que a exportação está incompleta.
+func StreamJSONArray[T any](c *fiber.Ctx, log logging.Logger, produce func(ctx context.Context, emit func(item *T) error) error) error {
+	encode := c.App().Config().JSONEncoder
+	ctx := context.WithoutCancel(c.UserContext())
+	logFields := logging.Fields{
+		"request_id": c.Locals("requestid"),
+		"path":       utils.CopyString(c.Path()),
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		count := 0
+		if _, err := w.WriteString("["); err != nil {
+			return
+		}
+
+		err := produce(ctx, func(item *T) error {
+			data, err := encode(item)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				if err := w.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			// O bufio.Writer descarrega automaticamente; erro de escrita indica cliente desconectado
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			log.WithError(err).WithFields(logFields).WithField("items", count).Error("Exportação interrompida")
+			_ = w.Flush()
+			return
+		}
+
+		_, _ = w.WriteString("]")
+		_ = w.Flush()
+		log.WithFields(logFields).WithField("items", count).Info("Exportação concluída")
+	})
+
+	return nil
+}
+
+// StreamCSV escreve os registros em CSV na resposta, uma linha por vez (SetBodyStreamWriter), como anexo
+// (Content-Disposition: attachment; filename=<fileName>-<data e hora>.csv)
+// produce segue as mesmas regras de StreamJSONArray; um erro durante a produção interrompe o arquivo
+// (linhas faltando), registrado no log
+func StreamCSV[T any](c *fiber.Ctx, log logging.Logger, fileName string, mapper export.RowMapper[T], produce func(ctx context.Context, emit func(item *T) error) error) error {
+	ctx := context.WithoutCancel(c.UserContext())
+	logFields := logging.Fields{
+		"request_id": c.Locals("requestid"),
+		"path":       utils.CopyString(c.Path()),
+		"format":     "csv",
+	}
+
+	c.Set(fiber.HeaderContentType, export.MIMETextCSV)
+	c.Attachment(fileName + "-" + time.Now().Format("20060102-150405") + ".csv")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		writer, err := export.NewCSVWriter(w, mapper)
+		if err == nil {
+			// O bufio.Writer descarrega automaticamente; erro de escrita indica cliente desconectado
+			err = produce(ctx, writer.Write)
+		}
+		if err == nil {
+			err = writer.Flush()
+		}
+		if err != nil {
+			rows := 0
+			if writer != nil {
+				_ = writer.Flush()
+				rows = writer.Rows()
+			}
+			log.WithError(err).WithFields(logFields).WithField("items", rows).Error("Exportação interrompida")
+			_ = w.Flush()
+			return
+		}
+
+		_ = w.Flush()
+		log.WithFields(logFields).WithField("items", writer.Rows()).Info("Exportação concluída")
+	})
+
+	return nil
+}
+
+// WithCSVExport habilita a exportação em CSV (GET /export?format=csv) com o mapeamento das linhas
+// e o prefixo do nome do arquivo (ex: "produtos"); retorna o próprio handler para chaining
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) WithCSVExport(fileName string, mapper export.RowMapper[Resp]) *BaseHandlerImpl[CreateReq, UpdateReq, Resp] {
+	h.csvFileName = fileName
+	h.csvMapper = mapper
+	return h
+}
+
+// Export exporta todas as entidades transmitidas item a item, carregadas do banco em lotes:
+// array JSON (padrão) ou CSV com ?format=csv, quando habilitado com WithCSVExport
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Export(c *fiber.Ctx) error {
+	produce := func(ctx context.Context, emit func(item *Resp) error) error {
+		return h.Service.StreamAll(ctx, exportBatchSize, emit)
+	}
+
+	formats := []string{"json"}
+	if h.csvMapper != nil {
+		formats = append(formats, "csv")
+	}
+	format := strings.ToLower(c.Query("format", "json"))
+	switch {
+	case format == "json":
+		return StreamJSONArray(c, h.Log, produce)
+	case format == "csv" && h.csvMapper != nil:
+		return StreamCSV(c, h.Log, h.csvFileName, h.csvMapper, produce)
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, Message(c, i18n.MsgInvalidValues, i18n.Params{"param": "format", "values": strings.Join(formats, ", ")}))
+	}
+}
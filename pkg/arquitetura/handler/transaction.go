@@ -0,0 +1,25 @@
+package handler
+
+import "github.com/gofiber/fiber/v2"
+
+// WithTransaction marca as rotas de escrita do handler como transacionais: cada requisição é executada
+// em uma única transação (middleware informado), desfeita em qualquer resposta não-2xx
+// Retorna o próprio handler para chaining; deve ser chamado antes de RegisterRoutes
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) WithTransaction(transaction fiber.Handler) *BaseHandlerImpl[CreateReq, UpdateReq, Resp] {
+	h.Config.Transaction = transaction
+	return h
+}
+
+// transactional insere o middleware de transação (quando configurado) antes do handler final da rota,
+// após os middlewares de validação e proteção, para que requisições rejeitadas não abram transação
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) transactional(handlers ...fiber.Handler) []fiber.Handler {
+	if h.Config.Transaction == nil {
+		return handlers
+	}
+
+	last := len(handlers) - 1
+	result := make([]fiber.Handler, 0, len(handlers)+1)
+	result = append(result, handlers[:last]...)
+	result = append(result, h.Config.Transaction, handlers[last])
+	return result
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"strconv"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WithPermanentDeleteGuard configura o middleware que protege a exclusão definitiva
+// (retorna o próprio handler para chaining); deve ser chamado antes de RegisterRoutes
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) WithPermanentDeleteGuard(guard fiber.Handler) *BaseHandlerImpl[CreateReq, UpdateReq, Resp] {
+	h.Config.PermanentDeleteGuard = guard
+	return h
+}
+
+// GetDeleted retorna as entidades da lixeira com paginação
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) GetDeleted(c *fiber.Ctx) error {
+	page, _ := strconv.Atoi(c.Query("page", "1"))
+	pageSize, _ := strconv.Atoi(c.Query("page_size", "10"))
+
+	countMode, err := h.ParseCountMode(c)
+	if err != nil {
+		return err
+	}
+
+	dateRange, err := ParseDateRange(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.GetDeleted(ctx, page, pageSize, countMode, dateRange)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendPaginated(c, result)
+}
+
+// Restore restaura uma entidade da lixeira
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) Restore(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	result, err := h.Service.Restore(ctx, id)
+	if err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendResponse(c, result)
+}
+
+// DeletePermanently remove definitivamente uma entidade da lixeira
+func (h *BaseHandlerImpl[CreateReq, UpdateReq, Resp]) DeletePermanently(c *fiber.Ctx) error {
+	id, err := h.ParseID(c, "id")
+	if err != nil {
+		return err
+	}
+
+	ctx := c.UserContext()
+	if err := h.Service.DeletePermanently(ctx, id); err != nil {
+		return h.HandleError(c, err)
+	}
+
+	return SendResponse(c, dto.SuccessResponse{
+		Message: h.entityMessage(c, i18n.MsgDeletedPermanently),
+	})
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/dto"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/google/uuid"
+)
+
+// VersionedService adapta um serviço para o DTO de resposta de outra versão da API
+// O serviço (regras de negócio, repositório e validações) é compartilhado entre as versões;
+// apenas o response é convertido pelo mapper da versão.
+//
+// Ex: handler v2 sobre o mesmo serviço da v1
+//
+//	svcV2 := handler.NewVersionedService(produtoService, mapper.ToProdutoResponseV2)
+//	handlerV2 := handler.NewBaseHandler(svcV2, log, handler.DefaultHandlerConfig("Produto"))
+type VersionedService[CreateReq any, UpdateReq any, Resp any, Out any] struct {
+	service BaseService[CreateReq, UpdateReq, Resp]
+	mapper  func(resp *Resp) *Out
+}
+
+// NewVersionedService cria o adaptador do serviço para o DTO da versão
+func NewVersionedService[CreateReq any, UpdateReq any, Resp any, Out any](
+	svc BaseService[CreateReq, UpdateReq, Resp],
+	mapper func(resp *Resp) *Out,
+) *VersionedService[CreateReq, UpdateReq, Resp, Out] {
+	return &VersionedService[CreateReq, UpdateReq, Resp, Out]{
+		service: svc,
+		mapper:  mapper,
+	}
+}
+
+// Create cria a entidade e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Create(ctx context.Context, req *CreateReq) (*Out, error) {
+	resp, err := s.service.Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// ValidateCreate valida a criação sem gravar (o resultado independe da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) ValidateCreate(ctx context.Context, req *CreateReq) error {
+	return s.service.ValidateCreate(ctx, req)
+}
+
+// ValidateUpdate valida a atualização sem gravar (o resultado independe da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) ValidateUpdate(ctx context.Context, id uint, req *UpdateReq) error {
+	return s.service.ValidateUpdate(ctx, id, req)
+}
+
+// GetByID busca a entidade e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetByID(ctx context.Context, id uint) (*Out, error) {
+	resp, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// GetByPublicID busca a entidade pelo UUID e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetByPublicID(ctx context.Context, publicID uuid.UUID) (*Out, error) {
+	resp, err := s.service.GetByPublicID(ctx, publicID)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// Count conta as entidades conforme as opções (o resultado independe da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Count(ctx context.Context, opts repository.ListOptions) (*dto.CountResponse, error) {
+	return s.service.Count(ctx, opts)
+}
+
+// GetAll lista as entidades conforme as opções e converte os responses para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetAll(ctx context.Context, opts repository.ListOptions) (*dto.PaginatedResponse[Out], error) {
+	result, err := s.service.GetAll(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return MapPaginatedResponse(result, s.mapper), nil
+}
+
+// Search busca as entidades e converte os responses dos resultados para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Search(ctx context.Context, query string, opts repository.ListOptions) (*dto.PaginatedResponse[dto.SearchHit[Out]], error) {
+	result, err := s.service.Search(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return MapPaginatedResponse(result, func(hit *dto.SearchHit[Resp]) *dto.SearchHit[Out] {
+		return &dto.SearchHit[Out]{Item: *s.mapper(&hit.Item), Rank: hit.Rank, Highlights: hit.Highlights}
+	}), nil
+}
+
+// Searchable indica se a entidade do serviço participa da busca textual
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Searchable() bool {
+	return s.service.Searchable()
+}
+
+// GetAllAfterCursor lista as entidades por cursor e converte os responses para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetAllAfterCursor(ctx context.Context, cursor *repository.Cursor, pageSize int) (*dto.CursorPaginatedResponse[Out], error) {
+	result, err := s.service.GetAllAfterCursor(ctx, cursor, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]Out, len(result.Data))
+	for i := range result.Data {
+		data[i] = *s.mapper(&result.Data[i])
+	}
+	return &dto.CursorPaginatedResponse[Out]{
+		Data:       data,
+		PageSize:   result.PageSize,
+		HasNext:    result.HasNext,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// StreamAll percorre as entidades convertendo cada response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) StreamAll(ctx context.Context, batchSize int, fn func(resp *Out) error) error {
+	return s.service.StreamAll(ctx, batchSize, func(resp *Resp) error {
+		return fn(s.mapper(resp))
+	})
+}
+
+// Update atualiza a entidade e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Update(ctx context.Context, id uint, req *UpdateReq) (*Out, error) {
+	resp, err := s.service.Update(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// Patch atualiza os campos da máscara e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Patch(ctx context.Context, id uint, fieldMask []string, req *UpdateReq) (*Out, error) {
+	resp, err := s.service.Patch(ctx, id, fieldMask, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// Delete remove a entidade
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Delete(ctx context.Context, id uint) error {
+	return s.service.Delete(ctx, id)
+}
+
+// GetDeleted lista a lixeira e converte os responses para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetDeleted(ctx context.Context, page, pageSize int, countMode repository.CountMode, dateRange repository.DateRange) (*dto.PaginatedResponse[Out], error) {
+	result, err := s.service.GetDeleted(ctx, page, pageSize, countMode, dateRange)
+	if err != nil {
+		return nil, err
+	}
+	return MapPaginatedResponse(result, s.mapper), nil
+}
+
+// Restore restaura a entidade e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Restore(ctx context.Context, id uint) (*Out, error) {
+	resp, err := s.service.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// Duplicate duplica a entidade e converte o response para a versão
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Duplicate(ctx context.Context, id uint, overrides map[string]interface{}) (*Out, error) {
+	resp, err := s.service.Duplicate(ctx, id, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapper(resp), nil
+}
+
+// DeletePermanently remove definitivamente a entidade da lixeira
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) DeletePermanently(ctx context.Context, id uint) error {
+	return s.service.DeletePermanently(ctx, id)
+}
+
+// GetHistory retorna o histórico de alterações (independente da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) GetHistory(ctx context.Context, id uint, filter audit.Filter, page, pageSize int) (*dto.PaginatedResponse[audit.EntryResponse], error) {
+	return s.service.GetHistory(ctx, id, filter, page, pageSize)
+}
+
+// DiffVersions compara duas versões do histórico (independente da versão da API)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) DiffVersions(ctx context.Context, id uint, from, to audit.VersionRef) (*audit.DiffResponse, error) {
+	return s.service.DiffVersions(ctx, id, from, to)
+}
+
+// BulkUpsert insere ou atualiza o lote (o resultado por item independe da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) BulkUpsert(ctx context.Context, key string, reqs []CreateReq) (*dto.BulkResponse, error) {
+	return s.service.BulkUpsert(ctx, key, reqs)
+}
+
+// Import importa as linhas do arquivo (o relatório independe da versão)
+func (s *VersionedService[CreateReq, UpdateReq, Resp, Out]) Import(ctx context.Context, rows []dto.ImportRow[CreateReq]) (*dto.ImportResponse, error) {
+	return s.service.Import(ctx, rows)
+}
+
+// MapPaginatedResponse converte os itens de uma resposta paginada preservando os metadados de paginação
+// Exportado para uso em handlers versionados com listagens próprias
+func MapPaginatedResponse[Resp any, Out any](result *dto.PaginatedResponse[Resp], mapper func(resp *Resp) *Out) *dto.PaginatedResponse[Out] {
+	data := make([]Out, len(result.Data))
+	for i := range result.Data {
+		data[i] = *mapper(&result.Data[i])
+	}
+
+	return &dto.PaginatedResponse[Out]{
+		Data:           data,
+		Total:          result.Total,
+		Page:           result.Page,
+		PageSize:       result.PageSize,
+		TotalPages:     result.TotalPages,
+		HasNext:        result.HasNext,
+		TotalEstimated: result.TotalEstimated,
+	}
+}
@@ -0,0 +1,104 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale é o idioma utilizado quando nenhum outro é negociado
+const DefaultLocale = "pt-BR"
+
+// localeKey chave do idioma no context.Context
+type localeKey struct{}
+
+// WithLocale retorna um contexto com o idioma informado
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext retorna o idioma armazenado no contexto ou DefaultLocale
+func LocaleFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// languageRange representa um idioma do cabeçalho Accept-Language com seu peso
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// Negotiate resolve o cabeçalho Accept-Language contra os idiomas suportados
+// Tenta correspondência exata (ex: pt-BR) e depois pelo idioma base (ex: pt-PT → pt-BR, en-US → en)
+// Retorna fallback quando nenhum idioma aceito é suportado
+func Negotiate(header string, supported []string, fallback string) string {
+	for _, r := range parseAcceptLanguage(header) {
+		if r.tag == "*" {
+			return fallback
+		}
+
+		// Correspondência exata
+		for _, s := range supported {
+			if strings.EqualFold(r.tag, s) {
+				return s
+			}
+		}
+
+		// Correspondência pelo idioma base
+		base := baseLanguage(r.tag)
+		for _, s := range supported {
+			if strings.EqualFold(base, baseLanguage(s)) {
+				return s
+			}
+		}
+	}
+	return fallback
+}
+
+// parseAcceptLanguage interpreta o cabeçalho ordenando os idiomas pelo peso (q)
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		tag := part
+		quality := 1.0
+		if idx := strings.Index(part, ";"); idx >= 0 {
+			tag = strings.TrimSpace(part[:idx])
+			param := strings.TrimSpace(part[idx+1:])
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		if tag == "" || quality <= 0 {
+			continue
+		}
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
+
+// baseLanguage retorna o idioma base de uma tag (ex: pt-BR → pt)
+func baseLanguage(tag string) string {
+	if idx := strings.IndexAny(tag, "-_"); idx >= 0 {
+		return tag[:idx]
+	}
+	return tag
+}
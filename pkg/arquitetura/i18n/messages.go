@@ -0,0 +1,349 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Params valores interpolados nas mensagens (placeholders no formato {nome})
+type Params map[string]interface{}
+
+// Chaves das mensagens da arquitetura base
+// Entidades são traduzidas pela chave "entity.<nome em minúsculas>" (ver Entity)
+const (
+	MsgNotFound           = "error.not_found"
+	MsgNotFoundInTrash    = "error.not_found_in_trash"
+	MsgGone               = "error.gone"
+	MsgForbidden          = "error.forbidden"
+	MsgHistoryUnavailable = "error.history_unavailable"
+	MsgVersionNotFound    = "error.version_not_found"
+	MsgInvalidBody        = "error.invalid_body"
+	MsgInvalidBodyArray   = "error.invalid_body_array"
+	MsgValidation         = "error.validation"
+	MsgInvalidID          = "error.invalid_id"
+	MsgInvalidIDParam     = "error.invalid_id_param"
+	MsgInvalidUUIDParam   = "error.invalid_uuid_param"
+	MsgInvalidCursor      = "error.invalid_cursor"
+	MsgInvalidFilter      = "error.invalid_filter"
+	MsgFilterNotAllowed   = "error.filter_not_allowed"
+	MsgSortNotAllowed     = "error.sort_not_allowed"
+	MsgFieldNotAllowed    = "error.field_not_allowed"
+	MsgMaskNotAllowed     = "error.mask_field_not_allowed"
+	MsgInvalidValues      = "error.invalid_parameter_values"
+	MsgInvalidDate        = "error.invalid_parameter_date"
+	MsgInvalidVersionRef  = "error.invalid_parameter_version"
+	MsgInvalidRangeOrder  = "error.invalid_range_order"
+	MsgRequiredParameter  = "error.required_parameter"
+	MsgBulkKeyRequired    = "error.bulk_key_required"
+	MsgBulkUnsupportedKey = "error.bulk_unsupported_key"
+	MsgBulkEmpty          = "error.bulk_empty"
+	MsgBulkTooLarge       = "error.bulk_too_large"
+	MsgBulkDuplicateKey   = "error.bulk_duplicate_key"
+	MsgBulkConvert        = "error.bulk_convert"
+	MsgBulkUpdateNoValues = "error.bulk_update_no_values"
+	MsgImportFormat       = "error.import_unsupported_format"
+	MsgImportInvalidFile  = "error.import_invalid_file"
+	MsgImportEmpty        = "error.import_empty"
+	MsgImportTooLarge     = "error.import_too_large"
+	MsgImportInvalidValue = "error.import_invalid_value"
+	MsgImportDuplicateKey = "error.import_duplicate_key"
+	MsgTimeout            = "error.timeout"
+	MsgInternal           = "error.internal"
+	MsgRouteNotFound      = "error.route_not_found"
+	MsgMethodNotAllowed   = "error.method_not_allowed"
+	MsgUnsupportedVersion = "error.unsupported_version"
+	MsgUnauthorized       = "error.unauthorized"
+	MsgAdminDisabled      = "error.admin_disabled"
+	MsgBodyTooLarge       = "error.body_too_large"
+	MsgBodyTooLargeLimit  = "error.body_too_large_limit"
+	MsgDatabaseDown       = "error.database_unavailable"
+	MsgCommitFailed       = "error.commit_failed"
+	MsgMaintenance        = "error.maintenance"
+	MsgIdempotencyInvalid = "error.idempotency_invalid"
+	MsgIdempotencyReused  = "error.idempotency_mismatch"
+	MsgIdempotencyPending = "error.idempotency_pending"
+	MsgDuplicateField     = "error.duplicate_field"
+	MsgHasRelations       = "error.has_relations"
+	MsgForeignKeyMissing  = "error.foreign_key_missing"
+	MsgConcurrentUpdate   = "error.concurrent_update"
+	MsgStaleUpdate        = "error.stale_update"
+	MsgMappingField       = "error.mapping_field"
+	MsgMappingFailed      = "error.mapping_failed"
+	MsgDeleted            = "success.deleted"
+	MsgDeletedPermanently = "success.deleted_permanently"
+)
+
+// catalog mensagens registradas por idioma
+var (
+	catalog      = map[string]map[string]string{}
+	catalogMutex sync.RWMutex
+)
+
+func init() {
+	RegisterMessages("pt-BR", map[string]string{
+		MsgNotFound:           "{entity} não encontrado(a)",
+		MsgNotFoundInTrash:    "{entity} não encontrado(a) na lixeira",
+		MsgGone:               "{entity} foi excluído(a) e está na lixeira; restaure com POST /{id}/restaurar",
+		MsgForbidden:          "Operação não permitida em {entity}",
+		MsgHistoryUnavailable: "Histórico não disponível para {entity}",
+		MsgVersionNotFound:    "Versão não encontrada no histórico de {entity}",
+		MsgInvalidBody:        "Erro ao processar requisição",
+		MsgInvalidBodyArray:   "Erro ao processar requisição (esperado um array JSON)",
+		MsgValidation:         "Erro de validação",
+		MsgInvalidID:          "ID inválido",
+		MsgInvalidIDParam:     "O parâmetro {param} deve ser um número inteiro positivo com até {max} dígitos",
+		MsgInvalidUUIDParam:   "O parâmetro {param} deve ser um UUID válido",
+		MsgInvalidCursor:      "Cursor de paginação inválido",
+		MsgInvalidFilter:      "Valor inválido para o filtro {param}: {value}",
+		MsgFilterNotAllowed:   "Filtro não permitido: {param}",
+		MsgSortNotAllowed:     "Campo de ordenação não permitido: {field}",
+		MsgFieldNotAllowed:    "Campo não disponível em fields: {field}",
+		MsgMaskNotAllowed:     "Campo não alterável em update_mask: {field}",
+		MsgInvalidValues:      "Parâmetro {param} inválido (valores: {values})",
+		MsgInvalidDate:        "Parâmetro {param} inválido (use RFC3339, ex: 2024-01-31T10:00:00Z, ou AAAA-MM-DD)",
+		MsgInvalidVersionRef:  "Parâmetro {param} inválido (use o ID da versão, RFC3339 ou AAAA-MM-DD)",
+		MsgInvalidRangeOrder:  "Parâmetro {from} deve ser anterior a {to}",
+		MsgRequiredParameter:  "Parâmetro {param} é obrigatório",
+		MsgBulkKeyRequired:    "Parâmetro key é obrigatório (chave natural, ex: key=codigo)",
+		MsgBulkUnsupportedKey: "Chave natural não suportada para {entity}: {key}",
+		MsgBulkEmpty:          "Informe ao menos um item",
+		MsgBulkTooLarge:       "O lote deve ter no máximo {max} itens",
+		MsgBulkDuplicateKey:   "Chave repetida no lote (item {index})",
+		MsgBulkConvert:        "Não foi possível converter o item para atualização",
+		MsgBulkUpdateNoValues: "Informe ao menos um campo para atualizar",
+		MsgImportFormat:       "Formato de arquivo não suportado (use CSV ou XLSX)",
+		MsgImportInvalidFile:  "Não foi possível ler o arquivo",
+		MsgImportEmpty:        "O arquivo não possui linhas para importar (a primeira linha deve ser o cabeçalho)",
+		MsgImportTooLarge:     "O arquivo deve ter no máximo {max} linhas",
+		MsgImportInvalidValue: "Valor inválido: {value}",
+		MsgImportDuplicateKey: "Chave repetida no arquivo (linha {line})",
+		MsgTimeout:            "Tempo limite da requisição excedido",
+		MsgInternal:           "Erro interno do servidor",
+		MsgRouteNotFound:      "Rota não encontrada: {method} {path}",
+		MsgMethodNotAllowed:   "Método {method} não permitido em {path}",
+		MsgUnsupportedVersion: "Versão da API não suportada: {version} (disponíveis: {versions})",
+		MsgUnauthorized:       "Não autorizado",
+		MsgAdminDisabled:      "Área administrativa desabilitada",
+		MsgBodyTooLarge:       "O corpo da requisição excede o tamanho máximo permitido",
+		MsgBodyTooLargeLimit:  "O corpo da requisição excede o tamanho máximo permitido ({limit})",
+		MsgDatabaseDown:       "Banco de dados indisponível",
+		MsgCommitFailed:       "Não foi possível confirmar a operação",
+		MsgMaintenance:        "API em manutenção. Tente novamente em instantes.",
+		MsgIdempotencyInvalid: "Idempotency-Key inválida",
+		MsgIdempotencyReused:  "Idempotency-Key já utilizada com outro payload",
+		MsgIdempotencyPending: "Requisição com esta Idempotency-Key ainda está em processamento",
+		MsgDuplicateField:     "Já existe um registro com este valor de {field}",
+		MsgHasRelations:       "Existem registros relacionados em {table} que impedem a operação",
+		MsgForeignKeyMissing:  "O registro referenciado por {field} não existe",
+		MsgConcurrentUpdate:   "O registro foi alterado por outra operação ao mesmo tempo; tente novamente",
+		MsgStaleUpdate:        "{entity} foi alterado(a) após a versão informada; recarregue e tente novamente",
+		MsgMappingField:       "Valor inválido para {field}: {detail}",
+		MsgMappingFailed:      "Não foi possível converter os dados da requisição",
+		MsgDeleted:            "{entity} excluído(a) com sucesso",
+		MsgDeletedPermanently: "{entity} excluído(a) definitivamente",
+	})
+	RegisterMessages("en", map[string]string{
+		MsgNotFound:           "{entity} not found",
+		MsgNotFoundInTrash:    "{entity} not found in trash",
+		MsgGone:               "{entity} was deleted and is in the trash; restore it with POST /{id}/restaurar",
+		MsgForbidden:          "Operation not allowed on {entity}",
+		MsgHistoryUnavailable: "History not available for {entity}",
+		MsgVersionNotFound:    "Version not found in {entity} history",
+		MsgInvalidBody:        "Error processing request",
+		MsgInvalidBodyArray:   "Error processing request (expected a JSON array)",
+		MsgValidation:         "Validation error",
+		MsgInvalidID:          "Invalid ID",
+		MsgInvalidIDParam:     "The {param} parameter must be a positive integer with up to {max} digits",
+		MsgInvalidUUIDParam:   "The {param} parameter must be a valid UUID",
+		MsgInvalidCursor:      "Invalid pagination cursor",
+		MsgInvalidFilter:      "Invalid value for filter {param}: {value}",
+		MsgFilterNotAllowed:   "Filter not allowed: {param}",
+		MsgSortNotAllowed:     "Sort field not allowed: {field}",
+		MsgFieldNotAllowed:    "Field not available in fields: {field}",
+		MsgMaskNotAllowed:     "Field not updatable in update_mask: {field}",
+		MsgInvalidValues:      "Invalid {param} parameter (values: {values})",
+		MsgInvalidDate:        "Invalid {param} parameter (use RFC3339, e.g. 2024-01-31T10:00:00Z, or YYYY-MM-DD)",
+		MsgInvalidVersionRef:  "Invalid {param} parameter (use the version ID, RFC3339 or YYYY-MM-DD)",
+		MsgInvalidRangeOrder:  "Parameter {from} must be before {to}",
+		MsgRequiredParameter:  "Parameter {param} is required",
+		MsgBulkKeyRequired:    "Parameter key is required (natural key, e.g. key=codigo)",
+		MsgBulkUnsupportedKey: "Unsupported natural key for {entity}: {key}",
+		MsgBulkEmpty:          "Provide at least one item",
+		MsgBulkTooLarge:       "The batch must have at most {max} items",
+		MsgBulkDuplicateKey:   "Duplicate key in batch (item {index})",
+		MsgBulkConvert:        "Could not convert the item for update",
+		MsgBulkUpdateNoValues: "Provide at least one field to update",
+		MsgImportFormat:       "Unsupported file format (use CSV or XLSX)",
+		MsgImportInvalidFile:  "Could not read the file",
+		MsgImportEmpty:        "The file has no rows to import (the first row must be the header)",
+		MsgImportTooLarge:     "The file must have at most {max} rows",
+		MsgImportInvalidValue: "Invalid value: {value}",
+		MsgImportDuplicateKey: "Duplicate key in the file (row {line})",
+		MsgTimeout:            "Request timeout exceeded",
+		MsgInternal:           "Internal server error",
+		MsgRouteNotFound:      "Route not found: {method} {path}",
+		MsgMethodNotAllowed:   "Method {method} not allowed on {path}",
+		MsgUnsupportedVersion: "Unsupported API version: {version} (available: {versions})",
+		MsgUnauthorized:       "Unauthorized",
+		MsgAdminDisabled:      "Administration area disabled",
+		MsgBodyTooLarge:       "The request body exceeds the maximum allowed size",
+		MsgBodyTooLargeLimit:  "The request body exceeds the maximum allowed size ({limit})",
+		MsgDatabaseDown:       "Database unavailable",
+		MsgCommitFailed:       "The operation could not be committed",
+		MsgMaintenance:        "API under maintenance. Please try again shortly.",
+		MsgIdempotencyInvalid: "Invalid Idempotency-Key",
+		MsgIdempotencyReused:  "Idempotency-Key already used with a different payload",
+		MsgIdempotencyPending: "A request with this Idempotency-Key is still being processed",
+		MsgDuplicateField:     "A record with this {field} value already exists",
+		MsgHasRelations:       "Related records in {table} prevent this operation",
+		MsgForeignKeyMissing:  "The record referenced by {field} does not exist",
+		MsgConcurrentUpdate:   "The record was changed by another operation at the same time; please try again",
+		MsgStaleUpdate:        "{entity} was changed after the given version; reload it and try again",
+		MsgMappingField:       "Invalid value for {field}: {detail}",
+		MsgMappingFailed:      "Could not convert the request data",
+		MsgDeleted:            "{entity} deleted successfully",
+		MsgDeletedPermanently: "{entity} permanently deleted",
+	})
+	RegisterMessages("es", map[string]string{
+		MsgNotFound:           "{entity} no encontrado(a)",
+		MsgNotFoundInTrash:    "{entity} no encontrado(a) en la papelera",
+		MsgGone:               "{entity} fue eliminado(a) y está en la papelera; restáurelo con POST /{id}/restaurar",
+		MsgForbidden:          "Operación no permitida en {entity}",
+		MsgHistoryUnavailable: "Historial no disponible para {entity}",
+		MsgVersionNotFound:    "Versión no encontrada en el historial de {entity}",
+		MsgInvalidBody:        "Error al procesar la solicitud",
+		MsgInvalidBodyArray:   "Error al procesar la solicitud (se esperaba un array JSON)",
+		MsgValidation:         "Error de validación",
+		MsgInvalidID:          "ID inválido",
+		MsgInvalidIDParam:     "El parámetro {param} debe ser un número entero positivo de hasta {max} dígitos",
+		MsgInvalidUUIDParam:   "El parámetro {param} debe ser un UUID válido",
+		MsgInvalidCursor:      "Cursor de paginación inválido",
+		MsgInvalidFilter:      "Valor inválido para el filtro {param}: {value}",
+		MsgFilterNotAllowed:   "Filtro no permitido: {param}",
+		MsgSortNotAllowed:     "Campo de ordenación no permitido: {field}",
+		MsgFieldNotAllowed:    "Campo no disponible en fields: {field}",
+		MsgMaskNotAllowed:     "Campo no modificable en update_mask: {field}",
+		MsgInvalidValues:      "Parámetro {param} inválido (valores: {values})",
+		MsgInvalidDate:        "Parámetro {param} inválido (use RFC3339, ej: 2024-01-31T10:00:00Z, o AAAA-MM-DD)",
+		MsgInvalidVersionRef:  "Parámetro {param} inválido (use el ID de la versión, RFC3339 o AAAA-MM-DD)",
+		MsgInvalidRangeOrder:  "El parámetro {from} debe ser anterior a {to}",
+		MsgRequiredParameter:  "El parámetro {param} es obligatorio",
+		MsgBulkKeyRequired:    "El parámetro key es obligatorio (clave natural, ej: key=codigo)",
+		MsgBulkUnsupportedKey: "Clave natural no soportada para {entity}: {key}",
+		MsgBulkEmpty:          "Informe al menos un ítem",
+		MsgBulkTooLarge:       "El lote debe tener como máximo {max} ítems",
+		MsgBulkDuplicateKey:   "Clave repetida en el lote (ítem {index})",
+		MsgBulkConvert:        "No fue posible convertir el ítem para actualización",
+		MsgBulkUpdateNoValues: "Informe al menos un campo para actualizar",
+		MsgImportFormat:       "Formato de archivo no soportado (use CSV o XLSX)",
+		MsgImportInvalidFile:  "No fue posible leer el archivo",
+		MsgImportEmpty:        "El archivo no tiene filas para importar (la primera fila debe ser el encabezado)",
+		MsgImportTooLarge:     "El archivo debe tener como máximo {max} filas",
+		MsgImportInvalidValue: "Valor inválido: {value}",
+		MsgImportDuplicateKey: "Clave repetida en el archivo (fila {line})",
+		MsgTimeout:            "Tiempo límite de la solicitud excedido",
+		MsgInternal:           "Error interno del servidor",
+		MsgRouteNotFound:      "Ruta no encontrada: {method} {path}",
+		MsgMethodNotAllowed:   "Método {method} no permitido en {path}",
+		MsgUnsupportedVersion: "Versión de la API no soportada: {version} (disponibles: {versions})",
+		MsgUnauthorized:       "No autorizado",
+		MsgAdminDisabled:      "Área administrativa deshabilitada",
+		MsgBodyTooLarge:       "El cuerpo de la solicitud excede el tamaño máximo permitido",
+		MsgBodyTooLargeLimit:  "El cuerpo de la solicitud excede el tamaño máximo permitido ({limit})",
+		MsgDatabaseDown:       "Base de datos no disponible",
+		MsgCommitFailed:       "No fue posible confirmar la operación",
+		MsgMaintenance:        "API en mantenimiento. Inténtelo de nuevo en unos instantes.",
+		MsgIdempotencyInvalid: "Idempotency-Key inválida",
+		MsgIdempotencyReused:  "Idempotency-Key ya utilizada con otro payload",
+		MsgIdempotencyPending: "La solicitud con esta Idempotency-Key aún se está procesando",
+		MsgDuplicateField:     "Ya existe un registro con este valor de {field}",
+		MsgHasRelations:       "Existen registros relacionados en {table} que impiden la operación",
+		MsgForeignKeyMissing:  "El registro referenciado por {field} no existe",
+		MsgConcurrentUpdate:   "El registro fue modificado por otra operación al mismo tiempo; inténtelo de nuevo",
+		MsgStaleUpdate:        "{entity} fue modificado(a) después de la versión informada; recárguelo e inténtelo de nuevo",
+		MsgMappingField:       "Valor inválido para {field}: {detail}",
+		MsgMappingFailed:      "No fue posible convertir los datos de la solicitud",
+		MsgDeleted:            "{entity} eliminado(a) con éxito",
+		MsgDeletedPermanently: "{entity} eliminado(a) definitivamente",
+	})
+}
+
+// RegisterMessages adiciona (ou sobrescreve) mensagens de um idioma no catálogo
+// Usado pelos módulos da aplicação para registrar mensagens e nomes de entidades próprios
+func RegisterMessages(locale string, messages map[string]string) {
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+
+	if catalog[locale] == nil {
+		catalog[locale] = make(map[string]string, len(messages))
+	}
+	for key, message := range messages {
+		catalog[locale][key] = message
+	}
+}
+
+// Translate retorna a mensagem da chave no idioma informado, com os parâmetros interpolados
+// Sem tradução no idioma (nem no idioma base, ex: en-US → en), usa DefaultLocale; sem mensagem, retorna a chave
+func Translate(locale, key string, params Params) string {
+	message, ok := lookup(locale, key)
+	if !ok {
+		message, ok = lookup(DefaultLocale, key)
+	}
+	if !ok {
+		message = key
+	}
+	return interpolate(message, params)
+}
+
+// T traduz a mensagem para o idioma da requisição (ver WithLocale)
+func T(ctx context.Context, key string, params Params) string {
+	return Translate(LocaleFromContext(ctx), key, params)
+}
+
+// Entity retorna o nome da entidade traduzido para o idioma da requisição
+// A chave é "entity.<nome em minúsculas>"; sem tradução, retorna o próprio nome
+func Entity(ctx context.Context, name string) string {
+	key := "entity." + strings.ToLower(name)
+	if message, ok := lookup(LocaleFromContext(ctx), key); ok {
+		return message
+	}
+	if message, ok := lookup(DefaultLocale, key); ok {
+		return message
+	}
+	return name
+}
+
+// lookup busca a mensagem pelo idioma exato e, em seguida, pelo idioma base
+func lookup(locale, key string) (string, bool) {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	if message, ok := catalog[locale][key]; ok {
+		return message, true
+	}
+
+	base := baseLanguage(locale)
+	for registered, messages := range catalog {
+		if strings.EqualFold(baseLanguage(registered), base) {
+			if message, ok := messages[key]; ok {
+				return message, true
+			}
+		}
+	}
+	return "", false
+}
+
+// interpolate substitui os placeholders {nome} pelos parâmetros
+func interpolate(message string, params Params) string {
+	if len(params) == 0 {
+		return message
+	}
+
+	replacements := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(message)
+}
@@ -0,0 +1,77 @@
+package i18n
+
+import "context"
+
+// MsgValidationInvalid mensagem das tags de validação sem mensagem própria no catálogo
+const MsgValidationInvalid = "validation.invalid"
+
+// ValidationKey retorna a chave da mensagem de uma tag de validação (ex: required → validation.required)
+// As mensagens aceitam os placeholders {field} (campo) e {param} (parâmetro da tag, ex: min=3 → 3)
+func ValidationKey(tag string) string {
+	return "validation." + tag
+}
+
+// CodeKey retorna a chave da mensagem de um código do catálogo de erros (ex: PRODUTO_CODIGO_DUPLICADO →
+// code.PRODUTO_CODIGO_DUPLICADO), usada pelos validadores para responder a mensagem no idioma da requisição
+func CodeKey(code string) string {
+	return "code." + code
+}
+
+// Has indica se a chave possui mensagem no idioma da requisição ou no idioma padrão
+func Has(ctx context.Context, key string) bool {
+	if _, ok := lookup(LocaleFromContext(ctx), key); ok {
+		return true
+	}
+	_, ok := lookup(DefaultLocale, key)
+	return ok
+}
+
+func init() {
+	RegisterMessages("pt-BR", map[string]string{
+		MsgValidationInvalid:         "O campo {field} é inválido",
+		ValidationKey("required"):    "O campo {field} é obrigatório",
+		ValidationKey("min"):         "O campo {field} deve ter no mínimo {param} caracteres",
+		ValidationKey("max"):         "O campo {field} deve ter no máximo {param} caracteres",
+		ValidationKey("gt"):          "O campo {field} deve ser maior que {param}",
+		ValidationKey("gte"):         "O campo {field} deve ser maior ou igual a {param}",
+		ValidationKey("lt"):          "O campo {field} deve ser menor que {param}",
+		ValidationKey("lte"):         "O campo {field} deve ser menor ou igual a {param}",
+		ValidationKey("email"):       "O campo {field} deve ser um email válido",
+		ValidationKey("cpf"):         "O campo {field} deve ser um CPF válido",
+		ValidationKey("cnpj"):        "O campo {field} deve ser um CNPJ válido",
+		ValidationKey("cep"):         "O campo {field} deve ser um CEP válido (00000-000)",
+		ValidationKey("telefone_br"): "O campo {field} deve ser um telefone válido com DDD",
+	})
+
+	RegisterMessages("en", map[string]string{
+		MsgValidationInvalid:         "The field {field} is invalid",
+		ValidationKey("required"):    "The field {field} is required",
+		ValidationKey("min"):         "The field {field} must have at least {param} characters",
+		ValidationKey("max"):         "The field {field} must have at most {param} characters",
+		ValidationKey("gt"):          "The field {field} must be greater than {param}",
+		ValidationKey("gte"):         "The field {field} must be greater than or equal to {param}",
+		ValidationKey("lt"):          "The field {field} must be less than {param}",
+		ValidationKey("lte"):         "The field {field} must be less than or equal to {param}",
+		ValidationKey("email"):       "The field {field} must be a valid email",
+		ValidationKey("cpf"):         "The field {field} must be a valid CPF",
+		ValidationKey("cnpj"):        "The field {field} must be a valid CNPJ",
+		ValidationKey("cep"):         "The field {field} must be a valid CEP (00000-000)",
+		ValidationKey("telefone_br"): "The field {field} must be a valid phone number with area code",
+	})
+
+	RegisterMessages("es", map[string]string{
+		MsgValidationInvalid:         "El campo {field} no es válido",
+		ValidationKey("required"):    "El campo {field} es obligatorio",
+		ValidationKey("min"):         "El campo {field} debe tener como mínimo {param} caracteres",
+		ValidationKey("max"):         "El campo {field} debe tener como máximo {param} caracteres",
+		ValidationKey("gt"):          "El campo {field} debe ser mayor que {param}",
+		ValidationKey("gte"):         "El campo {field} debe ser mayor o igual a {param}",
+		ValidationKey("lt"):          "El campo {field} debe ser menor que {param}",
+		ValidationKey("lte"):         "El campo {field} debe ser menor o igual a {param}",
+		ValidationKey("email"):       "El campo {field} debe ser un email válido",
+		ValidationKey("cpf"):         "El campo {field} debe ser un CPF válido",
+		ValidationKey("cnpj"):        "El campo {field} debe ser un CNPJ válido",
+		ValidationKey("cep"):         "El campo {field} debe ser un CEP válido (00000-000)",
+		ValidationKey("telefone_br"): "El campo {field} debe ser un teléfono válido con código de área",
+	})
+}
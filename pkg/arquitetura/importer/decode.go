@@ -0,0 +1,161 @@
+package importer
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/jsoncodec"
+)
+
+// FieldError célula que não pôde ser convertida para o tipo do campo do request
+type FieldError struct {
+	Field string // Nome JSON do campo (ex: preco)
+	Value string // Valor da célula
+}
+
+// Decode converte a linha no request T, associando as colunas aos campos pelo nome JSON
+// (cabeçalho em snake_case ou camelCase, ex: categoria_id ou categoriaId)
+// Colunas desconhecidas são ignoradas e células vazias mantêm o valor zero (ponteiros nil), para que a
+// validação do request aponte os campos obrigatórios. Números aceitam vírgula decimal (1.234,56) e
+// booleanos aceitam sim/não; datas em RFC3339 ou AAAA-MM-DD. Valores inválidos retornam FieldError
+func Decode[T any](header []string, values []string) (*T, []FieldError) {
+	req := new(T)
+	target := reflect.ValueOf(req).Elem()
+	fields := jsonFields(target.Type())
+
+	var fieldErrors []FieldError
+	for i, name := range header {
+		if i >= len(values) {
+			break
+		}
+		value := strings.TrimSpace(values[i])
+		name = jsoncodec.CamelToSnake(strings.TrimSpace(name))
+		index, ok := fields[name]
+		if !ok || value == "" {
+			continue
+		}
+		if !setValue(target.FieldByIndex(index), value) {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Value: value})
+		}
+	}
+	return req, fieldErrors
+}
+
+// jsonFields indexa os campos exportados do tipo (inclusive os embutidos) pelo nome JSON
+func jsonFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int)
+	var walk func(t reflect.Type, parent []int)
+	walk = func(t reflect.Type, parent []int) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			index := append(append([]int{}, parent...), i)
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				walk(field.Type, index)
+				continue
+			}
+			if !field.IsExported() {
+				continue
+			}
+			tag := field.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "" {
+				name = jsoncodec.CamelToSnake(field.Name)
+			}
+			if _, exists := fields[name]; !exists {
+				fields[name] = index
+			}
+		}
+	}
+	walk(t, nil)
+	return fields
+}
+
+// setValue converte o texto para o tipo do campo; false quando o valor é inválido ou o tipo não é suportado
+func setValue(field reflect.Value, value string) bool {
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(field.Type().Elem())
+		if !setValue(ptr.Elem(), value) {
+			return false
+		}
+		field.Set(ptr)
+		return true
+	}
+
+	if field.Type() == reflect.TypeOf(time.Time{}) {
+		t, ok := parseTime(value)
+		if ok {
+			field.Set(reflect.ValueOf(t))
+		}
+		return ok
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return false
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return false
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, ok := parseDecimal(value, field.Type().Bits())
+		if !ok {
+			return false
+		}
+		field.SetFloat(n)
+	case reflect.Bool:
+		b, ok := parseBool(value)
+		if !ok {
+			return false
+		}
+		field.SetBool(b)
+	default:
+		return false
+	}
+	return true
+}
+
+// parseDecimal converte números com ponto ou vírgula decimal (ex: 99.90, 99,90 e 1.234,56)
+func parseDecimal(value string, bits int) (float64, bool) {
+	if n, err := strconv.ParseFloat(value, bits); err == nil {
+		return n, true
+	}
+	if !strings.Contains(value, ",") {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(strings.ReplaceAll(strings.ReplaceAll(value, ".", ""), ",", "."), bits)
+	return n, err == nil
+}
+
+// parseBool converte true/false, 1/0 e sim/não
+func parseBool(value string) (bool, bool) {
+	switch strings.ToLower(value) {
+	case "sim", "s", "yes", "y":
+		return true, true
+	case "não", "nao", "n", "no":
+		return false, true
+	}
+	b, err := strconv.ParseBool(value)
+	return b, err == nil
+}
+
+// parseTime converte datas em RFC3339 ou AAAA-MM-DD
+func parseTime(value string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+	t, err := time.Parse("2006-01-02", value)
+	return t, err == nil
+}
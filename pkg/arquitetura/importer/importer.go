@@ -0,0 +1,172 @@
+// Package importer lê os arquivos de importação (CSV e XLSX) e converte as linhas nos requests de criação
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Formatos de arquivo aceitos na importação
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// MIMETypeXLSX tipo de conteúdo das planilhas do Excel
+const MIMETypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+var (
+	// ErrUnsupportedFormat indica um arquivo que não é CSV nem XLSX
+	ErrUnsupportedFormat = errors.New("formato de arquivo não suportado")
+
+	// ErrEmpty indica um arquivo sem cabeçalho ou sem linhas de dados
+	ErrEmpty = errors.New("arquivo sem linhas para importar")
+)
+
+// Sheet conteúdo do arquivo: o cabeçalho (primeira linha) e as linhas de dados, sem as linhas vazias
+type Sheet struct {
+	Header []string
+	Rows   []Row
+}
+
+// Row linha de dados do arquivo
+type Row struct {
+	Line   int      // Número da linha no arquivo (o cabeçalho é a linha 1)
+	Values []string // Células na ordem do cabeçalho
+}
+
+// DetectFormat identifica o formato pela extensão do arquivo ou, sem ela, pelo tipo de conteúdo
+func DetectFormat(fileName, contentType string) (string, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	case "":
+		mediaType, _, _ := strings.Cut(contentType, ";")
+		switch strings.TrimSpace(strings.ToLower(mediaType)) {
+		case "text/csv", "application/csv":
+			return FormatCSV, nil
+		case MIMETypeXLSX:
+			return FormatXLSX, nil
+		}
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Read lê o arquivo no formato informado
+// CSV: separador vírgula ou ponto e vírgula (detectado pelo cabeçalho), com ou sem BOM UTF-8
+// XLSX: primeira planilha da pasta de trabalho, com os valores sem a formatação das células
+// Retorna ErrEmpty quando o arquivo não tem cabeçalho ou linhas de dados
+func Read(r io.Reader, format string) (*Sheet, error) {
+	var records [][]string
+	var lines []int
+	var err error
+	switch format {
+	case FormatCSV:
+		records, lines, err = readCSV(r)
+	case FormatXLSX:
+		records, lines, err = readXLSX(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrEmpty
+	}
+
+	sheet := &Sheet{Header: make([]string, len(records[0]))}
+	for i, name := range records[0] {
+		sheet.Header[i] = strings.TrimSpace(name)
+	}
+	for i, record := range records[1:] {
+		if isBlank(record) {
+			continue
+		}
+		values := make([]string, len(sheet.Header))
+		copy(values, record)
+		sheet.Rows = append(sheet.Rows, Row{Line: lines[i+1], Values: values})
+	}
+	if len(sheet.Rows) == 0 {
+		return nil, ErrEmpty
+	}
+	return sheet, nil
+}
+
+// readCSV lê os registros do CSV com o número da linha de cada um (campos entre aspas podem ocupar várias linhas)
+func readCSV(r io.Reader) ([][]string, []int, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\uFEFF"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return records, lines, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+}
+
+// readXLSX lê as linhas da primeira planilha (o número da linha é a posição na planilha)
+func readXLSX(r io.Reader) ([][]string, []int, error) {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil, ErrEmpty
+	}
+	rows, err := file.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Linhas vazias antes do cabeçalho são ignoradas
+	first := 0
+	for first < len(rows) && isBlank(rows[first]) {
+		first++
+	}
+	lines := make([]int, 0, len(rows)-first)
+	for i := first; i < len(rows); i++ {
+		lines = append(lines, i+1)
+	}
+	return rows[first:], lines, nil
+}
+
+// isBlank indica uma linha sem valores
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,57 @@
+package jsoncodec
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	gojson "github.com/goccy/go-json"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// Nomes dos codificadores suportados
+const (
+	Std    = "std"     // encoding/json da biblioteca padrão
+	GoJSON = "go-json" // github.com/goccy/go-json (compatível com encoding/json)
+	Sonic  = "sonic"   // github.com/bytedance/sonic (JIT, mais rápido em amd64/arm64)
+)
+
+// Codec agrupa as funções de serialização usadas pelo Fiber (JSONEncoder/JSONDecoder)
+type Codec struct {
+	Name      string
+	Marshal   utils.JSONMarshal
+	Unmarshal utils.JSONUnmarshal
+}
+
+// codecs codificadores registrados por nome
+var codecs = map[string]Codec{
+	Std:    {Name: Std, Marshal: json.Marshal, Unmarshal: json.Unmarshal},
+	GoJSON: {Name: GoJSON, Marshal: gojson.Marshal, Unmarshal: gojson.Unmarshal},
+	Sonic:  {Name: Sonic, Marshal: sonic.Marshal, Unmarshal: sonic.Unmarshal},
+}
+
+// Get retorna o codificador pelo nome (vazio retorna std)
+func Get(name string) (Codec, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = Std
+	}
+
+	codec, ok := codecs[name]
+	if !ok {
+		return Codec{}, fmt.Errorf("codificador JSON desconhecido: %s (suportados: %s)", name, strings.Join(Names(), ", "))
+	}
+	return codec, nil
+}
+
+// Names retorna os nomes dos codificadores suportados em ordem alfabética
+func Names() []string {
+	names := make([]string, 0, len(codecs))
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
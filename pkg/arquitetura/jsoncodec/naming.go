@@ -0,0 +1,159 @@
+package jsoncodec
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Convenções de nomes dos campos JSON
+const (
+	SnakeCase = "snake_case" // Nomes das tags json dos DTOs (padrão)
+	CamelCase = "camelCase"  // Convertidos na serialização: created_at → createdAt
+)
+
+// WithNaming aplica a convenção de nomes dos campos ao codificador
+// Os DTOs continuam declarando as tags em snake_case: com camelCase as chaves dos objetos são convertidas
+// na serialização das respostas e, no parse dos bodies, convertidas de volta antes do Unmarshal
+// (bodies em snake_case continuam aceitos)
+func WithNaming(codec Codec, naming string) (Codec, error) {
+	switch strings.TrimSpace(naming) {
+	case "", SnakeCase:
+		return codec, nil
+	case CamelCase:
+		marshal, unmarshal := codec.Marshal, codec.Unmarshal
+		return Codec{
+			Name: codec.Name,
+			Marshal: func(v interface{}) ([]byte, error) {
+				data, err := marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				return RenameKeys(data, SnakeToCamel), nil
+			},
+			Unmarshal: func(data []byte, v interface{}) error {
+				return unmarshal(RenameKeys(data, CamelToSnake), v)
+			},
+		}, nil
+	default:
+		return Codec{}, fmt.Errorf("convenção de nomes JSON desconhecida: %s (suportadas: %s, %s)", naming, SnakeCase, CamelCase)
+	}
+}
+
+// RenameKeys reescreve as chaves dos objetos do documento JSON preservando a ordem e o restante do conteúdo
+// Chaves com sequências de escape são mantidas como estão; JSON inválido é devolvido sem alterações
+// para que o erro seja reportado pelo Unmarshal
+func RenameKeys(data []byte, rename func(string) string) []byte {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); {
+		if data[i] != '"' {
+			out = append(out, data[i])
+			i++
+			continue
+		}
+
+		end, escaped := stringEnd(data, i)
+		if end < 0 {
+			return data
+		}
+		if !escaped && isKey(data, end+1) {
+			out = append(out, '"')
+			out = append(out, rename(string(data[i+1:end]))...)
+			out = append(out, '"')
+		} else {
+			out = append(out, data[i:end+1]...)
+		}
+		i = end + 1
+	}
+	return out
+}
+
+// stringEnd retorna a posição das aspas que fecham a string iniciada em start e se ela contém escapes
+func stringEnd(data []byte, start int) (int, bool) {
+	escaped := false
+	for i := start + 1; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			escaped = true
+			i++
+		case '"':
+			return i, escaped
+		}
+	}
+	return -1, escaped
+}
+
+// isKey verifica se a string terminada antes de pos é uma chave (seguida de ':')
+func isKey(data []byte, pos int) bool {
+	for ; pos < len(data); pos++ {
+		switch data[pos] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case ':':
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// SnakeToCamel converte snake_case em camelCase (ex: total_pages → totalPages)
+func SnakeToCamel(s string) string {
+	if !strings.Contains(s, "_") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	upper := false
+	for i, r := range s {
+		switch {
+		case r == '_' && i > 0:
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if upper {
+		b.WriteByte('_')
+	}
+	return b.String()
+}
+
+// CamelToSnake converte camelCase em snake_case (ex: totalPages → total_pages)
+// Siglas são tratadas como uma palavra (ex: publicID → public_id)
+func CamelToSnake(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 4)
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 && (!isUpperAt(s, i-1) || isLowerAfter(s, i)) && s[i-1] != '_' {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isUpperAt verifica se o byte anterior (ASCII) é maiúsculo
+func isUpperAt(s string, i int) bool {
+	return s[i] >= 'A' && s[i] <= 'Z'
+}
+
+// isLowerAfter verifica se a letra após a posição i é minúscula (fim de uma sigla: IDValue → id_value)
+func isLowerAfter(s string, i int) bool {
+	_, size := utf8.DecodeRuneInString(s[i:])
+	if i+size >= len(s) {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(s[i+size:])
+	return unicode.IsLower(next)
+}
@@ -0,0 +1,23 @@
+package logging
+
+// Fields campos estruturados anexados a uma entrada de log
+type Fields map[string]interface{}
+
+// Logger é a interface mínima de log usada pela arquitetura (serviços, handlers, auditoria e relatórios)
+// Permite reutilizar BaseService/BaseHandler com o logger já adotado pela equipe;
+// há adaptadores para logrus (NewLogrus), slog (NewSlog) e zap (NewZap)
+//
+// Os métodos With* retornam um novo Logger com os campos adicionados, sem alterar o original
+type Logger interface {
+	WithField(key string, value interface{}) Logger
+	WithFields(fields Fields) Logger
+	WithError(err error) Logger
+
+	Debug(args ...interface{})
+	Info(args ...interface{})
+	Warn(args ...interface{})
+	Error(args ...interface{})
+}
+
+// ErrorKey nome do campo com o erro anexado por WithError (mesmo nome usado pelo logrus)
+const ErrorKey = "error"
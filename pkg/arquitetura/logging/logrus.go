@@ -0,0 +1,40 @@
+package logging
+
+import "github.com/sirupsen/logrus"
+
+// logrusLogger adapta um logrus.FieldLogger (*logrus.Logger ou *logrus.Entry) para Logger
+type logrusLogger struct {
+	log logrus.FieldLogger
+}
+
+// NewLogrus cria o Logger sobre o logrus
+func NewLogrus(log logrus.FieldLogger) Logger {
+	return &logrusLogger{log: log}
+}
+
+// WithField adiciona um campo à entrada de log
+func (l *logrusLogger) WithField(key string, value interface{}) Logger {
+	return &logrusLogger{log: l.log.WithField(key, value)}
+}
+
+// WithFields adiciona os campos à entrada de log
+func (l *logrusLogger) WithFields(fields Fields) Logger {
+	return &logrusLogger{log: l.log.WithFields(logrus.Fields(fields))}
+}
+
+// WithError adiciona o erro à entrada de log
+func (l *logrusLogger) WithError(err error) Logger {
+	return &logrusLogger{log: l.log.WithError(err)}
+}
+
+// Debug registra a mensagem no nível debug
+func (l *logrusLogger) Debug(args ...interface{}) { l.log.Debug(args...) }
+
+// Info registra a mensagem no nível info
+func (l *logrusLogger) Info(args ...interface{}) { l.log.Info(args...) }
+
+// Warn registra a mensagem no nível warn
+func (l *logrusLogger) Warn(args ...interface{}) { l.log.Warn(args...) }
+
+// Error registra a mensagem no nível error
+func (l *logrusLogger) Error(args ...interface{}) { l.log.Error(args...) }
@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// slogLogger adapta um *slog.Logger (biblioteca padrão) para Logger
+type slogLogger struct {
+	log *slog.Logger
+}
+
+// NewSlog cria o Logger sobre o slog (nil usa slog.Default())
+func NewSlog(log *slog.Logger) Logger {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &slogLogger{log: log}
+}
+
+// WithField adiciona um atributo à entrada de log
+func (l *slogLogger) WithField(key string, value interface{}) Logger {
+	return &slogLogger{log: l.log.With(key, value)}
+}
+
+// WithFields adiciona os atributos à entrada de log
+func (l *slogLogger) WithFields(fields Fields) Logger {
+	args := make([]any, 0, len(fields)*2)
+	for key, value := range fields {
+		args = append(args, key, value)
+	}
+	return &slogLogger{log: l.log.With(args...)}
+}
+
+// WithError adiciona o erro à entrada de log
+func (l *slogLogger) WithError(err error) Logger {
+	return &slogLogger{log: l.log.With(ErrorKey, err)}
+}
+
+// Debug registra a mensagem no nível debug
+func (l *slogLogger) Debug(args ...interface{}) { l.log.Debug(fmt.Sprint(args...)) }
+
+// Info registra a mensagem no nível info
+func (l *slogLogger) Info(args ...interface{}) { l.log.Info(fmt.Sprint(args...)) }
+
+// Warn registra a mensagem no nível warn
+func (l *slogLogger) Warn(args ...interface{}) { l.log.Warn(fmt.Sprint(args...)) }
+
+// Error registra a mensagem no nível error
+func (l *slogLogger) Error(args ...interface{}) { l.log.Error(fmt.Sprint(args...)) }
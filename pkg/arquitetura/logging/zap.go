@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// zapLogger adapta um *zap.Logger para Logger
+type zapLogger struct {
+	log *zap.Logger
+}
+
+// NewZap cria o Logger sobre o zap (nil usa zap.L())
+// O caller registrado é o de quem chamou o Logger, e não o do adaptador
+func NewZap(log *zap.Logger) Logger {
+	if log == nil {
+		log = zap.L()
+	}
+	return &zapLogger{log: log.WithOptions(zap.AddCallerSkip(1))}
+}
+
+// WithField adiciona um campo à entrada de log
+func (l *zapLogger) WithField(key string, value interface{}) Logger {
+	return &zapLogger{log: l.log.With(zap.Any(key, value))}
+}
+
+// WithFields adiciona os campos à entrada de log
+func (l *zapLogger) WithFields(fields Fields) Logger {
+	zapFields := make([]zap.Field, 0, len(fields))
+	for key, value := range fields {
+		zapFields = append(zapFields, zap.Any(key, value))
+	}
+	return &zapLogger{log: l.log.With(zapFields...)}
+}
+
+// WithError adiciona o erro à entrada de log
+func (l *zapLogger) WithError(err error) Logger {
+	return &zapLogger{log: l.log.With(zap.NamedError(ErrorKey, err))}
+}
+
+// Debug registra a mensagem no nível debug
+func (l *zapLogger) Debug(args ...interface{}) { l.log.Debug(fmt.Sprint(args...)) }
+
+// Info registra a mensagem no nível info
+func (l *zapLogger) Info(args ...interface{}) { l.log.Info(fmt.Sprint(args...)) }
+
+// Warn registra a mensagem no nível warn
+func (l *zapLogger) Warn(args ...interface{}) { l.log.Warn(fmt.Sprint(args...)) }
+
+// Error registra a mensagem no nível error
+func (l *zapLogger) Error(args ...interface{}) { l.log.Error(fmt.Sprint(args...)) }
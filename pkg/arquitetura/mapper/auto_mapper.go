@@ -0,0 +1,293 @@
+package mapper
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+)
+
+// AutoMapper implementa dto.Mapper copiando os campos por nome via reflection
+// Indicado para entidades simples; entidades com regras de mapeamento complexas
+// continuam usando mappers escritos manualmente.
+//
+// Regras de correspondência:
+//   - Campos com o mesmo nome são copiados (inclusive os de structs embutidas, como BaseEntity)
+//   - A tag `mapper:"Nome"` no DTO indica o nome do campo correspondente na entidade
+//   - A tag `mapper:"-"` ignora o campo
+//   - time.Time é convertido para string no formato configurado (entity.SetTimeFormat, o mesmo de BaseEntity)
+//   - Tipos com método String() (ex: uuid.UUID) são convertidos para string
+//   - Ponteiros são desreferenciados (ou criados) quando os tipos base coincidem
+//   - Structs e slices de structs aninhados são mapeados recursivamente
+//
+// Em ApplyUpdate apenas os campos não-zero do request são aplicados (nil/""/0 são ignorados),
+// seguindo a semântica dos mappers manuais. Em ApplyPatch (PATCH) os campos da máscara são
+// aplicados mesmo quando zero.
+//
+// Os ajustes de ToEntity e ApplyUpdate podem retornar erro para conversões que falham
+// (ex: arqerrors.NewMappingError("data", err)); o erro é repassado ao serviço.
+//
+// E é o tipo ponteiro da entidade (ex: *models.Categoria)
+type AutoMapper[E entity.Entity, CreateReq any, UpdateReq any, Resp any] struct {
+	toEntityHook    func(req *CreateReq, entity E) error
+	toResponseHook  func(entity E, resp *Resp)
+	applyUpdateHook func(entity E, req *UpdateReq) error
+}
+
+// NewAutoMapper cria um novo mapper automático
+func NewAutoMapper[E entity.Entity, CreateReq any, UpdateReq any, Resp any]() *AutoMapper[E, CreateReq, UpdateReq, Resp] {
+	return &AutoMapper[E, CreateReq, UpdateReq, Resp]{}
+}
+
+// WithToEntity registra um ajuste executado após o mapeamento automático em ToEntity
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) WithToEntity(hook func(req *CreateReq, entity E) error) *AutoMapper[E, CreateReq, UpdateReq, Resp] {
+	m.toEntityHook = hook
+	return m
+}
+
+// WithToResponse registra um ajuste executado após o mapeamento automático em ToResponse
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) WithToResponse(hook func(entity E, resp *Resp)) *AutoMapper[E, CreateReq, UpdateReq, Resp] {
+	m.toResponseHook = hook
+	return m
+}
+
+// WithApplyUpdate registra um ajuste executado após o mapeamento automático em ApplyUpdate
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) WithApplyUpdate(hook func(entity E, req *UpdateReq) error) *AutoMapper[E, CreateReq, UpdateReq, Resp] {
+	m.applyUpdateHook = hook
+	return m
+}
+
+// ToEntity converte um request de criação para a entidade
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) ToEntity(req *CreateReq) (E, error) {
+	var zero E
+	if req == nil {
+		return zero, arqerrors.ErrMapping
+	}
+	t := reflect.TypeOf(zero)
+	entity := reflect.New(t.Elem()).Interface().(E)
+
+	copyFields(reflect.ValueOf(req).Elem(), reflect.ValueOf(entity).Elem(), false)
+
+	if m.toEntityHook != nil {
+		if err := m.toEntityHook(req, entity); err != nil {
+			return zero, err
+		}
+	}
+	return entity, nil
+}
+
+// ToResponse converte uma entidade para response (nil quando a entidade é nil)
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) ToResponse(entity E) *Resp {
+	if isNil(entity) {
+		return nil
+	}
+	resp := new(Resp)
+
+	copyFields(reflect.ValueOf(entity).Elem(), reflect.ValueOf(resp).Elem(), false)
+
+	if m.toResponseHook != nil {
+		m.toResponseHook(entity, resp)
+	}
+	return resp
+}
+
+// ApplyUpdate aplica os campos não-zero do request na entidade
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) ApplyUpdate(entity E, req *UpdateReq) error {
+	if isNil(entity) || req == nil {
+		return arqerrors.ErrMapping
+	}
+	copyFields(reflect.ValueOf(req).Elem(), reflect.ValueOf(entity).Elem(), true)
+
+	if m.applyUpdateHook != nil {
+		return m.applyUpdateHook(entity, req)
+	}
+	return nil
+}
+
+// ApplyPatch aplica na entidade os campos da máscara, inclusive os valores zero (ver ApplyMask)
+// O ajuste de WithApplyUpdate também é executado
+func (m *AutoMapper[E, CreateReq, UpdateReq, Resp]) ApplyPatch(entity E, req *UpdateReq, mask []string) error {
+	if isNil(entity) || req == nil {
+		return arqerrors.ErrMapping
+	}
+	if err := ApplyMask(req, entity, mask); err != nil {
+		return err
+	}
+
+	if m.applyUpdateHook != nil {
+		return m.applyUpdateHook(entity, req)
+	}
+	return nil
+}
+
+// isNil verifica se a entidade é nil (interface nil ou ponteiro nil)
+func isNil(entity interface{}) bool {
+	if entity == nil {
+		return true
+	}
+	v := reflect.ValueOf(entity)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// Map copia os campos correspondentes de src para dst (ambos ponteiros para struct)
+// Útil para mapeamentos auxiliares em mappers manuais; src ou dst nil não copiam nada
+func Map(src interface{}, dst interface{}) {
+	if isNil(src) || isNil(dst) {
+		return
+	}
+	copyFields(reflect.ValueOf(src).Elem(), reflect.ValueOf(dst).Elem(), false)
+}
+
+// fieldInfo descreve um campo (possivelmente de struct embutida) pelo caminho de índices
+type fieldInfo struct {
+	index []int
+	name  string // Nome usado para correspondência
+}
+
+// fieldsCache armazena os campos achatados por tipo
+var fieldsCache sync.Map
+
+var timeType = reflect.TypeOf(time.Time{})
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// structFields retorna os campos exportados do tipo, achatando structs embutidas
+func structFields(t reflect.Type) []fieldInfo {
+	if cached, ok := fieldsCache.Load(t); ok {
+		return cached.([]fieldInfo)
+	}
+
+	var fields []fieldInfo
+	collectFields(t, nil, &fields)
+	fieldsCache.Store(t, fields)
+	return fields
+}
+
+// collectFields percorre os campos do tipo recursivamente
+func collectFields(t reflect.Type, parent []int, fields *[]fieldInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		index := append(append([]int(nil), parent...), i)
+
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Type != timeType {
+			collectFields(f.Type, index, fields)
+			continue
+		}
+
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("mapper"); ok {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+
+		*fields = append(*fields, fieldInfo{index: index, name: name})
+	}
+}
+
+// copyFields copia os campos de src para dst por correspondência de nome
+// onlyNonZero ignora os campos zero de src (semântica de atualização parcial)
+func copyFields(src, dst reflect.Value, onlyNonZero bool) {
+	srcByName := make(map[string]reflect.Value)
+	for _, f := range structFields(src.Type()) {
+		srcByName[f.name] = src.FieldByIndex(f.index)
+	}
+
+	for _, f := range structFields(dst.Type()) {
+		// A tag pode estar em qualquer um dos lados
+		sv, ok := srcByName[f.name]
+		if !ok {
+			sv, ok = srcByName[dst.Type().FieldByIndex(f.index).Name]
+		}
+		if !ok {
+			continue
+		}
+		if onlyNonZero && sv.IsZero() {
+			continue
+		}
+
+		assign(sv, dst.FieldByIndex(f.index))
+	}
+}
+
+// assign atribui sv a dv aplicando as conversões suportadas
+// Retorna false quando os tipos não são compatíveis
+func assign(sv, dv reflect.Value) bool {
+	if !dv.CanSet() {
+		return false
+	}
+
+	st, dt := sv.Type(), dv.Type()
+
+	switch {
+	case st.AssignableTo(dt):
+		dv.Set(sv)
+		return true
+
+	case st == timeType && dt.Kind() == reflect.String:
+		dv.SetString(entity.FormatTime(sv.Interface().(time.Time)))
+		return true
+
+	case st.Kind() == reflect.Ptr && dt.Kind() != reflect.Ptr:
+		if sv.IsNil() {
+			return false
+		}
+		return assign(sv.Elem(), dv)
+
+	case dt.Kind() == reflect.String && st.Implements(stringerType):
+		dv.SetString(sv.Interface().(fmt.Stringer).String())
+		return true
+
+	case st.Kind() != reflect.Ptr && dt.Kind() == reflect.Ptr:
+		// Structs zero (ex: relacionamento não carregado) não geram ponteiro
+		if st.Kind() == reflect.Struct && sv.IsZero() {
+			return false
+		}
+		target := reflect.New(dt.Elem())
+		if !assign(sv, target.Elem()) {
+			return false
+		}
+		dv.Set(target)
+		return true
+
+	case st.Kind() == reflect.Struct && dt.Kind() == reflect.Struct:
+		copyFields(sv, dv, false)
+		return true
+
+	case st.Kind() == reflect.Slice && dt.Kind() == reflect.Slice:
+		if sv.IsNil() {
+			return false
+		}
+		result := reflect.MakeSlice(dt, sv.Len(), sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			assign(sv.Index(i), result.Index(i))
+		}
+		dv.Set(result)
+		return true
+
+	case isConvertibleKind(st.Kind()) && isConvertibleKind(dt.Kind()) && st.ConvertibleTo(dt):
+		dv.Set(sv.Convert(dt))
+		return true
+	}
+
+	return false
+}
+
+// isConvertibleKind indica os tipos básicos que podem ser convertidos entre si
+func isConvertibleKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		return true
+	}
+	return false
+}
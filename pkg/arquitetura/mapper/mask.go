@@ -0,0 +1,95 @@
+package mapper
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+)
+
+// ErrFieldNotUpdatable indica um campo da máscara que não existe no request de atualização
+var ErrFieldNotUpdatable = errors.New("campo inexistente ou não alterável")
+
+// ApplyMask copia de src (request) para dst (entidade) apenas os campos da máscara, inclusive os valores
+// zero: diferente de ApplyUpdate, "" e 0 são aplicados e ponteiros nil limpam o campo (zero ou NULL)
+// Os campos da máscara são os nomes JSON do request (ex: descricao, preco); um campo que não existe no
+// request retorna MappingError com ErrFieldNotUpdatable. Campos sem correspondência na entidade ou de
+// tipos incompatíveis são ignorados, como em ApplyUpdate (tratados pelo ajuste do mapper)
+func ApplyMask(src interface{}, dst interface{}, mask []string) error {
+	if isNil(src) || isNil(dst) {
+		return arqerrors.ErrMapping
+	}
+	sv, dv := reflect.ValueOf(src).Elem(), reflect.ValueOf(dst).Elem()
+
+	srcByJSON := jsonFields(sv.Type())
+	dstByName := make(map[string]reflect.Value)
+	for _, f := range structFields(dv.Type()) {
+		dstByName[f.name] = dv.FieldByIndex(f.index)
+		if goName := dv.Type().FieldByIndex(f.index).Name; goName != f.name {
+			if _, exists := dstByName[goName]; !exists {
+				dstByName[goName] = dv.FieldByIndex(f.index)
+			}
+		}
+	}
+
+	for _, field := range mask {
+		f, ok := srcByJSON[field]
+		if !ok {
+			return arqerrors.NewMappingError(field, ErrFieldNotUpdatable)
+		}
+		target, ok := dstByName[f.name]
+		if !ok {
+			target, ok = dstByName[sv.Type().FieldByIndex(f.index).Name]
+		}
+		if !ok || !target.CanSet() {
+			continue
+		}
+
+		value := sv.FieldByIndex(f.index)
+		if value.Kind() == reflect.Ptr && value.IsNil() {
+			target.Set(reflect.Zero(target.Type()))
+			continue
+		}
+		assign(value, target)
+	}
+	return nil
+}
+
+// MaskFields retorna os nomes JSON dos campos do tipo (ex: request de atualização), na ordem de declaração
+// Útil para validar ou documentar as máscaras aceitas
+func MaskFields(t reflect.Type) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	var names []string
+	for _, f := range structFields(t) {
+		if name := jsonName(t.FieldByIndex(f.index)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jsonFields indexa os campos do tipo pelo nome JSON
+func jsonFields(t reflect.Type) map[string]fieldInfo {
+	fields := make(map[string]fieldInfo)
+	for _, f := range structFields(t) {
+		if name := jsonName(t.FieldByIndex(f.index)); name != "" {
+			fields[name] = f
+		}
+	}
+	return fields
+}
+
+// jsonName retorna o nome JSON do campo (tag json ou nome do campo); vazio quando ignorado (json:"-")
+func jsonName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return field.Name
+}
@@ -0,0 +1,65 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher publica as mensagens no Kafka: o tópico da mensagem é o tópico Kafka e a chave
+// define a partição, mantendo a ordem dos eventos de uma mesma entidade
+type KafkaPublisher struct {
+	brokers []string
+	writer  *kafka.Writer
+}
+
+// NewKafkaPublisher cria o publicador para os brokers informados (host:porta)
+// As escritas aguardam a confirmação de todas as réplicas (acks=all)
+func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("nenhum broker Kafka configurado")
+	}
+	return &KafkaPublisher{
+		brokers: brokers,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}, nil
+}
+
+// Publish grava a mensagem no tópico e aguarda a confirmação
+func (p *KafkaPublisher) Publish(ctx context.Context, message Message) error {
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: message.Topic,
+		Key:   []byte(message.Key),
+		Value: message.Payload,
+		Headers: []kafka.Header{
+			{Key: "message_id", Value: []byte(message.MessageID.String())},
+			{Key: "event_type", Value: []byte(message.EventType)},
+		},
+	})
+}
+
+// Ping verifica a conexão com ao menos um dos brokers (o writer só conecta na primeira publicação)
+func (p *KafkaPublisher) Ping(ctx context.Context) error {
+	var lastErr error
+	for _, broker := range p.brokers {
+		conn, err := kafka.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+		lastErr = err
+	}
+	return fmt.Errorf("nenhum broker Kafka acessível: %w", lastErr)
+}
+
+// Close encerra as conexões com os brokers
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,97 @@
+// Package outbox implementa o padrão transactional outbox: os eventos de integração são gravados na tabela
+// outbox na mesma transação da alteração da entidade e publicados depois no broker (Kafka ou RabbitMQ)
+// pelo Relay, garantindo que nenhum evento se perca nem seja publicado para uma alteração desfeita
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Message representa um evento de integração pendente ou já publicado (tabela outbox)
+type Message struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	MessageID   uuid.UUID  `gorm:"type:uuid;uniqueIndex;not null" json:"message_id"` // Deduplicação nos consumidores (entrega at-least-once)
+	Topic       string     `gorm:"type:varchar(255);not null" json:"topic"`          // Tópico (Kafka) ou routing key (RabbitMQ)
+	Key         string     `gorm:"type:varchar(255)" json:"key"`                     // Chave de particionamento: mantém a ordem por entidade
+	EventType   string     `gorm:"type:varchar(100);not null" json:"event_type"`     // Ex: produto.updated
+	Payload     []byte     `gorm:"type:jsonb;not null" json:"payload"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastError   string     `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at"`
+	PublishedAt *time.Time `gorm:"index" json:"published_at,omitempty"`
+	FailedAt    *time.Time `json:"failed_at,omitempty"` // Desistência após Relay.maxAttempts (exige republicação manual)
+}
+
+// TableName define o nome da tabela no banco de dados
+func (Message) TableName() string {
+	return "outbox"
+}
+
+// Envelope é o conteúdo (payload JSON) publicado no broker
+type Envelope struct {
+	MessageID  uuid.UUID   `json:"message_id"`
+	Type       string      `json:"type"` // Ex: produto.created
+	Entity     string      `json:"entity"`
+	Table      string      `json:"table"`
+	EntityID   uint        `json:"entity_id"`
+	PublicID   uuid.UUID   `json:"public_id"`
+	Actor      string      `json:"actor,omitempty"`
+	RequestID  string      `json:"request_id,omitempty"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data,omitempty"` // Estado da entidade (response da API)
+}
+
+// Outbox grava os eventos de integração na transação do contexto
+type Outbox struct {
+	db *gorm.DB
+}
+
+// New cria o outbox sobre a conexão informada (usada apenas fora de transação)
+func New(db *gorm.DB) *Outbox {
+	return &Outbox{db: db}
+}
+
+// Add grava o evento da entidade no tópico informado. Deve ser chamado na transação da alteração
+// (contexto com transação, ex: UnitOfWork): o evento só existe se a alteração for confirmada
+// O tipo do evento é "<tabela>.<operação>" e a chave é o identificador público da entidade
+func (o *Outbox) Add(ctx context.Context, topic string, meta events.Metadata, data interface{}) error {
+	envelope := Envelope{
+		MessageID:  uuid.New(),
+		Type:       meta.Table + "." + string(meta.Type),
+		Entity:     meta.EntityName,
+		Table:      meta.Table,
+		EntityID:   meta.ID,
+		PublicID:   meta.PublicID,
+		Actor:      meta.Actor,
+		RequestID:  meta.RequestID,
+		OccurredAt: meta.OccurredAt,
+		Data:       data,
+	}
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	message := &Message{
+		MessageID: envelope.MessageID,
+		Topic:     topic,
+		Key:       meta.PublicID.String(),
+		EventType: envelope.Type,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}
+
+	db := o.db
+	if tx, ok := repository.TxFromContext(ctx); ok {
+		db = tx
+	}
+	return db.WithContext(ctx).Create(message).Error
+}
@@ -0,0 +1,119 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// RabbitMQPublisher publica as mensagens em uma exchange do tipo topic do RabbitMQ, com o tópico da
+// mensagem como routing key. As publicações são persistentes e aguardam a confirmação do broker
+// (publisher confirms); a conexão é refeita na publicação seguinte a uma queda
+type RabbitMQPublisher struct {
+	url      string
+	exchange string
+	mutex    sync.Mutex
+	conn     *amqp.Connection
+	channel  *amqp.Channel
+}
+
+// NewRabbitMQPublisher cria o publicador e declara a exchange (durável, tipo topic)
+func NewRabbitMQPublisher(url, exchange string) (*RabbitMQPublisher, error) {
+	if url == "" || exchange == "" {
+		return nil, errors.New("URL e exchange do RabbitMQ são obrigatórias")
+	}
+	p := &RabbitMQPublisher{url: url, exchange: exchange}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err := p.connect(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Publish publica a mensagem e aguarda a confirmação do broker
+func (p *RabbitMQPublisher) Publish(ctx context.Context, message Message) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.channel == nil || p.channel.IsClosed() {
+		if err := p.connect(); err != nil {
+			return err
+		}
+	}
+
+	confirmation, err := p.channel.PublishWithDeferredConfirmWithContext(ctx, p.exchange, message.Topic, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    message.MessageID.String(),
+		Type:         message.EventType,
+		Timestamp:    message.CreatedAt,
+		Headers:      amqp.Table{"key": message.Key},
+		Body:         message.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("mensagem %s rejeitada pelo RabbitMQ", message.MessageID)
+	}
+	return nil
+}
+
+// Ping verifica a conexão com o broker, refazendo-a se tiver caído
+func (p *RabbitMQPublisher) Ping(ctx context.Context) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.channel != nil && !p.channel.IsClosed() {
+		return nil
+	}
+	return p.connect()
+}
+
+// Close encerra o canal e a conexão
+func (p *RabbitMQPublisher) Close() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn, p.channel = nil, nil
+	return err
+}
+
+// connect abre a conexão e o canal em modo de confirmação e declara a exchange (chamado com o mutex)
+func (p *RabbitMQPublisher) connect() error {
+	if p.conn != nil {
+		_ = p.conn.Close()
+		p.conn, p.channel = nil, nil
+	}
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return fmt.Errorf("falha ao conectar ao RabbitMQ: %w", err)
+	}
+	channel, err := conn.Channel()
+	if err == nil {
+		err = channel.Confirm(false)
+	}
+	if err == nil {
+		err = channel.ExchangeDeclare(p.exchange, amqp.ExchangeTopic, true, false, false, false, nil)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("falha ao configurar o canal do RabbitMQ: %w", err)
+	}
+
+	p.conn, p.channel = conn, channel
+	return nil
+}
@@ -0,0 +1,152 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/logging"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// relayLockKey chave do advisory lock do Postgres que garante um único Relay ativo por ciclo
+// (várias instâncias ou processos prefork publicariam fora de ordem)
+const relayLockKey = 7_202_601
+
+// maxErrorLength tamanho máximo do último erro gravado na mensagem
+const maxErrorLength = 1000
+
+// Relay publica no broker as mensagens pendentes do outbox, na ordem de gravação
+// Cada ciclo (RunOnce) publica um lote; a primeira falha interrompe o lote para preservar a ordem e a
+// mensagem é tentada de novo no ciclo seguinte, até maxAttempts, quando é marcada como falha e ignorada.
+// A entrega é at-least-once: uma queda entre a confirmação do broker e o commit republica a mensagem,
+// e os consumidores devem descartar duplicatas pelo message_id
+type Relay struct {
+	db          *gorm.DB
+	publisher   Publisher
+	log         logging.Logger
+	batchSize   int
+	maxAttempts int
+	retention   time.Duration
+}
+
+// NewRelay cria o relay do outbox (lotes de 100 mensagens, 10 tentativas e retenção de 7 dias)
+func NewRelay(db *gorm.DB, publisher Publisher, log logging.Logger) *Relay {
+	return &Relay{
+		db:          db,
+		publisher:   publisher,
+		log:         log,
+		batchSize:   100,
+		maxAttempts: 10,
+		retention:   7 * 24 * time.Hour,
+	}
+}
+
+// WithBatchSize configura a quantidade de mensagens por ciclo (retorna o próprio relay para chaining)
+func (r *Relay) WithBatchSize(size int) *Relay {
+	if size > 0 {
+		r.batchSize = size
+	}
+	return r
+}
+
+// WithMaxAttempts configura as tentativas de publicação de cada mensagem (retorna o próprio relay para chaining)
+func (r *Relay) WithMaxAttempts(attempts int) *Relay {
+	if attempts > 0 {
+		r.maxAttempts = attempts
+	}
+	return r
+}
+
+// WithRetention configura por quanto tempo as mensagens publicadas são mantidas (retorna o próprio relay para chaining)
+func (r *Relay) WithRetention(retention time.Duration) *Relay {
+	r.retention = retention
+	return r
+}
+
+// RunOnce publica um lote de mensagens pendentes. Retorna erro quando uma publicação falhou
+// (a mensagem fica pendente para o próximo ciclo). Sem o lock do relay (outra instância publicando), não faz nada
+func (r *Relay) RunOnce(ctx context.Context) error {
+	var publishErr error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", relayLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		var messages []Message
+		err := tx.Where("published_at IS NULL AND failed_at IS NULL").
+			Order("id").
+			Limit(r.batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&messages).Error
+		if err != nil {
+			return err
+		}
+
+		for i := range messages {
+			message := &messages[i]
+			if publishErr = r.publisher.Publish(ctx, *message); publishErr != nil {
+				return r.recordFailure(tx, message, publishErr)
+			}
+			now := time.Now()
+			if err := tx.Model(message).Updates(map[string]interface{}{
+				"published_at": now,
+				"attempts":     message.Attempts + 1,
+				"last_error":   "",
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if publishErr != nil {
+		return fmt.Errorf("falha ao publicar mensagem do outbox: %w", publishErr)
+	}
+	return nil
+}
+
+// Purge remove as mensagens publicadas há mais tempo que a retenção (zero mantém todas)
+func (r *Relay) Purge(ctx context.Context) error {
+	if r.retention <= 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Where("published_at < ?", time.Now().Add(-r.retention)).
+		Delete(&Message{}).Error
+}
+
+// recordFailure registra a falha na mensagem e, esgotadas as tentativas, desiste dela
+func (r *Relay) recordFailure(tx *gorm.DB, message *Message, cause error) error {
+	attempts := message.Attempts + 1
+	lastError := cause.Error()
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+
+	updates := map[string]interface{}{
+		"attempts":   attempts,
+		"last_error": lastError,
+	}
+	fields := logging.Fields{
+		"message_id": message.MessageID,
+		"event_type": message.EventType,
+		"topic":      message.Topic,
+		"attempts":   attempts,
+	}
+	if attempts >= r.maxAttempts {
+		updates["failed_at"] = time.Now()
+		r.log.WithError(cause).WithFields(fields).Error("Mensagem do outbox descartada após esgotar as tentativas")
+	} else {
+		r.log.WithError(cause).WithFields(fields).Warn("Falha ao publicar mensagem do outbox")
+	}
+	return tx.Model(message).Updates(updates).Error
+}
@@ -0,0 +1,37 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+
+	"api_fibergorm/pkg/arquitetura/export"
+)
+
+// WriteCSV escreve o resultado em CSV (cabeçalho com os títulos das colunas)
+// O BOM UTF-8 inicial faz o Excel reconhecer a acentuação
+func WriteCSV(w io.Writer, result *Result) error {
+	if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(result.Columns))
+	for i, column := range result.Columns {
+		record[i] = column.Title
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	for _, row := range result.Rows {
+		for i, column := range result.Columns {
+			record[i] = export.FormatValue(row[column.Key])
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
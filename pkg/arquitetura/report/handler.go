@@ -0,0 +1,326 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	arqhandler "api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Formatos de saída dos relatórios
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Response resposta JSON de um relatório
+// @Description Resultado de um relatório
+type Response struct {
+	Report      string    `json:"report" example:"produtos-por-categoria"`
+	Params      Params    `json:"params" swaggertype:"object"`
+	GeneratedAt time.Time `json:"generated_at"`
+	*Result
+}
+
+// Handler expõe os relatórios do registro via HTTP (execução síncrona ou assíncrona)
+type Handler struct {
+	registry *Registry
+	runner   *Runner
+	log      logging.Logger
+}
+
+// NewHandler cria o handler dos relatórios
+func NewHandler(registry *Registry, runner *Runner, log logging.Logger) *Handler {
+	return &Handler{
+		registry: registry,
+		runner:   runner,
+		log:      log,
+	}
+}
+
+// RegisterRoutes registra as rotas dos relatórios
+// As rotas de gerações (/jobs) são registradas antes de /:nome para não serem tratadas como relatório
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Get("/", h.List)
+	router.Get("/jobs/:id", h.JobStatus)
+	router.Get("/jobs/:id/resultado", h.JobResult)
+	router.Get("/:nome", h.Run)
+	router.Post("/:nome/async", h.StartJob)
+}
+
+// List godoc
+// @Summary Listar relatórios
+// @Description Retorna os relatórios disponíveis e seus parâmetros
+// @Tags Relatórios
+// @Produce json
+// @Success 200 {array} report.Info
+// @Router /api/v1/relatorios [get]
+func (h *Handler) List(c *fiber.Ctx) error {
+	return c.JSON(h.registry.List())
+}
+
+// Run godoc
+// @Summary Gerar relatório
+// @Description Executa o relatório e retorna o resultado em JSON ou CSV (format=csv ou Accept: text/csv)
+// @Description Os demais parâmetros da query string são os parâmetros do relatório (ver GET /api/v1/relatorios)
+// @Tags Relatórios
+// @Produce json,text/csv
+// @Param nome path string true "Nome do relatório"
+// @Param format query string false "Formato de saída (json ou csv)"
+// @Success 200 {object} report.Response
+// @Failure 400 {object} arqdto.ErrorResponse
+// @Failure 404 {object} arqdto.ErrorResponse
+// @Failure 500 {object} arqdto.ErrorResponse
+// @Router /api/v1/relatorios/{nome} [get]
+func (h *Handler) Run(c *fiber.Ctx) error {
+	definition, ok := h.registry.Find(c.Params("nome"))
+	if !ok {
+		return h.reportNotFound(c)
+	}
+
+	params, format, err := h.parseRequest(c, definition)
+	if err != nil {
+		return err
+	}
+
+	result, err := definition.Run(c.UserContext(), params)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	return h.render(c, definition.Name, params, time.Now(), result, format)
+}
+
+// StartJob godoc
+// @Summary Gerar relatório em segundo plano
+// @Description Agenda a geração do relatório (indicado para relatórios grandes) e responde 202 com a geração;
+// @Description o header Location aponta para o status e o resultado fica em /jobs/{id}/resultado
+// @Tags Relatórios
+// @Produce json
+// @Param nome path string true "Nome do relatório"
+// @Success 202 {object} report.Job
+// @Failure 400 {object} arqdto.ErrorResponse
+// @Failure 404 {object} arqdto.ErrorResponse
+// @Router /api/v1/relatorios/{nome}/async [post]
+func (h *Handler) StartJob(c *fiber.Ctx) error {
+	definition, ok := h.registry.Find(c.Params("nome"))
+	if !ok {
+		return h.reportNotFound(c)
+	}
+
+	params, _, err := h.parseRequest(c, definition)
+	if err != nil {
+		return err
+	}
+
+	job := h.runner.Start(c.UserContext(), definition, params)
+	h.log.WithFields(logging.Fields{
+		"report":     definition.Name,
+		"job_id":     job.ID,
+		"request_id": c.Locals("requestid"),
+	}).Info("Geração assíncrona de relatório agendada")
+
+	base := strings.TrimSuffix(c.Path(), "/"+definition.Name+"/async")
+	c.Location(base + "/jobs/" + job.ID)
+	return c.Status(fiber.StatusAccepted).JSON(job)
+}
+
+// JobStatus godoc
+// @Summary Status da geração de relatório
+// @Description Retorna o estado da geração assíncrona (pending, running, done ou failed)
+// @Tags Relatórios
+// @Produce json
+// @Param id path string true "ID da geração"
+// @Success 200 {object} report.Job
+// @Failure 404 {object} arqdto.ErrorResponse
+// @Router /api/v1/relatorios/jobs/{id} [get]
+func (h *Handler) JobStatus(c *fiber.Ctx) error {
+	job, _, ok := h.runner.Get(c.Params("id"))
+	if !ok {
+		return h.jobNotFound(c)
+	}
+	return c.JSON(job)
+}
+
+// JobResult godoc
+// @Summary Resultado da geração de relatório
+// @Description Retorna o resultado da geração assíncrona em JSON ou CSV; 409 enquanto não concluída ou em caso de falha
+// @Tags Relatórios
+// @Produce json,text/csv
+// @Param id path string true "ID da geração"
+// @Param format query string false "Formato de saída (json ou csv)"
+// @Success 200 {object} report.Response
+// @Failure 404 {object} arqdto.ErrorResponse
+// @Failure 409 {object} arqdto.ErrorResponse
+// @Router /api/v1/relatorios/jobs/{id}/resultado [get]
+func (h *Handler) JobResult(c *fiber.Ctx) error {
+	format, err := parseFormat(c)
+	if err != nil {
+		return err
+	}
+
+	job, result, ok := h.runner.Get(c.Params("id"))
+	if !ok {
+		return h.jobNotFound(c)
+	}
+
+	switch job.Status {
+	case JobDone:
+		return h.render(c, job.Report, job.Params, *job.FinishedAt, result, format)
+	case JobFailed:
+		return arqhandler.SendError(c, fiber.StatusConflict, dto.ErrorResponse{
+			Code:  CodeReportNotReady,
+			Error: arqhandler.Message(c, MsgReportFailed, i18n.Params{"error": job.Error}),
+		})
+	default:
+		c.Set(fiber.HeaderRetryAfter, "5")
+		return arqhandler.SendError(c, fiber.StatusConflict, dto.ErrorResponse{
+			Code:  CodeReportNotReady,
+			Error: arqhandler.Message(c, MsgReportNotReady, i18n.Params{"status": job.Status}),
+		})
+	}
+}
+
+// parseRequest valida o formato e os parâmetros do relatório informados na requisição
+func (h *Handler) parseRequest(c *fiber.Ctx, definition Definition) (Params, string, error) {
+	format, err := parseFormat(c)
+	if err != nil {
+		return nil, "", err
+	}
+
+	params, err := parseParams(c, definition.Params)
+	if err != nil {
+		return nil, "", err
+	}
+	return params, format, nil
+}
+
+// parseFormat lê o formato de saída (format=json|csv ou Accept: text/csv)
+func parseFormat(c *fiber.Ctx) (string, error) {
+	switch format := strings.ToLower(c.Query("format")); format {
+	case FormatJSON, FormatCSV:
+		return format, nil
+	case "":
+		if c.Accepts(fiber.MIMEApplicationJSON, "text/csv") == "text/csv" {
+			return FormatCSV, nil
+		}
+		return FormatJSON, nil
+	default:
+		return "", fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, MsgInvalidFormat, nil))
+	}
+}
+
+// parseParams valida e converte os parâmetros do relatório informados na query string
+func parseParams(c *fiber.Ctx, specs []ParamSpec) (Params, error) {
+	params := make(Params, len(specs))
+
+	for _, spec := range specs {
+		value := c.Query(spec.Name)
+		if value == "" {
+			if spec.Required {
+				return nil, fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgRequiredParameter, i18n.Params{"param": spec.Name}))
+			}
+			continue
+		}
+
+		switch spec.Type {
+		case ParamNumber:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, MsgInvalidNumber, i18n.Params{"param": spec.Name}))
+			}
+			params[spec.Name] = number
+		case ParamID:
+			id, err := strconv.ParseUint(value, 10, 32)
+			if err != nil || id == 0 {
+				return nil, fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidIDParam, i18n.Params{"param": spec.Name, "max": arqhandler.MaxIDLength}))
+			}
+			params[spec.Name] = uint(id)
+		case ParamDate, ParamDateEnd:
+			t, dateOnly, ok := arqhandler.ParseDateParam(value)
+			if !ok {
+				return nil, fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidDate, i18n.Params{"param": spec.Name}))
+			}
+			if dateOnly && spec.Type == ParamDateEnd {
+				t = t.Add(24*time.Hour - time.Nanosecond)
+			}
+			params[spec.Name] = t
+		default:
+			if len(spec.Values) > 0 && !contains(spec.Values, value) {
+				return nil, fiber.NewError(fiber.StatusBadRequest, arqhandler.Message(c, i18n.MsgInvalidValues, i18n.Params{"param": spec.Name, "values": strings.Join(spec.Values, ", ")}))
+			}
+			params[spec.Name] = value
+		}
+	}
+
+	return params, nil
+}
+
+// render escreve o resultado no formato solicitado
+func (h *Handler) render(c *fiber.Ctx, name string, params Params, generatedAt time.Time, result *Result, format string) error {
+	if format != FormatCSV {
+		return c.JSON(Response{
+			Report:      name,
+			Params:      params,
+			GeneratedAt: generatedAt,
+			Result:      result,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, result); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Attachment(name + "-" + generatedAt.Format("20060102-150405") + ".csv")
+	return c.Send(buf.Bytes())
+}
+
+// handleError responde os erros da execução do relatório
+func (h *Handler) handleError(c *fiber.Ctx, err error) error {
+	if businessErr, ok := arqerrors.GetBusinessError(err); ok {
+		return arqhandler.SendBusinessError(c, businessErr)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	h.log.WithError(err).WithField("path", c.Path()).Error("Erro ao gerar relatório")
+	return err
+}
+
+// reportNotFound responde 404 para relatórios não registrados
+func (h *Handler) reportNotFound(c *fiber.Ctx) error {
+	return arqhandler.SendError(c, fiber.StatusNotFound, dto.ErrorResponse{
+		Code:  arqerrors.CodeNotFound,
+		Error: arqhandler.Message(c, MsgReportNotFound, i18n.Params{"name": c.Params("nome")}),
+	})
+}
+
+// jobNotFound responde 404 para gerações inexistentes ou expiradas
+func (h *Handler) jobNotFound(c *fiber.Ctx) error {
+	return arqhandler.SendError(c, fiber.StatusNotFound, dto.ErrorResponse{
+		Code:  arqerrors.CodeNotFound,
+		Error: arqhandler.Message(c, MsgReportJobNotFound, i18n.Params{"id": c.Params("id")}),
+	})
+}
+
+// contains indica se o valor está na lista
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+)
+
+// Códigos de erro dos relatórios
+const (
+	CodeReportNotReady = "REPORT_NOT_READY"
+)
+
+// Chaves das mensagens dos relatórios
+const (
+	MsgReportNotFound    = "report.not_found"
+	MsgReportJobNotFound = "report.job_not_found"
+	MsgReportNotReady    = "report.not_ready"
+	MsgReportFailed      = "report.failed"
+	MsgInvalidNumber     = "report.invalid_number"
+	MsgInvalidFormat     = "report.invalid_format"
+)
+
+func init() {
+	arqerrors.RegisterCode(CodeReportNotReady, http.StatusConflict, "Relatório assíncrono ainda em geração ou com falha (ver status da geração)")
+
+	i18n.RegisterMessages("pt-BR", map[string]string{
+		MsgReportNotFound:    "Relatório {name} não encontrado",
+		MsgReportJobNotFound: "Geração de relatório {id} não encontrada ou expirada",
+		MsgReportNotReady:    "Relatório ainda não disponível (status: {status})",
+		MsgReportFailed:      "Falha na geração do relatório: {error}",
+		MsgInvalidNumber:     "Parâmetro {param} deve ser numérico",
+		MsgInvalidFormat:     "Parâmetro format inválido (valores: json, csv)",
+	})
+	i18n.RegisterMessages("en", map[string]string{
+		MsgReportNotFound:    "Report {name} not found",
+		MsgReportJobNotFound: "Report generation {id} not found or expired",
+		MsgReportNotReady:    "Report not available yet (status: {status})",
+		MsgReportFailed:      "Report generation failed: {error}",
+		MsgInvalidNumber:     "Parameter {param} must be numeric",
+		MsgInvalidFormat:     "Invalid format parameter (values: json, csv)",
+	})
+	i18n.RegisterMessages("es", map[string]string{
+		MsgReportNotFound:    "Informe {name} no encontrado",
+		MsgReportJobNotFound: "Generación de informe {id} no encontrada o expirada",
+		MsgReportNotReady:    "Informe aún no disponible (estado: {status})",
+		MsgReportFailed:      "Error en la generación del informe: {error}",
+		MsgInvalidNumber:     "El parámetro {param} debe ser numérico",
+		MsgInvalidFormat:     "Parámetro format inválido (valores: json, csv)",
+	})
+}
+
+// ParamType tipo de um parâmetro de relatório (validado antes da execução)
+type ParamType string
+
+const (
+	ParamString  ParamType = "string"
+	ParamNumber  ParamType = "number"
+	ParamID      ParamType = "id"
+	ParamDate    ParamType = "date"     // RFC3339 ou AAAA-MM-DD (início do dia)
+	ParamDateEnd ParamType = "date_end" // RFC3339 ou AAAA-MM-DD (uma data sem horário inclui o dia inteiro)
+)
+
+// ParamSpec descreve um parâmetro aceito pelo relatório (query string)
+// Values restringe os valores aceitos em parâmetros do tipo string
+type ParamSpec struct {
+	Name        string    `json:"name" example:"preco_min"`
+	Type        ParamType `json:"type" example:"number"`
+	Required    bool      `json:"required" example:"false"`
+	Values      []string  `json:"values,omitempty"`
+	Description string    `json:"description" example:"Preço mínimo dos produtos considerados"`
+}
+
+// Params valores dos parâmetros já validados e convertidos conforme o ParamSpec
+// (string, float64, uint ou time.Time)
+type Params map[string]interface{}
+
+// String retorna o valor de um parâmetro de texto (vazio se não informado)
+func (p Params) String(name string) string {
+	value, _ := p[name].(string)
+	return value
+}
+
+// Number retorna o valor de um parâmetro numérico
+func (p Params) Number(name string) (float64, bool) {
+	value, ok := p[name].(float64)
+	return value, ok
+}
+
+// ID retorna o valor de um parâmetro de ID
+func (p Params) ID(name string) (uint, bool) {
+	value, ok := p[name].(uint)
+	return value, ok
+}
+
+// Time retorna o valor de um parâmetro de data
+func (p Params) Time(name string) (time.Time, bool) {
+	value, ok := p[name].(time.Time)
+	return value, ok
+}
+
+// Column coluna do resultado (Key nas linhas JSON, Title no cabeçalho do CSV)
+type Column struct {
+	Key   string `json:"key" example:"preco_medio"`
+	Title string `json:"title" example:"Preço médio"`
+}
+
+// Result resultado tabular de um relatório
+type Result struct {
+	Columns []Column                 `json:"columns"`
+	Rows    []map[string]interface{} `json:"rows"`
+}
+
+// AddRow adiciona uma linha com os valores na ordem das colunas
+func (r *Result) AddRow(values ...interface{}) {
+	row := make(map[string]interface{}, len(r.Columns))
+	for i, column := range r.Columns {
+		if i < len(values) {
+			row[column.Key] = values[i]
+		}
+	}
+	r.Rows = append(r.Rows, row)
+}
+
+// NewResult cria um resultado vazio com as colunas informadas
+func NewResult(columns ...Column) *Result {
+	return &Result{Columns: columns, Rows: []map[string]interface{}{}}
+}
+
+// Definition define um relatório parametrizado
+// Run recebe os parâmetros já validados; erros de negócio (ex: arqerrors.NewBusinessError)
+// são respondidos com o status do catálogo de códigos
+type Definition struct {
+	Name        string
+	Description string
+	Params      []ParamSpec
+	Run         func(ctx context.Context, params Params) (*Result, error)
+}
+
+// Info descreve um relatório disponível (listagem)
+// @Description Relatório disponível e seus parâmetros
+type Info struct {
+	Name        string      `json:"name" example:"produtos-por-categoria"`
+	Description string      `json:"description" example:"Quantidade e preços dos produtos por categoria"`
+	Params      []ParamSpec `json:"params"`
+}
+
+// Registry mantém os relatórios disponíveis
+type Registry struct {
+	definitions map[string]Definition
+	mutex       sync.RWMutex
+}
+
+// NewRegistry cria um registro de relatórios vazio
+func NewRegistry() *Registry {
+	return &Registry{definitions: make(map[string]Definition)}
+}
+
+// Register adiciona (ou substitui) um relatório
+func (r *Registry) Register(definition Definition) {
+	if definition.Name == "" || definition.Run == nil {
+		panic(fmt.Sprintf("relatório inválido: nome e Run são obrigatórios (%q)", definition.Name))
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.definitions[definition.Name] = definition
+}
+
+// Find retorna o relatório pelo nome
+func (r *Registry) Find(name string) (Definition, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	definition, ok := r.definitions[name]
+	return definition, ok
+}
+
+// List retorna os relatórios disponíveis ordenados pelo nome
+func (r *Registry) List() []Info {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	infos := make([]Info, 0, len(r.definitions))
+	for _, definition := range r.definitions {
+		infos = append(infos, Info{
+			Name:        definition.Name,
+			Description: definition.Description,
+			Params:      definition.Params,
+		})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
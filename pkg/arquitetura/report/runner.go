@@ -0,0 +1,218 @@
+package report
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// JobStatus estado de uma geração assíncrona
+type JobStatus string
+
+const (
+	JobPending JobStatus = "pending"
+	JobRunning JobStatus = "running"
+	JobDone    JobStatus = "done"
+	JobFailed  JobStatus = "failed"
+)
+
+// Job representa a geração assíncrona de um relatório
+// @Description Geração assíncrona de relatório
+type Job struct {
+	ID         string     `json:"id" example:"4f1c2a9e0b7d4e3f8a6b5c4d3e2f1a0b"`
+	Report     string     `json:"report" example:"produtos-por-categoria"`
+	Params     Params     `json:"params" swaggertype:"object"`
+	Status     JobStatus  `json:"status" example:"done"`
+	Error      string     `json:"error,omitempty"`
+	Rows       int        `json:"rows" example:"12"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	result *Result
+}
+
+// RunnerConfig configurações das gerações assíncronas
+type RunnerConfig struct {
+	MaxConcurrent int           // Gerações simultâneas (padrão: 2); as demais aguardam como pending
+	Timeout       time.Duration // Prazo de cada geração (padrão: 10min)
+	Retention     time.Duration // Tempo em que o resultado fica disponível após a conclusão (padrão: 1h)
+}
+
+// Runner executa relatórios em segundo plano e guarda os resultados em memória até expirarem
+// Em modo prefork cada processo possui suas próprias gerações (consulte o mesmo processo ou use prefork desabilitado)
+type Runner struct {
+	config RunnerConfig
+	jobs   map[string]*Job
+	slots  chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mutex  sync.RWMutex
+	log    logging.Logger
+}
+
+// NewRunner cria um executor de relatórios assíncronos
+func NewRunner(config RunnerConfig, log logging.Logger) *Runner {
+	if config.MaxConcurrent <= 0 {
+		config.MaxConcurrent = 2
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Minute
+	}
+	if config.Retention <= 0 {
+		config.Retention = time.Hour
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Runner{
+		config: config,
+		jobs:   make(map[string]*Job),
+		slots:  make(chan struct{}, config.MaxConcurrent),
+		ctx:    ctx,
+		cancel: cancel,
+		log:    log,
+	}
+}
+
+// Start agenda a geração do relatório e retorna imediatamente
+// Os valores do contexto (idioma, usuário) são preservados; o cancelamento da requisição não interrompe a geração
+func (r *Runner) Start(ctx context.Context, definition Definition, params Params) *Job {
+	job := &Job{
+		ID:        newJobID(),
+		Report:    definition.Name,
+		Params:    params,
+		Status:    JobPending,
+		CreatedAt: time.Now(),
+	}
+
+	r.mutex.Lock()
+	r.evictExpired()
+	r.jobs[job.ID] = job
+	snapshot := r.snapshot(job)
+	r.mutex.Unlock()
+
+	r.wg.Add(1)
+	go r.run(context.WithoutCancel(ctx), definition, job)
+
+	return snapshot
+}
+
+// run aguarda uma vaga e executa a geração
+func (r *Runner) run(ctx context.Context, definition Definition, job *Job) {
+	defer r.wg.Done()
+
+	select {
+	case r.slots <- struct{}{}:
+		defer func() { <-r.slots }()
+	case <-r.ctx.Done():
+		r.finish(job, nil, r.ctx.Err())
+		return
+	}
+
+	started := time.Now()
+	r.mutex.Lock()
+	job.Status = JobRunning
+	job.StartedAt = &started
+	r.mutex.Unlock()
+
+	// Encerra a geração no prazo configurado ou no encerramento da aplicação
+	ctx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+	defer cancel()
+	stop := context.AfterFunc(r.ctx, cancel)
+	defer stop()
+
+	result, err := definition.Run(ctx, job.Params)
+	r.finish(job, result, err)
+
+	fields := logging.Fields{
+		"report":   job.Report,
+		"job_id":   job.ID,
+		"duration": time.Since(started).String(),
+	}
+	if err != nil {
+		r.log.WithError(err).WithFields(fields).Error("Falha na geração assíncrona do relatório")
+		return
+	}
+	r.log.WithFields(fields).WithField("rows", job.Rows).Info("Relatório assíncrono gerado")
+}
+
+// finish registra o resultado (ou o erro) da geração
+func (r *Runner) finish(job *Job, result *Result, err error) {
+	finished := time.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	job.FinishedAt = &finished
+	if err != nil {
+		job.Status = JobFailed
+		job.Error = err.Error()
+		return
+	}
+	job.Status = JobDone
+	job.result = result
+	job.Rows = len(result.Rows)
+}
+
+// Get retorna uma cópia do estado da geração e o resultado (nil enquanto não concluída)
+func (r *Runner) Get(id string) (*Job, *Result, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	job, ok := r.jobs[id]
+	if !ok || r.expired(job) {
+		return nil, nil, false
+	}
+	return r.snapshot(job), job.result, true
+}
+
+// Close cancela as gerações em andamento e aguarda seu término dentro do prazo do contexto
+func (r *Runner) Close(ctx context.Context) error {
+	r.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// snapshot copia o estado da geração (deve ser chamado com o mutex bloqueado)
+func (r *Runner) snapshot(job *Job) *Job {
+	clone := *job
+	clone.result = nil
+	return &clone
+}
+
+// expired indica se o resultado da geração já expirou
+func (r *Runner) expired(job *Job) bool {
+	return job.FinishedAt != nil && time.Since(*job.FinishedAt) > r.config.Retention
+}
+
+// evictExpired remove as gerações expiradas (deve ser chamado com o mutex bloqueado)
+func (r *Runner) evictExpired() {
+	for id, job := range r.jobs {
+		if r.expired(job) {
+			delete(r.jobs, id)
+		}
+	}
+}
+
+// newJobID gera um identificador aleatório para a geração
+func newJobID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// NumericStats resume os valores de uma coluna numérica (registros excluídos logicamente são ignorados)
+type NumericStats struct {
+	Count int64   `json:"count"`
+	Sum   float64 `json:"sum"`
+	Avg   float64 `json:"avg"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+// AggregateFunc função de agregação SQL aplicada a uma coluna numérica
+type AggregateFunc string
+
+const (
+	AggregateSum AggregateFunc = "SUM"
+	AggregateAvg AggregateFunc = "AVG"
+	AggregateMin AggregateFunc = "MIN"
+	AggregateMax AggregateFunc = "MAX"
+)
+
+// DayCount representa a quantidade de registros de um dia
+type DayCount struct {
+	Day   time.Time
+	Total int64
+}
+
+// StatsWhere calcula quantidade, soma, média, mínimo e máximo de uma coluna numérica
+// condition é opcional (nil considera todos os registros); sem registros, os valores são zero
+// Ex: produtoRepo.StatsWhere("preco", "categoria_id = ?", 1)
+func (r *BaseRepositoryImpl[E]) StatsWhere(column string, condition interface{}, args ...interface{}) (NumericStats, error) {
+	var stats NumericStats
+
+	field, err := r.lookupField(column)
+	if err != nil {
+		return stats, err
+	}
+
+	query := r.db.Model(r.newEntity()).
+		Select("COUNT(" + field.DBName + ") AS count, " +
+			"COALESCE(SUM(" + field.DBName + "), 0) AS sum, " +
+			"COALESCE(AVG(" + field.DBName + "), 0) AS avg, " +
+			"COALESCE(MIN(" + field.DBName + "), 0) AS min, " +
+			"COALESCE(MAX(" + field.DBName + "), 0) AS max")
+	if condition != nil {
+		query = query.Where(condition, args...)
+	}
+
+	err = query.Scan(&stats).Error
+	return stats, err
+}
+
+// SumWhere retorna a soma de uma coluna numérica (zero sem registros)
+// condition é opcional (nil considera todos os registros)
+// Ex: produtoRepo.SumWhere("preco", "categoria_id = ?", 1)
+func (r *BaseRepositoryImpl[E]) SumWhere(column string, condition interface{}, args ...interface{}) (float64, error) {
+	return r.aggregateWhere(AggregateSum, column, condition, args...)
+}
+
+// AvgWhere retorna a média de uma coluna numérica (zero sem registros)
+func (r *BaseRepositoryImpl[E]) AvgWhere(column string, condition interface{}, args ...interface{}) (float64, error) {
+	return r.aggregateWhere(AggregateAvg, column, condition, args...)
+}
+
+// MinWhere retorna o menor valor de uma coluna numérica (zero sem registros)
+func (r *BaseRepositoryImpl[E]) MinWhere(column string, condition interface{}, args ...interface{}) (float64, error) {
+	return r.aggregateWhere(AggregateMin, column, condition, args...)
+}
+
+// MaxWhere retorna o maior valor de uma coluna numérica (zero sem registros)
+func (r *BaseRepositoryImpl[E]) MaxWhere(column string, condition interface{}, args ...interface{}) (float64, error) {
+	return r.aggregateWhere(AggregateMax, column, condition, args...)
+}
+
+// aggregateWhere aplica a função de agregação à coluna, validada contra o modelo
+func (r *BaseRepositoryImpl[E]) aggregateWhere(fn AggregateFunc, column string, condition interface{}, args ...interface{}) (float64, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return 0, err
+	}
+
+	query := r.db.Model(r.newEntity()).
+		Select("COALESCE(" + string(fn) + "(" + field.DBName + "), 0)")
+	if condition != nil {
+		query = query.Where(condition, args...)
+	}
+
+	var result float64
+	err = query.Scan(&result).Error
+	return result, err
+}
+
+// CountByDay retorna a quantidade de registros por dia da coluna de data informada, a partir de since
+// Dias sem registros não aparecem no resultado
+// Ex: repo.CountByDay("created_at", time.Now().AddDate(0, 0, -30))
+func (r *BaseRepositoryImpl[E]) CountByDay(column string, since time.Time) ([]DayCount, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []DayCount
+	err = r.db.Model(r.newEntity()).
+		Select("DATE("+field.DBName+") AS day, COUNT(*) AS total").
+		Where(field.DBName+" >= ?", since).
+		Group("DATE(" + field.DBName + ")").
+		Order("day").
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GroupByCount retorna a quantidade de registros para cada valor da coluna (GROUP BY column)
+// K é o tipo da coluna (ex: uint para chaves estrangeiras, bool para flags)
+// Função genérica porque métodos em Go não possuem parâmetros de tipo próprios
+// Ex: repository.GroupByCount[uint](produtoRepo.BaseRepositoryImpl, "categoria_id")
+func GroupByCount[K comparable, E entity.Entity](r *BaseRepositoryImpl[E], column string) (map[K]int64, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Value K
+		Total int64
+	}
+	err = r.db.Model(r.newEntity()).
+		Select(field.DBName + " AS value, COUNT(*) AS total").
+		Group(field.DBName).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[K]int64, len(rows))
+	for _, row := range rows {
+		result[row.Value] = row.Total
+	}
+	return result, nil
+}
+
+// GroupByAggregate aplica a função de agregação à coluna para cada valor de groupColumn (GROUP BY groupColumn)
+// K é o tipo da coluna de agrupamento, como em GroupByCount
+// Ex: média de preço por categoria
+// repository.GroupByAggregate[uint](produtoRepo.BaseRepositoryImpl, "categoria_id", repository.AggregateAvg, "preco")
+func GroupByAggregate[K comparable, E entity.Entity](r *BaseRepositoryImpl[E], groupColumn string, fn AggregateFunc, column string) (map[K]float64, error) {
+	group, err := r.lookupField(groupColumn)
+	if err != nil {
+		return nil, err
+	}
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Value K
+		Total float64
+	}
+	err = r.db.Model(r.newEntity()).
+		Select(group.DBName + " AS value, COALESCE(" + string(fn) + "(" + field.DBName + "), 0) AS total").
+		Group(group.DBName).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[K]float64, len(rows))
+	for _, row := range rows {
+		result[row.Value] = row.Total
+	}
+	return result, nil
+}
+
+// DistinctValues retorna os valores distintos e não nulos da coluna, em ordem crescente (SELECT DISTINCT)
+// T é o tipo da coluna (ex: string para códigos, uint para chaves estrangeiras); condition é opcional
+// Útil para alimentar listas de opções dos filtros
+// Ex: repository.DistinctValues[uint](produtoRepo.BaseRepositoryImpl, "categoria_id", nil)
+func DistinctValues[T any, E entity.Entity](r *BaseRepositoryImpl[E], column string, condition interface{}, args ...interface{}) ([]T, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.Model(r.newEntity()).
+		Distinct(field.DBName).
+		Where(field.DBName + " IS NOT NULL")
+	if condition != nil {
+		query = query.Where(condition, args...)
+	}
+
+	values := make([]T, 0)
+	err = query.Order(field.DBName).Pluck(field.DBName, &values).Error
+	return values, err
+}
@@ -2,20 +2,56 @@ package repository
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"sort"
 
 	"api_fibergorm/pkg/arquitetura/entity"
 	arqerrors "api_fibergorm/pkg/arquitetura/errors"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
 )
 
+// Options contém as opções de comportamento dos repositórios
+type Options struct {
+	ParallelCount bool // Executa o COUNT e a busca da página concorrentemente nas listagens
+
+	// SkipDefaultTransaction evita a transação implícita do GORM nas escritas de um único comando
+	// (Create, Update, Delete). Fluxos com múltiplas escritas devem usar transações explícitas
+	SkipDefaultTransaction bool
+
+	// Retry repete as escritas fora de transação e as transações das unidades de trabalho
+	// (UnitOfWork) e da requisição que falham por conflito de concorrência (serialização/deadlock);
+	// zero não repete. Ver RetryPolicy
+	Retry RetryPolicy
+}
+
+// defaultOptions opções aplicadas a todos os repositórios criados com NewBaseRepository
+var defaultOptions = Options{}
+
+// SetDefaultOptions define as opções padrão dos repositórios (chamado na inicialização da aplicação)
+func SetDefaultOptions(options Options) {
+	defaultOptions = options
+}
+
+// DefaultOptions retorna as opções padrão dos repositórios (ex: a política de repetição das transações)
+func DefaultOptions() Options {
+	return defaultOptions
+}
+
 // BaseRepositoryImpl é a implementação base do repositório genérico
 // E é o tipo ponteiro da entidade que implementa entity.Entity (ex: *models.Categoria)
 type BaseRepositoryImpl[E entity.Entity] struct {
 	db           *gorm.DB
 	preloads     []string
 	defaultOrder string
+	options      Options
+	columns      []string                  // Colunas das listagens (WithSelect); vazio = todas
+	scopes       map[string]Scope          // Escopos registrados (WithScope)
+	applied      []func(*gorm.DB) *gorm.DB // Escopos aplicados a esta cópia (Scoped)
 }
 
 // NewBaseRepository cria uma nova instância do repositório base
@@ -24,6 +60,7 @@ func NewBaseRepository[E entity.Entity](db *gorm.DB) *BaseRepositoryImpl[E] {
 		db:           db,
 		preloads:     []string{},
 		defaultOrder: "id ASC",
+		options:      defaultOptions,
 	}
 }
 
@@ -39,6 +76,46 @@ func (r *BaseRepositoryImpl[E]) WithDefaultOrder(order string) *BaseRepositoryIm
 	return r
 }
 
+// WithParallelCount habilita/desabilita a contagem concorrente nas listagens deste repositório
+func (r *BaseRepositoryImpl[E]) WithParallelCount(enabled bool) *BaseRepositoryImpl[E] {
+	r.options.ParallelCount = enabled
+	return r
+}
+
+// WithSkipDefaultTransaction habilita/desabilita a transação implícita nas escritas deste repositório
+func (r *BaseRepositoryImpl[E]) WithSkipDefaultTransaction(skip bool) *BaseRepositoryImpl[E] {
+	r.options.SkipDefaultTransaction = skip
+	return r
+}
+
+// WithSession retorna uma cópia do repositório usando uma sessão GORM com as opções informadas
+// Permite ajustar o comportamento por chamada sem alterar o repositório original
+// Ex: repo.WithSession(&gorm.Session{SkipDefaultTransaction: false}).Create(entity)
+func (r *BaseRepositoryImpl[E]) WithSession(session *gorm.Session) *BaseRepositoryImpl[E] {
+	clone := *r
+	clone.db = r.db.Session(session)
+	clone.options.SkipDefaultTransaction = session.SkipDefaultTransaction
+	return &clone
+}
+
+// WithPrimary retorna uma cópia do repositório cujas leituras são feitas no banco primário, mesmo com
+// réplicas de leitura configuradas (DB_REPLICA_HOSTS). Usado para ler o que acabou de ser gravado fora de
+// uma transação, sem depender do atraso de replicação. Transações já usam sempre o primário
+// Ex: repo.WithPrimary().WithContext(ctx).FindByID(id)
+func (r *BaseRepositoryImpl[E]) WithPrimary() *BaseRepositoryImpl[E] {
+	clone := *r
+	clone.db = r.db.Clauses(dbresolver.Write).Session(&gorm.Session{})
+	return &clone
+}
+
+// writeDB retorna a conexão usada nas escritas, respeitando SkipDefaultTransaction
+func (r *BaseRepositoryImpl[E]) writeDB() *gorm.DB {
+	if r.options.SkipDefaultTransaction {
+		return r.db.Session(&gorm.Session{SkipDefaultTransaction: true})
+	}
+	return r.db
+}
+
 // GetDB retorna a instância do banco de dados
 func (r *BaseRepositoryImpl[E]) GetDB() *gorm.DB {
 	return r.db
@@ -55,9 +132,16 @@ func (r *BaseRepositoryImpl[E]) newEntity() E {
 	return zero
 }
 
+// TableName retorna o nome da tabela da entidade
+func (r *BaseRepositoryImpl[E]) TableName() string {
+	return r.newEntity().TableName()
+}
+
 // Create insere uma nova entidade no banco de dados
 func (r *BaseRepositoryImpl[E]) Create(entity E) error {
-	return r.db.Create(entity).Error
+	return r.retryWrite(func() error {
+		return r.translateError(r.writeDB().Create(entity).Error)
+	})
 }
 
 // FindByID busca uma entidade pelo ID
@@ -80,6 +164,11 @@ func (r *BaseRepositoryImpl[E]) FindByID(id uint) (E, error) {
 	return entity, nil
 }
 
+// FindByPublicID busca uma entidade pelo identificador público (UUID)
+func (r *BaseRepositoryImpl[E]) FindByPublicID(publicID uuid.UUID) (E, error) {
+	return r.FindOneWhere("public_id = ?", publicID)
+}
+
 // FindByIDWithPreloads busca uma entidade pelo ID com preloads específicos
 func (r *BaseRepositoryImpl[E]) FindByIDWithPreloads(id uint, preloads ...string) (E, error) {
 	entity := r.newEntity()
@@ -101,94 +190,44 @@ func (r *BaseRepositoryImpl[E]) FindByIDWithPreloads(id uint, preloads ...string
 
 // FindAll retorna todas as entidades com paginação
 func (r *BaseRepositoryImpl[E]) FindAll(page, pageSize int, orderBy string) ([]E, int64, error) {
-	var entities []E
-	var total int64
-
-	// Conta o total de registros
-	if err := r.db.Model(r.newEntity()).Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Calcula o offset
-	offset := (page - 1) * pageSize
-
-	// Define a ordenação
-	order := orderBy
-	if order == "" {
-		order = r.defaultOrder
-	}
-
-	// Aplica preloads se configurados
-	query := r.db
-	for _, preload := range r.preloads {
-		query = query.Preload(preload)
-	}
-
-	// Busca com paginação
-	err := query.Offset(offset).Limit(pageSize).Order(order).Find(&entities).Error
+	result, err := r.findPage(r.db, false, page, pageSize, orderBy, r.preloads, CountExact)
 	if err != nil {
 		return nil, 0, err
 	}
-
-	return entities, total, nil
+	return result.Items, result.Total, nil
 }
 
 // FindAllWithPreloads retorna todas as entidades com preloads específicos
 func (r *BaseRepositoryImpl[E]) FindAllWithPreloads(page, pageSize int, orderBy string, preloads ...string) ([]E, int64, error) {
-	var entities []E
-	var total int64
-
-	if err := r.db.Model(r.newEntity()).Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	offset := (page - 1) * pageSize
-
-	order := orderBy
-	if order == "" {
-		order = r.defaultOrder
-	}
-
-	query := r.db
-	for _, preload := range preloads {
-		query = query.Preload(preload)
-	}
-
-	err := query.Offset(offset).Limit(pageSize).Order(order).Find(&entities).Error
+	result, err := r.findPage(r.db, false, page, pageSize, orderBy, preloads, CountExact)
 	if err != nil {
 		return nil, 0, err
 	}
-
-	return entities, total, nil
+	return result.Items, result.Total, nil
 }
 
 // FindAllWhere busca entidades com condições
 func (r *BaseRepositoryImpl[E]) FindAllWhere(page, pageSize int, orderBy string, condition interface{}, args ...interface{}) ([]E, int64, error) {
-	var entities []E
-	var total int64
-
-	if err := r.db.Model(r.newEntity()).Where(condition, args...).Count(&total).Error; err != nil {
+	result, err := r.findPage(r.db.Where(condition, args...), true, page, pageSize, orderBy, r.preloads, CountExact)
+	if err != nil {
 		return nil, 0, err
 	}
+	return result.Items, result.Total, nil
+}
 
-	offset := (page - 1) * pageSize
-
-	order := orderBy
-	if order == "" {
-		order = r.defaultOrder
-	}
-
-	query := r.db.Where(condition, args...)
+// FindInBatches percorre todas as entidades em lotes, ordenadas pela chave primária
+// Mantém o uso de memória constante em exportações, independentemente do volume de dados
+// Retornar erro em fn interrompe a iteração
+func (r *BaseRepositoryImpl[E]) FindInBatches(batchSize int, fn func(batch []E) error) error {
+	query := r.db
 	for _, preload := range r.preloads {
 		query = query.Preload(preload)
 	}
 
-	err := query.Offset(offset).Limit(pageSize).Order(order).Find(&entities).Error
-	if err != nil {
-		return nil, 0, err
-	}
-
-	return entities, total, nil
+	var batch []E
+	return query.FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
 }
 
 // FindOneWhere busca uma entidade com condição
@@ -212,14 +251,20 @@ func (r *BaseRepositoryImpl[E]) FindOneWhere(condition interface{}, args ...inte
 
 // Update atualiza uma entidade existente
 func (r *BaseRepositoryImpl[E]) Update(entity E) error {
-	return r.db.Save(entity).Error
+	return r.retryWrite(func() error {
+		return r.translateError(r.writeDB().Save(entity).Error)
+	})
 }
 
 // Delete remove uma entidade pelo ID (soft delete se configurado)
 func (r *BaseRepositoryImpl[E]) Delete(id uint) error {
-	result := r.db.Delete(r.newEntity(), id)
-	if result.Error != nil {
-		return result.Error
+	var result *gorm.DB
+	err := r.retryWrite(func() error {
+		result = r.writeDB().Delete(r.newEntity(), id)
+		return r.translateError(result.Error)
+	})
+	if err != nil {
+		return err
 	}
 	if result.RowsAffected == 0 {
 		return arqerrors.ErrNotFound
@@ -242,14 +287,42 @@ func (r *BaseRepositoryImpl[E]) ExistsWhere(condition interface{}, args ...inter
 }
 
 // ExistsWhereExcludingID verifica se existe outra entidade com a condição (excluindo o ID)
+// A condição é agrupada entre parênteses, então condições com OR não anulam a exclusão do ID
 func (r *BaseRepositoryImpl[E]) ExistsWhereExcludingID(id uint, condition string, args ...interface{}) (bool, error) {
 	var count int64
-	fullCondition := condition + " AND id != ?"
-	fullArgs := append(args, id)
-	err := r.db.Model(r.newEntity()).Where(fullCondition, fullArgs...).Count(&count).Error
+	err := r.db.Model(r.newEntity()).
+		Where(condition, args...).
+		Where(clause.Neq{Column: clause.PrimaryColumn, Value: id}).
+		Count(&count).Error
 	return count > 0, err
 }
 
+// ExistsUnique verifica se outra entidade já possui a mesma combinação de valores (restrição de unicidade,
+// inclusive de várias colunas). As colunas (ou campos Go) são validadas contra o modelo e os valores vão como
+// parâmetros; excludeID diferente de zero desconsidera a própria entidade (atualização)
+// Ex: repo.ExistsUnique(map[string]interface{}{"codigo": req.Codigo, "categoria_id": req.CategoriaID}, id)
+func (r *BaseRepositoryImpl[E]) ExistsUnique(values map[string]interface{}, excludeID uint) (bool, error) {
+	if len(values) == 0 {
+		return false, fmt.Errorf("nenhuma coluna informada para a verificação de unicidade em %s", r.TableName())
+	}
+
+	// Ordena as colunas para que a query gerada seja sempre a mesma
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	specs := make([]Specification, 0, len(names)+1)
+	for _, name := range names {
+		specs = append(specs, Eq(name, values[name]))
+	}
+	if excludeID != 0 {
+		specs = append(specs, Neq("id", excludeID))
+	}
+	return r.ExistsBySpec(And(specs...))
+}
+
 // CountWhere conta registros com condição
 func (r *BaseRepositoryImpl[E]) CountWhere(condition interface{}, args ...interface{}) (int64, error) {
 	var count int64
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// FindByParentIDs carrega os filhos de vários pais em uma única query (segunda fase do carregamento em lote)
+// Evita o N+1 e o preload ilimitado de relacionamentos em listagens: a página de pais é carregada
+// primeiro e, em seguida, os filhos de todos os pais com um IN sobre foreignKey.
+//
+// limitPerParent limita a quantidade de filhos por pai (ROW_NUMBER() OVER PARTITION BY); 0 não limita
+// Os preloads padrão do repositório não são aplicados aos filhos.
+// Ex: produtoRepo.FindByParentIDs("categoria_id", []uint{1, 2, 3}, 5, "descricao ASC")
+func (r *BaseRepositoryImpl[E]) FindByParentIDs(foreignKey string, parentIDs []uint, limitPerParent int, orderBy string) (map[uint][]E, error) {
+	result := make(map[uint][]E, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	field, err := r.lookupField(foreignKey)
+	if err != nil {
+		return nil, err
+	}
+
+	order := orderBy
+	if order == "" {
+		order = r.defaultOrder
+	}
+
+	var query *gorm.DB
+	if limitPerParent > 0 {
+		ranked := r.db.Model(r.newEntity()).
+			Select("*, ROW_NUMBER() OVER (PARTITION BY "+field.DBName+" ORDER BY "+order+") AS batch_rn").
+			Where(field.DBName+" IN ?", parentIDs)
+
+		query = r.db.Table("(?) AS batch", ranked).
+			Where("batch_rn <= ?", limitPerParent).
+			Order(field.DBName).
+			Order("batch_rn")
+	} else {
+		query = r.db.Where(field.DBName+" IN ?", parentIDs).
+			Order(field.DBName).
+			Order(order)
+	}
+
+	var children []E
+	if err := query.Find(&children).Error; err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		value, _ := field.ValueOf(r.db.Statement.Context, reflect.ValueOf(child))
+		parentID, ok := toUint(value)
+		if !ok {
+			return nil, fmt.Errorf("chave estrangeira %s com tipo não suportado: %T", foreignKey, value)
+		}
+		result[parentID] = append(result[parentID], child)
+	}
+
+	return result, nil
+}
+
+// CountByParentIDs retorna a quantidade de filhos de cada pai em uma única query (GROUP BY foreignKey)
+// Pais sem filhos não aparecem no mapa
+func (r *BaseRepositoryImpl[E]) CountByParentIDs(foreignKey string, parentIDs []uint) (map[uint]int64, error) {
+	result := make(map[uint]int64, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	field, err := r.lookupField(foreignKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		ParentID uint
+		Total    int64
+	}
+	err = r.db.Model(r.newEntity()).
+		Select(field.DBName+" AS parent_id, COUNT(*) AS total").
+		Where(field.DBName+" IN ?", parentIDs).
+		Group(field.DBName).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.ParentID] = row.Total
+	}
+	return result, nil
+}
+
+// FindAllByIDs busca várias entidades pelo ID em uma única query (WHERE id IN ...), com os preloads
+// Substitui laços de FindByID ao compor dados de várias origens. O resultado segue a ordem de ids;
+// IDs repetidos aparecem uma vez e IDs inexistentes (ou excluídos logicamente) são omitidos
+// Ex: repo.FindAllByIDs([]uint{3, 1, 2})
+func (r *BaseRepositoryImpl[E]) FindAllByIDs(ids []uint) ([]E, error) {
+	if len(ids) == 0 {
+		return []E{}, nil
+	}
+
+	query := r.db
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	var entities []E
+	if err := query.Where(clause.IN{Column: clause.PrimaryColumn, Values: uintValues(ids)}).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]E, len(entities))
+	for _, entity := range entities {
+		byID[entity.GetID()] = entity
+	}
+
+	result := make([]E, 0, len(entities))
+	for _, id := range ids {
+		if entity, ok := byID[id]; ok {
+			result = append(result, entity)
+			delete(byID, id)
+		}
+	}
+	return result, nil
+}
+
+// lookupField localiza o campo da entidade pelo nome da coluna ou do campo Go
+func (r *BaseRepositoryImpl[E]) lookupField(name string) (*schema.Field, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(r.newEntity()); err != nil {
+		return nil, err
+	}
+
+	field := stmt.Schema.LookUpField(name)
+	if field == nil {
+		return nil, fmt.Errorf("campo %s não encontrado em %s", name, stmt.Schema.Table)
+	}
+	return field, nil
+}
+
+// toUint converte o valor de uma chave (inteiro com ou sem sinal) para uint
+func toUint(value interface{}) (uint, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return uint(v.Uint()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint(v.Int()), true
+	}
+	return 0, false
+}
+
+// uintValues converte os IDs para a lista de valores de uma cláusula IN
+func uintValues(ids []uint) []interface{} {
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+	return values
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+)
+
+// KeyValue retorna o valor da coluna (ou campo Go) informada na entidade
+// Usado para identificar registros pela chave natural (ex: codigo)
+func (r *BaseRepositoryImpl[E]) KeyValue(entity E, column string) (interface{}, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	value, _ := field.ValueOf(r.db.Statement.Context, reflect.ValueOf(entity))
+	return value, nil
+}
+
+// FindByKeys busca as entidades cuja coluna possui um dos valores informados, em uma única query
+// Retorna um mapa valor da chave → entidade (valores sem registro não aparecem no mapa)
+func (r *BaseRepositoryImpl[E]) FindByKeys(column string, values []interface{}) (map[interface{}]E, error) {
+	result := make(map[interface{}]E, len(values))
+	if len(values) == 0 {
+		return result, nil
+	}
+
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []E
+	if err := r.db.Where(field.DBName+" IN ?", values).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	for _, entity := range entities {
+		value, _ := field.ValueOf(r.db.Statement.Context, reflect.ValueOf(entity))
+		result[value] = entity
+	}
+	return result, nil
+}
+
+// SaveAll insere as novas entidades e atualiza as existentes em uma única transação
+// Qualquer falha desfaz todas as gravações
+func (r *BaseRepositoryImpl[E]) SaveAll(creates []E, updates []E) error {
+	return r.retryWrite(func() error {
+		return r.saveAll(creates, updates)
+	})
+}
+
+// saveAll grava o lote em uma transação (uma tentativa de SaveAll)
+func (r *BaseRepositoryImpl[E]) saveAll(creates []E, updates []E) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, entity := range creates {
+			if err := tx.Create(entity).Error; err != nil {
+				return fmt.Errorf("falha ao inserir item %d: %w", i, r.translateError(err))
+			}
+		}
+		for i, entity := range updates {
+			if err := tx.Save(entity).Error; err != nil {
+				return fmt.Errorf("falha ao atualizar item %d: %w", i, r.translateError(err))
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateWhere atualiza, em um único UPDATE, as colunas informadas em values de todos os registros
+// que atendem a condição, sem carregá-los. Retorna a quantidade de registros atualizados
+// As chaves de values aceitam o nome da coluna ou do campo Go; valores calculados usam gorm.Expr
+// updated_at é preenchido automaticamente e registros excluídos (soft delete) não são afetados
+//
+// Ex: repo.UpdateWhere("categoria_id = ?", []interface{}{id}, map[string]interface{}{"preco": gorm.Expr("preco * ?", 1.1)})
+func (r *BaseRepositoryImpl[E]) UpdateWhere(condition interface{}, args []interface{}, values map[string]interface{}) (int64, error) {
+	if condition == nil || condition == "" {
+		return 0, fmt.Errorf("atualização em massa sem condição: %w", gorm.ErrMissingWhereClause)
+	}
+	if len(values) == 0 {
+		return 0, fmt.Errorf("atualização em massa sem valores")
+	}
+
+	columns, err := r.updateColumns(values)
+	if err != nil {
+		return 0, err
+	}
+
+	var result *gorm.DB
+	err = r.retryWrite(func() error {
+		result = r.writeDB().Model(r.newEntity()).Where(condition, args...).Updates(columns)
+		return r.translateError(result.Error)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/cache"
+	"api_fibergorm/pkg/arquitetura/entity"
+
+	"gorm.io/gorm"
+)
+
+// CachedRepository decora o repositório base com cache (Redis ou memória) das buscas por ID e das
+// listagens paginadas sem filtros, aliviando o banco em entidades muito mais lidas do que gravadas
+// Os demais métodos do repositório base continuam disponíveis, sem cache.
+//
+// Invalidação: cada entidade tem uma geração no cache que faz parte de todas as chaves; as escritas
+// feitas pelo decorador (Create, Update, Delete...) trocam a geração, descartando de uma vez as
+// entradas por ID e as páginas. Escritas feitas por outros caminhos (SQL manual, outro repositório)
+// exigem Invalidate; caso contrário, o TTL limita o tempo de desatualização.
+// Dentro de uma transação o cache não é lido nem gravado, para não expor dados ainda não confirmados;
+// nela, a invalidação das escritas é agendada para depois do commit (AfterCommit).
+// Falhas do cache não interrompem as operações: as leituras caem para o banco
+//
+// Ex: produtos := repository.NewCachedRepository(produtoRepo.BaseRepositoryImpl, cache.New(redisClient), time.Minute)
+type CachedRepository[E entity.Entity] struct {
+	*BaseRepositoryImpl[E]
+	cache cache.Cache
+	ttl   time.Duration
+	ctx   context.Context
+}
+
+// NewCachedRepository cria o decorador de cache sobre o repositório informado
+func NewCachedRepository[E entity.Entity](repo *BaseRepositoryImpl[E], c cache.Cache, ttl time.Duration) *CachedRepository[E] {
+	return &CachedRepository[E]{
+		BaseRepositoryImpl: repo,
+		cache:              c,
+		ttl:                ttl,
+		ctx:                context.Background(),
+	}
+}
+
+// WithContext retorna uma cópia do decorador vinculada ao contexto (ver BaseRepositoryImpl.WithContext)
+func (r *CachedRepository[E]) WithContext(ctx context.Context) *CachedRepository[E] {
+	if ctx == nil {
+		return r
+	}
+	clone := *r
+	clone.BaseRepositoryImpl = r.BaseRepositoryImpl.WithContext(ctx)
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithTx retorna uma cópia do decorador que executa as operações na transação informada (sem cache nas leituras)
+// Sem a transação no contexto, a invalidação das escritas não aguarda o commit: prefira WithContext
+func (r *CachedRepository[E]) WithTx(tx *gorm.DB) *CachedRepository[E] {
+	clone := *r
+	clone.BaseRepositoryImpl = r.BaseRepositoryImpl.WithTx(tx)
+	return &clone
+}
+
+// FindByID busca a entidade pelo ID, consultando o cache antes do banco
+func (r *CachedRepository[E]) FindByID(id uint) (E, error) {
+	if !r.cacheable() {
+		return r.BaseRepositoryImpl.FindByID(id)
+	}
+
+	key := r.key("id:" + strconv.FormatUint(uint64(id), 10))
+	entity := r.newEntity()
+	if found, err := r.cache.Get(r.ctx, key, entity); err == nil && found {
+		return entity, nil
+	}
+
+	entity, err := r.BaseRepositoryImpl.FindByID(id)
+	if err != nil {
+		return entity, err
+	}
+	_ = r.cache.Set(r.ctx, key, entity, r.ttl)
+	return entity, nil
+}
+
+// FindAll busca a página de entidades com a contagem exata, consultando o cache antes do banco
+func (r *CachedRepository[E]) FindAll(page, pageSize int, orderBy string) ([]E, int64, error) {
+	result, err := r.FindAllWithCountMode(page, pageSize, orderBy, CountExact)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result.Items, result.Total, nil
+}
+
+// FindAllWithCountMode busca a página de entidades, consultando o cache antes do banco
+func (r *CachedRepository[E]) FindAllWithCountMode(page, pageSize int, orderBy string, mode CountMode) (*PageResult[E], error) {
+	if !r.cacheable() || len(r.columns) > 0 {
+		return r.BaseRepositoryImpl.FindAllWithCountMode(page, pageSize, orderBy, mode)
+	}
+
+	key := r.key(fmt.Sprintf("page:%d:%d:%s:%s", page, pageSize, mode, orderBy))
+	var result PageResult[E]
+	if found, err := r.cache.Get(r.ctx, key, &result); err == nil && found {
+		return &result, nil
+	}
+
+	fresh, err := r.BaseRepositoryImpl.FindAllWithCountMode(page, pageSize, orderBy, mode)
+	if err != nil {
+		return nil, err
+	}
+	_ = r.cache.Set(r.ctx, key, fresh, r.ttl)
+	return fresh, nil
+}
+
+// Create cria a entidade e invalida o cache
+func (r *CachedRepository[E]) Create(entity E) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.Create(entity))
+}
+
+// Update atualiza a entidade e invalida o cache
+func (r *CachedRepository[E]) Update(entity E) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.Update(entity))
+}
+
+// UpdateFields atualiza as colunas informadas e invalida o cache
+func (r *CachedRepository[E]) UpdateFields(id uint, fields map[string]interface{}) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.UpdateFields(id, fields))
+}
+
+// UpdateWhere atualiza as entidades que atendem a condição e invalida o cache
+func (r *CachedRepository[E]) UpdateWhere(condition interface{}, args []interface{}, values map[string]interface{}) (int64, error) {
+	affected, err := r.BaseRepositoryImpl.UpdateWhere(condition, args, values)
+	return affected, r.invalidateAfter(err)
+}
+
+// Upsert insere ou atualiza a entidade e invalida o cache
+func (r *CachedRepository[E]) Upsert(entity E, conflictColumns []string, updateColumns []string) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.Upsert(entity, conflictColumns, updateColumns))
+}
+
+// SaveAll grava o lote e invalida o cache
+func (r *CachedRepository[E]) SaveAll(creates []E, updates []E) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.SaveAll(creates, updates))
+}
+
+// Delete exclui a entidade (exclusão lógica) e invalida o cache
+func (r *CachedRepository[E]) Delete(id uint) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.Delete(id))
+}
+
+// Restore restaura a entidade da lixeira e invalida o cache
+func (r *CachedRepository[E]) Restore(id uint) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.Restore(id))
+}
+
+// DeletePermanently exclui a entidade definitivamente e invalida o cache
+func (r *CachedRepository[E]) DeletePermanently(id uint) error {
+	return r.invalidateAfter(r.BaseRepositoryImpl.DeletePermanently(id))
+}
+
+// HardDelete é um alias de DeletePermanently (com a invalidação do cache)
+func (r *CachedRepository[E]) HardDelete(id uint) error {
+	return r.DeletePermanently(id)
+}
+
+// Invalidate descarta as entradas em cache da entidade (buscas por ID e páginas), trocando a geração
+// Usado após escritas feitas fora do decorador
+func (r *CachedRepository[E]) Invalidate(ctx context.Context) error {
+	if r.cache == nil {
+		return nil
+	}
+	generation := strconv.FormatInt(time.Now().UnixNano(), 36)
+	// A geração dura o mesmo que as entradas: quando expira, as entradas da geração anterior já expiraram
+	return r.cache.Set(ctx, r.generationKey(), generation, r.ttl)
+}
+
+// invalidateAfter invalida o cache quando a escrita foi bem-sucedida (após o commit, em uma transação)
+// Falhas na invalidação são ignoradas: a escrita já foi feita e o TTL limita a desatualização
+func (r *CachedRepository[E]) invalidateAfter(err error) error {
+	if err != nil {
+		return err
+	}
+	AfterCommit(r.ctx, func() {
+		_ = r.Invalidate(context.WithoutCancel(r.ctx))
+	})
+	return nil
+}
+
+// cacheable indica se as leituras podem usar o cache (configurado e fora de transação)
+func (r *CachedRepository[E]) cacheable() bool {
+	if r.cache == nil || r.ttl <= 0 {
+		return false
+	}
+	_, inTx := r.db.Statement.ConnPool.(gorm.TxCommitter)
+	return !inTx
+}
+
+// key monta a chave da entrada na geração atual (ex: repo:produtos:<geração>:id:5)
+func (r *CachedRepository[E]) key(suffix string) string {
+	var generation string
+	if found, err := r.cache.Get(r.ctx, r.generationKey(), &generation); err != nil || !found {
+		generation = "0"
+	}
+	return "repo:" + r.TableName() + ":" + generation + ":" + suffix
+}
+
+// generationKey chave da geração atual das entradas da entidade
+func (r *CachedRepository[E]) generationKey() string {
+	return "repo:" + r.TableName() + ":generation"
+}
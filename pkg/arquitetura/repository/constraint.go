@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Códigos de erro do Postgres (SQLSTATE) traduzidos pelo repositório
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+var (
+	// constraintKey extrai as colunas do detalhe do erro: Key (codigo)=(PROD001) already exists.
+	constraintKey = regexp.MustCompile(`\(([^)]+)\)=\(`)
+
+	// constraintTable extrai a tabela que referencia o registro: ... is still referenced from table "produtos".
+	constraintTable = regexp.MustCompile(`table "([^"]+)"`)
+)
+
+// translateError converte violações de restrição do banco em erros de negócio
+// Cobre as condições de corrida que escapam dos validadores (ex: duas requisições criando o mesmo código):
+//   - 23505 (unique) → DUPLICATE (409), com a coluna em Field e errors.Is(err, ErrDuplicateKey)
+//   - 23503 (chave estrangeira) → HAS_RELATIONS (409), com errors.Is(err, ErrForeignKeyViolation)
+//   - 40001/40P01 (serialização/deadlock) → CONCURRENT_UPDATE (409), com errors.Is(err, ErrConcurrentUpdate)
+//
+// Demais erros são retornados sem alteração
+func (r *BaseRepositoryImpl[E]) translateError(err error) error {
+	return translateDBError(r.db.Statement.Context, r.TableName(), err)
+}
+
+// translateDBError converte o erro do banco da tabela informada, com as mensagens no idioma do contexto
+// (ver translateError)
+func translateDBError(ctx context.Context, tableName string, err error) error {
+	var pgErr *pgconn.PgError
+	if err == nil || !errors.As(err, &pgErr) {
+		return err
+	}
+
+	column := constraintColumn(pgErr)
+
+	switch pgErr.Code {
+	case pgUniqueViolation:
+		return &arqerrors.BusinessError{
+			Code:    arqerrors.CodeDuplicate,
+			Field:   column,
+			Message: i18n.T(ctx, i18n.MsgDuplicateField, i18n.Params{"field": column}),
+			Err:     fmt.Errorf("%w: %w", arqerrors.ErrDuplicateKey, err),
+		}
+
+	case pgForeignKeyViolation:
+		// A restrição pertence à tabela filha: se não é a tabela deste repositório, um registro
+		// filho ainda referencia o registro excluído; senão, o registro referenciado não existe
+		if pgErr.TableName != "" && pgErr.TableName != tableName {
+			table := pgErr.TableName
+			if m := constraintTable.FindStringSubmatch(pgErr.Detail); m != nil {
+				table = m[1]
+			}
+			return &arqerrors.BusinessError{
+				Code:    arqerrors.CodeHasRelations,
+				Message: i18n.T(ctx, i18n.MsgHasRelations, i18n.Params{"table": table}),
+				Err:     fmt.Errorf("%w: %w", arqerrors.ErrHasRelatedRecords, err),
+			}
+		}
+		return &arqerrors.BusinessError{
+			Code:    arqerrors.CodeHasRelations,
+			Field:   column,
+			Message: i18n.T(ctx, i18n.MsgForeignKeyMissing, i18n.Params{"field": column}),
+			Err:     fmt.Errorf("%w: %w", arqerrors.ErrForeignKeyViolation, err),
+		}
+
+	case pgSerializationFailure, pgDeadlockDetected:
+		// Conflito de concorrência que persistiu após as repetições (ver RetryPolicy); em uma transação,
+		// o indicador permite que quem a abriu a repita mesmo que o handler trate o erro
+		markConflict(ctx)
+		return &arqerrors.BusinessError{
+			Code:    arqerrors.CodeConcurrentUpdate,
+			Message: i18n.T(ctx, i18n.MsgConcurrentUpdate, nil),
+			Err:     fmt.Errorf("%w: %w", arqerrors.ErrConcurrentUpdate, err),
+		}
+	}
+
+	return err
+}
+
+// constraintColumn identifica a(s) coluna(s) da restrição violada
+// Usa o campo do erro quando informado, senão o detalhe da mensagem e, por fim, o nome da restrição
+func constraintColumn(pgErr *pgconn.PgError) string {
+	if pgErr.ColumnName != "" {
+		return pgErr.ColumnName
+	}
+	if m := constraintKey.FindStringSubmatch(pgErr.Detail); m != nil {
+		return m[1]
+	}
+	return pgErr.ConstraintName
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// ErrInvalidCursor cursor de paginação malformado ou incompatível com a ordenação
+var ErrInvalidCursor = errors.New("cursor de paginação inválido")
+
+// Cursor posição de continuação da paginação por cursor (keyset): valores da última linha retornada
+// Value é o valor da coluna de ordenação (ausente quando a ordenação é pelo próprio ID)
+type Cursor struct {
+	Value interface{} `json:"v,omitempty"`
+	ID    uint        `json:"id"`
+}
+
+// CursorResult representa o resultado de uma consulta paginada por cursor
+type CursorResult[E any] struct {
+	Items      []E
+	HasNext    bool   // Indica se existe uma próxima página
+	NextCursor string // Cursor da próxima página (vazio quando não houver)
+}
+
+// EncodeCursor serializa o cursor em um token opaco (base64 URL-safe)
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor converte o token recebido do cliente; token vazio indica a primeira página (nil)
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// FindAfterCursor retorna até limit entidades após o cursor, usando paginação por keyset:
+// WHERE (coluna, id) > (valor, id do cursor) ORDER BY coluna, id. O custo não cresce com a profundidade
+// da página, ao contrário do OFFSET. cursor nil retorna a primeira página
+// orderBy aceita uma única coluna com direção (ex: "nome ASC", "created_at DESC"); vazio usa a ordenação padrão
+// A coluna de ordenação não deve aceitar NULL
+func (r *BaseRepositoryImpl[E]) FindAfterCursor(cursor *Cursor, limit int, orderBy string) (*CursorResult[E], error) {
+	if orderBy == "" {
+		orderBy = r.defaultOrder
+	}
+	column, desc, err := r.parseCursorOrder(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	operator := ">"
+	if desc {
+		operator = "<"
+	}
+	if cursor != nil {
+		if column == "id" {
+			query = query.Where(clause.Expr{SQL: "? " + operator + " ?", Vars: []interface{}{clause.Column{Name: "id"}, cursor.ID}})
+		} else {
+			query = query.Where(clause.Expr{
+				SQL:  "(?, ?) " + operator + " (?, ?)",
+				Vars: []interface{}{clause.Column{Name: column}, clause.Column{Name: "id"}, cursor.Value, cursor.ID},
+			})
+		}
+	}
+
+	query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: column}, Desc: desc})
+	if column != "id" {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: "id"}, Desc: desc})
+	}
+
+	// Busca um registro a mais para saber se existe próxima página
+	var entities []E
+	err = query.Limit(limit + 1).Find(&entities).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CursorResult[E]{}
+	if len(entities) > limit {
+		entities = entities[:limit]
+		result.HasNext = true
+	}
+	result.Items = entities
+
+	if result.HasNext {
+		last := entities[len(entities)-1]
+		next := Cursor{ID: last.GetID()}
+		if column != "id" {
+			value, err := r.columnValue(last, column)
+			if err != nil {
+				return nil, err
+			}
+			next.Value = value
+		}
+		result.NextCursor = EncodeCursor(next)
+	}
+	return result, nil
+}
+
+// parseCursorOrder valida a ordenação da paginação por cursor (uma coluna existente na entidade)
+func (r *BaseRepositoryImpl[E]) parseCursorOrder(orderBy string) (string, bool, error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 || len(parts) > 2 || strings.Contains(orderBy, ",") {
+		return "", false, fmt.Errorf("ordenação %q não suportada na paginação por cursor (use uma única coluna)", orderBy)
+	}
+
+	desc := false
+	if len(parts) == 2 {
+		switch strings.ToUpper(parts[1]) {
+		case "ASC":
+		case "DESC":
+			desc = true
+		default:
+			return "", false, fmt.Errorf("direção de ordenação inválida: %s", parts[1])
+		}
+	}
+
+	field, err := r.lookupField(parts[0])
+	if err != nil {
+		return "", false, err
+	}
+	if field.DBName == "" {
+		return "", false, fmt.Errorf("campo %s não possui coluna no banco", parts[0])
+	}
+	return field.DBName, desc, nil
+}
+
+// columnValue lê o valor da coluna na entidade (usado para montar o próximo cursor)
+func (r *BaseRepositoryImpl[E]) columnValue(entity E, column string) (interface{}, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := field.ValueOf(context.Background(), reflect.Indirect(reflect.ValueOf(entity)))
+	return value, nil
+}
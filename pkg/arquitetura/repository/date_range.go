@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DateRange filtra as listagens pelo período de criação/atualização
+// Os intervalos são semiabertos: After é inclusivo (>=) e Before é exclusivo (<),
+// de forma que períodos consecutivos não se sobrepõem
+// As comparações são feitas diretamente nas colunas (sem funções), aproveitando os índices
+type DateRange struct {
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+}
+
+// IsEmpty indica se nenhum filtro de período foi informado
+func (f DateRange) IsEmpty() bool {
+	return f.CreatedAfter == nil && f.CreatedBefore == nil && f.UpdatedAfter == nil && f.UpdatedBefore == nil
+}
+
+// apply adiciona as condições de período à query, qualificando as colunas com a tabela
+func (f DateRange) apply(db *gorm.DB, table string) *gorm.DB {
+	if f.CreatedAfter != nil {
+		db = db.Where(table+".created_at >= ?", *f.CreatedAfter)
+	}
+	if f.CreatedBefore != nil {
+		db = db.Where(table+".created_at < ?", *f.CreatedBefore)
+	}
+	if f.UpdatedAfter != nil {
+		db = db.Where(table+".updated_at >= ?", *f.UpdatedAfter)
+	}
+	if f.UpdatedBefore != nil {
+		db = db.Where(table+".updated_at < ?", *f.UpdatedBefore)
+	}
+	return db
+}
+
+// FindAllInRangeWithCountMode retorna as entidades do período informado com paginação e o modo de contagem
+// Sem filtros de período, equivale a FindAllWithCountMode (inclusive a contagem estimada)
+func (r *BaseRepositoryImpl[E]) FindAllInRangeWithCountMode(page, pageSize int, orderBy string, mode CountMode, dateRange DateRange) (*PageResult[E], error) {
+	return r.FindAllFiltered(page, pageSize, orderBy, mode, dateRange, nil)
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// UpdateFields atualiza apenas as colunas informadas da entidade com o ID, sem reescrever as demais
+// Ao contrário de Update (Save), não sobrescreve alterações concorrentes nos campos não informados
+// As chaves aceitam o nome da coluna ou do campo Go; updated_at é preenchido automaticamente
+// Retorna ErrNotFound quando o registro não existe (ou está na lixeira)
+func (r *BaseRepositoryImpl[E]) UpdateFields(id uint, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	columns, err := r.updateColumns(fields)
+	if err != nil {
+		return err
+	}
+
+	var result *gorm.DB
+	err = r.retryWrite(func() error {
+		result = r.writeDB().Model(r.newEntity()).Where("id = ?", id).Updates(columns)
+		return r.translateError(result.Error)
+	})
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return arqerrors.ErrNotFound
+	}
+	return nil
+}
+
+// ColumnValues retorna os valores das colunas graváveis da entidade (coluna → valor), sem a chave
+// primária e as datas automáticas. Usado com ChangedColumns para detectar as colunas alteradas
+func (r *BaseRepositoryImpl[E]) ColumnValues(entity E) (map[string]interface{}, error) {
+	fields, err := r.writableFields()
+	if err != nil {
+		return nil, err
+	}
+
+	target := reflect.ValueOf(entity)
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, _ := field.ValueOf(r.db.Statement.Context, target)
+		values[field.DBName] = value
+	}
+	return values, nil
+}
+
+// ChangedColumns compara a entidade com os valores obtidos antes por ColumnValues e retorna
+// apenas as colunas alteradas, no formato aceito por UpdateFields
+func (r *BaseRepositoryImpl[E]) ChangedColumns(before map[string]interface{}, entity E) (map[string]interface{}, error) {
+	after, err := r.ColumnValues(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]interface{})
+	for column, value := range after {
+		if old, ok := before[column]; !ok || !reflect.DeepEqual(old, value) {
+			changed[column] = value
+		}
+	}
+	return changed, nil
+}
+
+// updateColumns converte as chaves (coluna ou campo Go) para os nomes das colunas
+// A chave primária não pode ser alterada
+func (r *BaseRepositoryImpl[E]) updateColumns(values map[string]interface{}) (map[string]interface{}, error) {
+	columns := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		field, err := r.lookupField(name)
+		if err != nil {
+			return nil, err
+		}
+		if field.PrimaryKey || field.DBName == "" {
+			return nil, fmt.Errorf("campo %s não pode ser alterado", name)
+		}
+		columns[field.DBName] = value
+	}
+	return columns, nil
+}
+
+// writableFields retorna os campos da entidade gravados em um UPDATE (colunas atualizáveis,
+// exceto a chave primária, as datas automáticas e o soft delete)
+func (r *BaseRepositoryImpl[E]) writableFields() ([]*schema.Field, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(r.newEntity()); err != nil {
+		return nil, err
+	}
+
+	fields := make([]*schema.Field, 0, len(stmt.Schema.Fields))
+	for _, field := range stmt.Schema.Fields {
+		if field.DBName == "" || !field.Updatable || field.PrimaryKey ||
+			field.AutoCreateTime > 0 || field.AutoUpdateTime > 0 {
+			continue
+		}
+		if field.FieldType == reflect.TypeOf(gorm.DeletedAt{}) {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// FilterOperator operador de um filtro recebido na query string (sufixo do parâmetro, ex: preco_gte)
+type FilterOperator string
+
+const (
+	FilterEq   FilterOperator = "eq"   // campo=valor
+	FilterNe   FilterOperator = "ne"   // campo_ne=valor
+	FilterGt   FilterOperator = "gt"   // campo_gt=valor
+	FilterGte  FilterOperator = "gte"  // campo_gte=valor
+	FilterLt   FilterOperator = "lt"   // campo_lt=valor
+	FilterLte  FilterOperator = "lte"  // campo_lte=valor
+	FilterLike FilterOperator = "like" // campo_like=trecho (contém, sem diferenciar maiúsculas)
+	FilterIn   FilterOperator = "in"   // campo_in=v1,v2
+)
+
+// FilterOperators operadores aceitos como sufixo dos parâmetros de filtro
+var FilterOperators = []FilterOperator{FilterNe, FilterGt, FilterGte, FilterLt, FilterLte, FilterLike, FilterIn}
+
+// Filter especificação com os valores como texto (ex: query string), convertidos para o tipo do campo
+// da entidade na execução. Param é o nome do parâmetro recebido, usado nas mensagens de erro
+type Filter struct {
+	Param    string
+	Field    string
+	Operator FilterOperator
+	Values   []string
+}
+
+// FilterError valor de filtro incompatível com o tipo do campo ou com o operador
+type FilterError struct {
+	Param string
+	Value string
+}
+
+// Error implementa a interface error
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("valor inválido para o filtro %s: %s", e.Param, e.Value)
+}
+
+// Expression converte os valores para o tipo do campo e monta a condição (Specification)
+func (f Filter) Expression(resolve ColumnResolver) (clause.Expression, error) {
+	column, field, err := resolve(f.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Operator == FilterLike {
+		if fieldKind(field) != reflect.String || len(f.Values) != 1 {
+			return nil, &FilterError{Param: f.Param, Value: strings.Join(f.Values, ",")}
+		}
+		pattern := "%" + escapeLike(f.Values[0]) + "%"
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, pattern}}, nil
+	}
+
+	values := make([]interface{}, len(f.Values))
+	for i, raw := range f.Values {
+		value, ok := convertFilterValue(field, raw)
+		if !ok {
+			return nil, &FilterError{Param: f.Param, Value: raw}
+		}
+		values[i] = value
+	}
+
+	if f.Operator == FilterIn {
+		return clause.IN{Column: column, Values: values}, nil
+	}
+	if len(values) != 1 {
+		return nil, &FilterError{Param: f.Param, Value: strings.Join(f.Values, ",")}
+	}
+
+	switch f.Operator {
+	case FilterNe:
+		return clause.Neq{Column: column, Value: values[0]}, nil
+	case FilterGt:
+		return clause.Gt{Column: column, Value: values[0]}, nil
+	case FilterGte:
+		return clause.Gte{Column: column, Value: values[0]}, nil
+	case FilterLt:
+		return clause.Lt{Column: column, Value: values[0]}, nil
+	case FilterLte:
+		return clause.Lte{Column: column, Value: values[0]}, nil
+	default:
+		return clause.Eq{Column: column, Value: values[0]}, nil
+	}
+}
+
+// convertFilterValue converte o texto para o tipo Go do campo
+// Aceita textos, booleanos, números, datas (RFC3339 ou AAAA-MM-DD) e UUIDs
+func convertFilterValue(field *schema.Field, raw string) (interface{}, bool) {
+	fieldType := field.FieldType
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	switch fieldType {
+	case reflect.TypeOf(time.Time{}):
+		if t, err := time.Parse(time.RFC3339, raw); err == nil {
+			return t, true
+		}
+		if t, err := time.ParseInLocation(time.DateOnly, raw, time.Local); err == nil {
+			return t, true
+		}
+		return nil, false
+	case reflect.TypeOf(uuid.UUID{}):
+		id, err := uuid.Parse(raw)
+		return id, err == nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		return raw, true
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		return b, err == nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		return n, err == nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		return n, err == nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		return n, err == nil
+	}
+	return nil, false
+}
+
+// fieldKind retorna o tipo básico do campo (sem ponteiro)
+func fieldKind(field *schema.Field) reflect.Kind {
+	fieldType := field.FieldType
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	return fieldType.Kind()
+}
+
+// escapeLike escapa os curingas do LIKE no texto informado pelo cliente
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
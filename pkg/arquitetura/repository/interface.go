@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BaseRepository define as operações do repositório usadas pelo serviço base (service.BaseServiceImpl)
+// Permite testar os serviços sem banco, com uma implementação falsa (ver o pacote repository/mocks)
+// WithContext e WithSelect retornam a própria interface: o repositório concreto é adaptado com AsBaseRepository
+// E é o tipo ponteiro da entidade (ex: *models.Categoria)
+type BaseRepository[E entity.Entity] interface {
+	WithContext(ctx context.Context) BaseRepository[E]
+	WithSelect(columns ...string) BaseRepository[E]
+	GetDB() *gorm.DB // nil em implementações sem banco
+	TableName() string
+	Searchable() bool
+
+	Create(entity E) error
+	FindByID(id uint) (E, error)
+	FindByPublicID(publicID uuid.UUID) (E, error)
+	FindOneWhere(condition interface{}, args ...interface{}) (E, error)
+	FindByKeys(column string, values []interface{}) (map[interface{}]E, error)
+	FindAllWithOptions(opts ListOptions) (*PageResult[E], error)
+	CountWithOptions(opts ListOptions) (int64, CountMode, error)
+	Search(query string, opts ListOptions) (*PageResult[SearchHit[E]], error)
+	FindAllWhereWithCountMode(page, pageSize int, orderBy string, mode CountMode, condition interface{}, args ...interface{}) (*PageResult[E], error)
+	FindAfterCursor(cursor *Cursor, limit int, orderBy string) (*CursorResult[E], error)
+	FindInBatches(batchSize int, fn func(batch []E) error) error
+	FindDeleted(page, pageSize int, orderBy string, mode CountMode, dateRange DateRange) (*PageResult[E], error)
+	ExistsWhere(condition interface{}, args ...interface{}) (bool, error)
+	UpdateFields(id uint, fields map[string]interface{}) error
+	UpdateWhere(condition interface{}, args []interface{}, values map[string]interface{}) (int64, error)
+	SaveAll(creates []E, updates []E) error
+	Delete(id uint) error
+	IsDeleted(id uint) (bool, error)
+	Restore(id uint) error
+	DeletePermanently(id uint) error
+
+	SortOrder(sort Sort) (string, error)
+	SelectColumns(fields []string) ([]string, error)
+	ColumnValues(entity E) (map[string]interface{}, error)
+	ChangedColumns(before map[string]interface{}, entity E) (map[string]interface{}, error)
+	KeyValue(entity E, column string) (interface{}, error)
+}
+
+// baseRepository adapta o repositório concreto à interface BaseRepository
+// (os métodos encadeáveis do concreto retornam *BaseRepositoryImpl, e não a interface)
+type baseRepository[E entity.Entity] struct {
+	*BaseRepositoryImpl[E]
+}
+
+// AsBaseRepository retorna o repositório como BaseRepository (ex: service.NewBaseService)
+func (r *BaseRepositoryImpl[E]) AsBaseRepository() BaseRepository[E] {
+	return baseRepository[E]{BaseRepositoryImpl: r}
+}
+
+// WithContext retorna uma cópia do repositório vinculada ao contexto (ver BaseRepositoryImpl.WithContext)
+func (r baseRepository[E]) WithContext(ctx context.Context) BaseRepository[E] {
+	return baseRepository[E]{BaseRepositoryImpl: r.BaseRepositoryImpl.WithContext(ctx)}
+}
+
+// WithSelect retorna uma cópia do repositório que carrega apenas as colunas informadas (ver BaseRepositoryImpl.WithSelect)
+func (r baseRepository[E]) WithSelect(columns ...string) BaseRepository[E] {
+	return baseRepository[E]{BaseRepositoryImpl: r.BaseRepositoryImpl.WithSelect(columns...)}
+}
+
+// Unwrap retorna o repositório concreto por trás da interface
+// false quando a implementação não é o repositório base (ex: falsos dos testes)
+func Unwrap[E entity.Entity](repo BaseRepository[E]) (*BaseRepositoryImpl[E], bool) {
+	adapted, ok := repo.(baseRepository[E])
+	if !ok {
+		return nil, false
+	}
+	return adapted.BaseRepositoryImpl, true
+}
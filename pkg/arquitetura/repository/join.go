@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils"
+)
+
+// specResolver ColumnResolver das especificações: campos da entidade são qualificados com a tabela principal
+// e campos de relacionamentos (ex: Categoria.ativo) registram em joins o relacionamento a ser incluído com JOIN
+func (r *BaseRepositoryImpl[E]) specResolver(joins *[]string) ColumnResolver {
+	return func(name string) (clause.Column, *schema.Field, error) {
+		if !strings.Contains(name, ".") {
+			field, err := r.resolveColumn(name)
+			if err != nil {
+				return clause.Column{}, nil, err
+			}
+			return clause.Column{Table: clause.CurrentTable, Name: field.DBName}, field, nil
+		}
+
+		path, alias, field, err := r.relationColumn(name)
+		if err != nil {
+			return clause.Column{}, nil, err
+		}
+		if !slices.Contains(*joins, path) {
+			*joins = append(*joins, path)
+		}
+		return clause.Column{Table: alias, Name: field.DBName}, field, nil
+	}
+}
+
+// relationColumn localiza o campo de um relacionamento referenciado como Relacao.campo (ou Relacao.Sub.campo)
+// Retorna o caminho do relacionamento para o Joins do GORM (LEFT JOIN, respeitando a exclusão lógica da
+// tabela relacionada) e o alias da tabela na query. Apenas relacionamentos belongs-to e has-one são aceitos:
+// has-many e many-to-many repetiriam a entidade principal no resultado
+func (r *BaseRepositoryImpl[E]) relationColumn(name string) (string, string, *schema.Field, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(r.newEntity()); err != nil {
+		return "", "", nil, err
+	}
+
+	parts := strings.Split(name, ".")
+	current := stmt.Schema
+	path := make([]string, 0, len(parts)-1)
+	alias := ""
+	for _, relationName := range parts[:len(parts)-1] {
+		relation := lookupRelation(current, relationName)
+		if relation == nil {
+			return "", "", nil, fmt.Errorf("relacionamento %s não encontrado em %s", relationName, current.Table)
+		}
+		if relation.Type != schema.BelongsTo && relation.Type != schema.HasOne {
+			return "", "", nil, fmt.Errorf("relacionamento %s de %s não pode ser usado em filtros (apenas belongs-to e has-one)", relation.Name, current.Table)
+		}
+		path = append(path, relation.Name)
+		if alias == "" {
+			alias = relation.Name
+		} else {
+			alias = utils.NestedRelationName(alias, relation.Name)
+		}
+		current = relation.FieldSchema
+	}
+
+	fieldName := parts[len(parts)-1]
+	field := current.LookUpField(fieldName)
+	if field == nil {
+		return "", "", nil, fmt.Errorf("campo %s não encontrado em %s", fieldName, current.Table)
+	}
+	if field.DBName == "" {
+		return "", "", nil, fmt.Errorf("campo %s não possui coluna no banco", name)
+	}
+	return strings.Join(path, "."), alias, field, nil
+}
+
+// lookupRelation localiza o relacionamento pelo nome do campo Go, sem diferenciar maiúsculas (Categoria ou categoria)
+func lookupRelation(s *schema.Schema, name string) *schema.Relationship {
+	if relation, ok := s.Relationships.Relations[name]; ok {
+		return relation
+	}
+	for relationName, relation := range s.Relationships.Relations {
+		if strings.EqualFold(relationName, name) {
+			return relation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+// ListOptions reúne as opções das listagens paginadas (FindAllWithOptions e service.GetAll)
+// Novas capacidades das listagens entram como campos, sem alterar as assinaturas dos repositórios,
+// serviços e handlers. O valor zero lista a primeira página na ordenação padrão, com contagem exata
+type ListOptions struct {
+	Page           int           // Página, a partir de 1
+	PageSize       int           // Itens por página
+	Sort           Sort          // Ordenação validada (?sort=); tem precedência sobre OrderBy
+	OrderBy        string        // Ordenação SQL usada sem Sort (vazio = ordenação padrão)
+	Filters        Specification // Condições da listagem (ex: filtros da query string); nil = sem filtros
+	DateRange      DateRange     // Período de criação/atualização; vazio = sem período
+	CountMode      CountMode     // Modo de contagem; vazio = CountExact
+	Preloads       []string      // Relacionamentos carregados; nil = preloads padrão do repositório
+	Fields         []string      // Campos carregados (ver SelectColumns); vazio = todos, com os relacionamentos
+	IncludeDeleted bool          // Inclui os registros excluídos logicamente (lixeira)
+}
+
+// WithTotal define se a listagem calcula o total de registros (retorna uma cópia das opções para chaining)
+// Atalho para CountMode: false equivale a CountNone (apenas indica se há próxima página, sem COUNT(*));
+// true mantém o modo informado ou, sem modo, usa CountExact. ListOptions{WithTotal: false} não é um campo
+// porque o valor zero das opções precisa continuar com a contagem exata
+func (o ListOptions) WithTotal(enabled bool) ListOptions {
+	switch {
+	case !enabled:
+		o.CountMode = CountNone
+	case o.CountMode == "" || o.CountMode == CountNone:
+		o.CountMode = CountExact
+	}
+	return o
+}
+
+// Filtered indica se a listagem possui condições (filtros, período ou lixeira)
+// Listagens com condições não usam a contagem estimada nem o cache das listagens sem filtros
+func (o ListOptions) Filtered() bool {
+	return o.Filters != nil || !o.DateRange.IsEmpty() || o.IncludeDeleted
+}
+
+// CountWithOptions conta as entidades que atendem às condições das opções (filtros, período e lixeira),
+// ignorando paginação, ordenação e seleção de campos. Retorna o modo de contagem usado: a estimativa
+// (CountEstimated) só vale sem condições e com estatísticas da tabela; nos demais casos a contagem é exata
+func (r *BaseRepositoryImpl[E]) CountWithOptions(opts ListOptions) (int64, CountMode, error) {
+	if opts.CountMode == CountEstimated && !opts.Filtered() {
+		total, ok, err := r.estimateCount()
+		if err != nil {
+			return 0, "", err
+		}
+		if ok {
+			return total, CountEstimated, nil
+		}
+	}
+
+	base := r.db
+	if opts.IncludeDeleted {
+		base = base.Unscoped()
+	}
+	base, err := r.applySpec(opts.DateRange.apply(base, r.TableName()), opts.Filters)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var total int64
+	if err := base.Model(r.newEntity()).Count(&total).Error; err != nil {
+		return 0, "", err
+	}
+	return total, CountExact, nil
+}
+
+// FindAllWithOptions busca as entidades com paginação conforme as opções (ordenação, filtros, período,
+// seleção de campos, preloads e lixeira). Sem condições, equivale a FindAllWithCountMode
+func (r *BaseRepositoryImpl[E]) FindAllWithOptions(opts ListOptions) (*PageResult[E], error) {
+	orderBy := opts.OrderBy
+	if len(opts.Sort) > 0 {
+		var err error
+		if orderBy, err = r.SortOrder(opts.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	repo := r
+	if len(opts.Fields) > 0 {
+		columns, err := r.SelectColumns(opts.Fields)
+		if err != nil {
+			return nil, err
+		}
+		repo = r.WithSelect(columns...)
+	}
+
+	preloads := repo.preloads
+	if opts.Preloads != nil {
+		preloads = opts.Preloads
+	}
+
+	mode := opts.CountMode
+	if mode == "" {
+		mode = CountExact
+	}
+
+	base := repo.db
+	if opts.IncludeDeleted {
+		base = base.Unscoped()
+	}
+	base, err := repo.applySpec(opts.DateRange.apply(base, repo.TableName()), opts.Filters)
+	if err != nil {
+		return nil, err
+	}
+	return repo.findPage(base, opts.Filtered(), opts.Page, opts.PageSize, orderBy, preloads, mode)
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"errors"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrLockOutsideTransaction bloqueio de registro solicitado fora de uma transação
+var ErrLockOutsideTransaction = errors.New("bloqueio de registro exige uma transação")
+
+// FindByIDForUpdate busca uma entidade pelo ID bloqueando a linha (SELECT ... FOR UPDATE) até o fim da
+// transação: outras transações que tentarem bloquear ou alterar o registro aguardam o commit/rollback
+// Usado em fluxos de leitura-alteração-gravação concorrentes (ex: ajuste de estoque ou preço)
+// Deve ser chamado em um repositório vinculado a uma transação (WithTx, Transaction ou WithContext com
+// transação no contexto); fora dela retorna ErrLockOutsideTransaction. Os preloads não são bloqueados
+//
+// Ex:
+//
+//	err := repo.Transaction(ctx, func(ctx context.Context, repo *BaseRepositoryImpl[*models.Produto]) error {
+//		produto, err := repo.FindByIDForUpdate(id)
+//		...
+//		return repo.UpdateFields(id, map[string]interface{}{"preco": novoPreco})
+//	})
+func (r *BaseRepositoryImpl[E]) FindByIDForUpdate(id uint) (E, error) {
+	entity := r.newEntity()
+	if _, inTx := r.db.Statement.ConnPool.(gorm.TxCommitter); !inTx {
+		return entity, ErrLockOutsideTransaction
+	}
+
+	query := r.db.Clauses(clause.Locking{Strength: "UPDATE"})
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	err := query.First(entity, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity, arqerrors.ErrNotFound
+		}
+		return entity, err
+	}
+	return entity, nil
+}
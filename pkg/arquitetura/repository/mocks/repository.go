@@ -0,0 +1,673 @@
+// Package mocks contém implementações falsas dos repositórios da arquitetura para os testes dos serviços
+package mocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/repository"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ErrNotConfigured indica uma operação que o repositório falso não sabe simular em memória
+// (condições SQL, especificações e períodos): configure a função correspondente (ex: FindOneWhereFunc)
+var ErrNotConfigured = errors.New("operação não simulada pelo repositório falso")
+
+// Repository é o repositório falso (em memória) que implementa repository.BaseRepository
+// Permite testar os serviços sem banco: o CRUD por ID é simulado em memória (IDs sequenciais, datas
+// automáticas, exclusão lógica e lixeira) e as consultas por condição retornam ErrNotConfigured.
+// Cada operação pode ser substituída pela função correspondente (ex: CreateFunc), para simular erros
+// ou consultas específicas. As cópias de WithContext compartilham os dados
+//
+// Ex:
+//
+//	repo := mocks.NewRepository[*models.Categoria]()
+//	repo.CreateFunc = func(c *models.Categoria) error { return arqerrors.ErrDuplicateKey }
+//	svc := service.NewBaseService[*models.Categoria](repo, mapper, logger, config)
+type Repository[E entity.Entity] struct {
+	store *store[E]
+	ctx   context.Context
+
+	CreateFunc                    func(entity E) error
+	FindByIDFunc                  func(id uint) (E, error)
+	FindByPublicIDFunc            func(publicID uuid.UUID) (E, error)
+	FindOneWhereFunc              func(condition interface{}, args ...interface{}) (E, error)
+	FindByKeysFunc                func(column string, values []interface{}) (map[interface{}]E, error)
+	FindAllWithOptionsFunc        func(opts repository.ListOptions) (*repository.PageResult[E], error)
+	CountWithOptionsFunc          func(opts repository.ListOptions) (int64, repository.CountMode, error)
+	SearchFunc                    func(query string, opts repository.ListOptions) (*repository.PageResult[repository.SearchHit[E]], error)
+	FindAllWhereWithCountModeFunc func(page, pageSize int, orderBy string, mode repository.CountMode, condition interface{}, args ...interface{}) (*repository.PageResult[E], error)
+	FindAfterCursorFunc           func(cursor *repository.Cursor, limit int, orderBy string) (*repository.CursorResult[E], error)
+	FindInBatchesFunc             func(batchSize int, fn func(batch []E) error) error
+	FindDeletedFunc               func(page, pageSize int, orderBy string, mode repository.CountMode, dateRange repository.DateRange) (*repository.PageResult[E], error)
+	ExistsWhereFunc               func(condition interface{}, args ...interface{}) (bool, error)
+	UpdateFieldsFunc              func(id uint, fields map[string]interface{}) error
+	UpdateWhereFunc               func(condition interface{}, args []interface{}, values map[string]interface{}) (int64, error)
+	SaveAllFunc                   func(creates []E, updates []E) error
+	DeleteFunc                    func(id uint) error
+	IsDeletedFunc                 func(id uint) (bool, error)
+	RestoreFunc                   func(id uint) error
+	DeletePermanentlyFunc         func(id uint) error
+}
+
+// store dados em memória compartilhados entre as cópias do repositório falso
+type store[E entity.Entity] struct {
+	mu      sync.Mutex
+	items   map[uint]E
+	deleted map[uint]E
+	nextID  uint
+	schema  *schema.Schema
+	err     error
+}
+
+// NewRepository cria o repositório falso vazio, opcionalmente com as entidades iniciais (IDs zero são gerados)
+func NewRepository[E entity.Entity](seed ...E) *Repository[E] {
+	s := &store[E]{
+		items:   make(map[uint]E),
+		deleted: make(map[uint]E),
+		nextID:  1,
+	}
+	s.schema, s.err = schema.Parse(newEntity[E](), &sync.Map{}, schema.NamingStrategy{})
+
+	r := &Repository[E]{store: s, ctx: context.Background()}
+	for _, entity := range seed {
+		_ = r.create(entity)
+	}
+	return r
+}
+
+// Items retorna as entidades ativas (fora da lixeira) em ordem de ID, para as verificações dos testes
+func (r *Repository[E]) Items() []E {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.sorted(r.store.items)
+}
+
+// Deleted retorna as entidades na lixeira em ordem de ID
+func (r *Repository[E]) Deleted() []E {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	return r.sorted(r.store.deleted)
+}
+
+// WithContext retorna uma cópia do repositório com o contexto (os dados são compartilhados)
+func (r *Repository[E]) WithContext(ctx context.Context) repository.BaseRepository[E] {
+	if ctx == nil {
+		return r
+	}
+	clone := *r
+	clone.ctx = ctx
+	return &clone
+}
+
+// WithSelect retorna o próprio repositório: as entidades falsas são sempre carregadas por inteiro
+func (r *Repository[E]) WithSelect(columns ...string) repository.BaseRepository[E] {
+	return r
+}
+
+// GetDB retorna nil: o repositório falso não usa banco (o serviço executa as unidades de trabalho sem transação)
+func (r *Repository[E]) GetDB() *gorm.DB {
+	return nil
+}
+
+// TableName retorna o nome da tabela da entidade
+func (r *Repository[E]) TableName() string {
+	return newEntity[E]().TableName()
+}
+
+// Create grava a entidade, gerando o ID, o identificador público e as datas quando não informados
+func (r *Repository[E]) Create(entity E) error {
+	if r.CreateFunc != nil {
+		return r.CreateFunc(entity)
+	}
+	return r.create(entity)
+}
+
+// FindByID busca a entidade ativa pelo ID (ErrNotFound quando não existe ou está na lixeira)
+func (r *Repository[E]) FindByID(id uint) (E, error) {
+	if r.FindByIDFunc != nil {
+		return r.FindByIDFunc(id)
+	}
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if entity, ok := r.store.items[id]; ok {
+		return clone(entity), nil
+	}
+	return newEntity[E](), arqerrors.ErrNotFound
+}
+
+// FindByPublicID busca a entidade ativa pelo identificador público
+func (r *Repository[E]) FindByPublicID(publicID uuid.UUID) (E, error) {
+	if r.FindByPublicIDFunc != nil {
+		return r.FindByPublicIDFunc(publicID)
+	}
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	for _, entity := range r.store.items {
+		if entity.GetPublicID() == publicID {
+			return clone(entity), nil
+		}
+	}
+	return newEntity[E](), arqerrors.ErrNotFound
+}
+
+// FindOneWhere exige FindOneWhereFunc (condições SQL não são simuladas)
+func (r *Repository[E]) FindOneWhere(condition interface{}, args ...interface{}) (E, error) {
+	if r.FindOneWhereFunc != nil {
+		return r.FindOneWhereFunc(condition, args...)
+	}
+	return newEntity[E](), notConfigured("FindOneWhere")
+}
+
+// FindByKeys busca as entidades ativas cuja coluna possui um dos valores (valor da chave → entidade)
+func (r *Repository[E]) FindByKeys(column string, values []interface{}) (map[interface{}]E, error) {
+	if r.FindByKeysFunc != nil {
+		return r.FindByKeysFunc(column, values)
+	}
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	result := make(map[interface{}]E, len(values))
+	for _, entity := range r.store.items {
+		value, _ := field.ValueOf(r.ctx, reflect.ValueOf(entity))
+		for _, wanted := range values {
+			if reflect.DeepEqual(value, wanted) {
+				result[value] = clone(entity)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// FindAllWithOptions lista as entidades em ordem de ID (a lixeira com IncludeDeleted); filtros e período
+// exigem FindAllWithOptionsFunc. Ordenação, campos e preloads são ignorados
+func (r *Repository[E]) FindAllWithOptions(opts repository.ListOptions) (*repository.PageResult[E], error) {
+	if r.FindAllWithOptionsFunc != nil {
+		return r.FindAllWithOptionsFunc(opts)
+	}
+	if !opts.DateRange.IsEmpty() || opts.Filters != nil {
+		return nil, notConfigured("FindAllWithOptions")
+	}
+
+	mode := opts.CountMode
+	if mode == "" {
+		mode = repository.CountExact
+	}
+	if !opts.IncludeDeleted {
+		return r.page(r.store.items, opts.Page, opts.PageSize, mode), nil
+	}
+
+	r.store.mu.Lock()
+	all := make(map[uint]E, len(r.store.items)+len(r.store.deleted))
+	for id, entity := range r.store.items {
+		all[id] = entity
+	}
+	for id, entity := range r.store.deleted {
+		all[id] = entity
+	}
+	r.store.mu.Unlock()
+	return r.page(all, opts.Page, opts.PageSize, mode), nil
+}
+
+// CountWithOptions conta as entidades (com a lixeira em IncludeDeleted), sempre com contagem exata;
+// filtros e período exigem CountWithOptionsFunc
+func (r *Repository[E]) CountWithOptions(opts repository.ListOptions) (int64, repository.CountMode, error) {
+	if r.CountWithOptionsFunc != nil {
+		return r.CountWithOptionsFunc(opts)
+	}
+	if !opts.DateRange.IsEmpty() || opts.Filters != nil {
+		return 0, "", notConfigured("CountWithOptions")
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	total := len(r.store.items)
+	if opts.IncludeDeleted {
+		total += len(r.store.deleted)
+	}
+	return int64(total), repository.CountExact, nil
+}
+
+// Searchable indica se a entidade declara colunas de busca (entity.Searchable)
+func (r *Repository[E]) Searchable() bool {
+	searchable, ok := any(newEntity[E]()).(entity.Searchable)
+	return ok && len(searchable.SearchColumns()) > 0
+}
+
+// Search exige SearchFunc (a busca textual não é simulada); retorna repository.ErrNotSearchable
+// quando a entidade não declara colunas de busca, como o repositório base
+func (r *Repository[E]) Search(query string, opts repository.ListOptions) (*repository.PageResult[repository.SearchHit[E]], error) {
+	if r.SearchFunc != nil {
+		return r.SearchFunc(query, opts)
+	}
+	if !r.Searchable() {
+		return nil, repository.ErrNotSearchable
+	}
+	return nil, notConfigured("Search")
+}
+
+// FindAllWhereWithCountMode exige FindAllWhereWithCountModeFunc (condições SQL não são simuladas)
+func (r *Repository[E]) FindAllWhereWithCountMode(page, pageSize int, orderBy string, mode repository.CountMode, condition interface{}, args ...interface{}) (*repository.PageResult[E], error) {
+	if r.FindAllWhereWithCountModeFunc != nil {
+		return r.FindAllWhereWithCountModeFunc(page, pageSize, orderBy, mode, condition, args...)
+	}
+	return nil, notConfigured("FindAllWhereWithCountMode")
+}
+
+// FindAfterCursor lista as entidades ativas com ID maior que o do cursor, em ordem de ID (orderBy é ignorado)
+func (r *Repository[E]) FindAfterCursor(cursor *repository.Cursor, limit int, orderBy string) (*repository.CursorResult[E], error) {
+	if r.FindAfterCursorFunc != nil {
+		return r.FindAfterCursorFunc(cursor, limit, orderBy)
+	}
+
+	r.store.mu.Lock()
+	items := r.sorted(r.store.items)
+	r.store.mu.Unlock()
+
+	result := &repository.CursorResult[E]{Items: []E{}}
+	for _, entity := range items {
+		if cursor != nil && entity.GetID() <= cursor.ID {
+			continue
+		}
+		if len(result.Items) == limit {
+			result.HasNext = true
+			break
+		}
+		result.Items = append(result.Items, entity)
+	}
+	if result.HasNext {
+		last := result.Items[len(result.Items)-1]
+		result.NextCursor = repository.EncodeCursor(repository.Cursor{ID: last.GetID()})
+	}
+	return result, nil
+}
+
+// FindInBatches percorre as entidades ativas em lotes, em ordem de ID, interrompendo no primeiro erro de fn
+func (r *Repository[E]) FindInBatches(batchSize int, fn func(batch []E) error) error {
+	if r.FindInBatchesFunc != nil {
+		return r.FindInBatchesFunc(batchSize, fn)
+	}
+
+	r.store.mu.Lock()
+	items := r.sorted(r.store.items)
+	r.store.mu.Unlock()
+
+	for start := 0; start < len(items); start += batchSize {
+		end := min(start+batchSize, len(items))
+		if err := fn(items[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindDeleted lista as entidades da lixeira em ordem de ID; período exige FindDeletedFunc
+func (r *Repository[E]) FindDeleted(page, pageSize int, orderBy string, mode repository.CountMode, dateRange repository.DateRange) (*repository.PageResult[E], error) {
+	if r.FindDeletedFunc != nil {
+		return r.FindDeletedFunc(page, pageSize, orderBy, mode, dateRange)
+	}
+	if !dateRange.IsEmpty() {
+		return nil, notConfigured("FindDeleted")
+	}
+	return r.page(r.store.deleted, page, pageSize, mode), nil
+}
+
+// ExistsWhere exige ExistsWhereFunc (condições SQL não são simuladas)
+func (r *Repository[E]) ExistsWhere(condition interface{}, args ...interface{}) (bool, error) {
+	if r.ExistsWhereFunc != nil {
+		return r.ExistsWhereFunc(condition, args...)
+	}
+	return false, notConfigured("ExistsWhere")
+}
+
+// UpdateFields atualiza as colunas informadas (nome da coluna ou do campo Go) da entidade ativa
+func (r *Repository[E]) UpdateFields(id uint, fields map[string]interface{}) error {
+	if r.UpdateFieldsFunc != nil {
+		return r.UpdateFieldsFunc(id, fields)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	stored, ok := r.store.items[id]
+	if !ok {
+		return arqerrors.ErrNotFound
+	}
+
+	updated := clone(stored)
+	target := reflect.ValueOf(updated)
+	for name, value := range fields {
+		field, err := r.lookupField(name)
+		if err != nil {
+			return err
+		}
+		if field.PrimaryKey {
+			return fmt.Errorf("a chave primária %s não pode ser alterada", field.DBName)
+		}
+		if err := field.Set(r.ctx, target, value); err != nil {
+			return err
+		}
+	}
+	r.touch(target, false)
+	r.store.items[id] = updated
+	return nil
+}
+
+// UpdateWhere exige UpdateWhereFunc (condições SQL não são simuladas)
+func (r *Repository[E]) UpdateWhere(condition interface{}, args []interface{}, values map[string]interface{}) (int64, error) {
+	if r.UpdateWhereFunc != nil {
+		return r.UpdateWhereFunc(condition, args, values)
+	}
+	return 0, notConfigured("UpdateWhere")
+}
+
+// SaveAll grava as novas entidades e substitui as existentes (ErrNotFound quando uma delas não existe)
+func (r *Repository[E]) SaveAll(creates []E, updates []E) error {
+	if r.SaveAllFunc != nil {
+		return r.SaveAllFunc(creates, updates)
+	}
+
+	r.store.mu.Lock()
+	for _, entity := range updates {
+		if _, ok := r.store.items[entity.GetID()]; !ok {
+			r.store.mu.Unlock()
+			return arqerrors.ErrNotFound
+		}
+	}
+	for _, entity := range updates {
+		r.touch(reflect.ValueOf(entity), false)
+		r.store.items[entity.GetID()] = clone(entity)
+	}
+	r.store.mu.Unlock()
+
+	for _, entity := range creates {
+		if err := r.create(entity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete move a entidade para a lixeira (ou a remove, se a entidade não tem exclusão lógica)
+func (r *Repository[E]) Delete(id uint) error {
+	if r.DeleteFunc != nil {
+		return r.DeleteFunc(id)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	entity, ok := r.store.items[id]
+	if !ok {
+		return arqerrors.ErrNotFound
+	}
+	delete(r.store.items, id)
+	if field := r.deletedAtField(); field != nil {
+		_ = field.Set(r.ctx, reflect.ValueOf(entity), gorm.DeletedAt{Time: time.Now(), Valid: true})
+		r.store.deleted[id] = entity
+	}
+	return nil
+}
+
+// IsDeleted indica se a entidade está na lixeira
+func (r *Repository[E]) IsDeleted(id uint) (bool, error) {
+	if r.IsDeletedFunc != nil {
+		return r.IsDeletedFunc(id)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	_, ok := r.store.deleted[id]
+	return ok, nil
+}
+
+// Restore retira a entidade da lixeira (ErrNotFound quando ela não está na lixeira)
+func (r *Repository[E]) Restore(id uint) error {
+	if r.RestoreFunc != nil {
+		return r.RestoreFunc(id)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	entity, ok := r.store.deleted[id]
+	if !ok {
+		return arqerrors.ErrNotFound
+	}
+	delete(r.store.deleted, id)
+	_ = r.deletedAtField().Set(r.ctx, reflect.ValueOf(entity), gorm.DeletedAt{})
+	r.store.items[id] = entity
+	return nil
+}
+
+// DeletePermanently remove definitivamente a entidade da lixeira (ErrNotFound quando ela não está na lixeira)
+func (r *Repository[E]) DeletePermanently(id uint) error {
+	if r.DeletePermanentlyFunc != nil {
+		return r.DeletePermanentlyFunc(id)
+	}
+
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+	if _, ok := r.store.deleted[id]; !ok {
+		return arqerrors.ErrNotFound
+	}
+	delete(r.store.deleted, id)
+	return nil
+}
+
+// SortOrder valida os campos da ordenação e a retorna no formato "coluna [DESC]" (o falso lista sempre por ID)
+func (r *Repository[E]) SortOrder(sort repository.Sort) (string, error) {
+	if len(sort) == 0 {
+		return "id ASC", nil
+	}
+	parts := make([]string, 0, len(sort))
+	for _, s := range sort {
+		field, err := r.lookupField(s.Field)
+		if err != nil {
+			return "", err
+		}
+		part := field.DBName
+		if s.Desc {
+			part += " DESC"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+// SelectColumns valida os campos e retorna as colunas correspondentes, sempre incluindo o ID
+func (r *Repository[E]) SelectColumns(fields []string) ([]string, error) {
+	columns := []string{"id"}
+	for _, name := range fields {
+		field, err := r.lookupField(name)
+		if err != nil {
+			return nil, err
+		}
+		if field.DBName != "id" {
+			columns = append(columns, field.DBName)
+		}
+	}
+	return columns, nil
+}
+
+// ColumnValues retorna os valores das colunas graváveis da entidade (ver BaseRepositoryImpl.ColumnValues)
+func (r *Repository[E]) ColumnValues(entity E) (map[string]interface{}, error) {
+	if r.store.err != nil {
+		return nil, r.store.err
+	}
+	target := reflect.ValueOf(entity)
+	values := make(map[string]interface{})
+	for _, field := range r.store.schema.Fields {
+		if field.DBName == "" || !field.Updatable || field.PrimaryKey ||
+			field.AutoCreateTime > 0 || field.AutoUpdateTime > 0 || field.FieldType == reflect.TypeOf(gorm.DeletedAt{}) {
+			continue
+		}
+		values[field.DBName], _ = field.ValueOf(r.ctx, target)
+	}
+	return values, nil
+}
+
+// ChangedColumns retorna as colunas alteradas desde ColumnValues (ver BaseRepositoryImpl.ChangedColumns)
+func (r *Repository[E]) ChangedColumns(before map[string]interface{}, entity E) (map[string]interface{}, error) {
+	after, err := r.ColumnValues(entity)
+	if err != nil {
+		return nil, err
+	}
+	changed := make(map[string]interface{})
+	for column, value := range after {
+		if old, ok := before[column]; !ok || !reflect.DeepEqual(old, value) {
+			changed[column] = value
+		}
+	}
+	return changed, nil
+}
+
+// KeyValue retorna o valor da coluna (ou campo Go) informada na entidade
+func (r *Repository[E]) KeyValue(entity E, column string) (interface{}, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+	value, _ := field.ValueOf(r.ctx, reflect.ValueOf(entity))
+	return value, nil
+}
+
+// create grava uma cópia da entidade, preenchendo ID, identificador público e datas quando vazios
+func (r *Repository[E]) create(entity E) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	id := entity.GetID()
+	if id == 0 {
+		id = r.store.nextID
+		entity.SetID(id)
+	}
+	if _, exists := r.store.items[id]; exists {
+		return arqerrors.ErrDuplicateKey
+	}
+	if id >= r.store.nextID {
+		r.store.nextID = id + 1
+	}
+
+	target := reflect.ValueOf(entity)
+	if field := r.field("PublicID"); field != nil && entity.GetPublicID() == uuid.Nil {
+		_ = field.Set(r.ctx, target, uuid.New())
+	}
+	r.touch(target, true)
+	r.store.items[id] = clone(entity)
+	return nil
+}
+
+// touch preenche as datas automáticas (created_at apenas na criação e quando vazia)
+func (r *Repository[E]) touch(target reflect.Value, creating bool) {
+	if r.store.schema == nil {
+		return
+	}
+	now := time.Now()
+	for _, field := range r.store.schema.Fields {
+		if field.AutoUpdateTime > 0 {
+			_ = field.Set(r.ctx, target, now)
+		}
+		if creating && field.AutoCreateTime > 0 {
+			if _, zero := field.ValueOf(r.ctx, target); zero {
+				_ = field.Set(r.ctx, target, now)
+			}
+		}
+	}
+}
+
+// page monta a página das entidades (em ordem de ID); chamado sem o lock
+func (r *Repository[E]) page(source map[uint]E, page, pageSize int, mode repository.CountMode) *repository.PageResult[E] {
+	r.store.mu.Lock()
+	items := r.sorted(source)
+	r.store.mu.Unlock()
+
+	start := min(max(page-1, 0)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+	result := &repository.PageResult[E]{
+		Items:     items[start:end],
+		HasNext:   end < len(items),
+		CountMode: mode,
+	}
+	if mode != repository.CountNone {
+		result.Total = int64(len(items))
+	}
+	return result
+}
+
+// sorted retorna cópias das entidades em ordem de ID (chamado com o lock)
+func (r *Repository[E]) sorted(source map[uint]E) []E {
+	items := make([]E, 0, len(source))
+	for _, entity := range source {
+		items = append(items, clone(entity))
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].GetID() < items[j].GetID() })
+	return items
+}
+
+// lookupField localiza o campo pelo nome da coluna ou do campo Go
+func (r *Repository[E]) lookupField(name string) (*schema.Field, error) {
+	if r.store.err != nil {
+		return nil, r.store.err
+	}
+	field := r.store.schema.LookUpField(name)
+	if field == nil {
+		return nil, fmt.Errorf("campo %s não encontrado em %s", name, r.store.schema.Table)
+	}
+	return field, nil
+}
+
+// field localiza o campo pelo nome, nil quando a entidade não o possui
+func (r *Repository[E]) field(name string) *schema.Field {
+	if r.store.schema == nil {
+		return nil
+	}
+	return r.store.schema.LookUpField(name)
+}
+
+// deletedAtField campo da exclusão lógica (nil quando a entidade não tem lixeira)
+func (r *Repository[E]) deletedAtField() *schema.Field {
+	if r.store.schema == nil {
+		return nil
+	}
+	for _, field := range r.store.schema.Fields {
+		if field.FieldType == reflect.TypeOf(gorm.DeletedAt{}) {
+			return field
+		}
+	}
+	return nil
+}
+
+// notConfigured erro das operações sem simulação em memória
+func notConfigured(method string) error {
+	return fmt.Errorf("%w: configure %sFunc", ErrNotConfigured, method)
+}
+
+// newEntity cria uma nova instância da entidade (E é um tipo ponteiro)
+func newEntity[E entity.Entity]() E {
+	var zero E
+	return reflect.New(reflect.TypeOf(zero).Elem()).Interface().(E)
+}
+
+// clone copia a entidade (cópia rasa), para que alterações fora do repositório não mudem os dados gravados
+func clone[E entity.Entity](entity E) E {
+	copied := reflect.New(reflect.ValueOf(entity).Elem().Type())
+	copied.Elem().Set(reflect.ValueOf(entity).Elem())
+	return copied.Interface().(E)
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
+)
+
+// CountMode define como o total de registros é calculado nas listagens paginadas
+type CountMode string
+
+const (
+	// CountExact executa COUNT(*) com as mesmas condições da listagem (padrão)
+	CountExact CountMode = "exact"
+
+	// CountNone não calcula o total; apenas indica se existe próxima página
+	CountNone CountMode = "none"
+
+	// CountEstimated usa a estimativa do PostgreSQL (pg_class.reltuples)
+	// Aplicável apenas a listagens sem condições; com condições, usa a contagem exata
+	CountEstimated CountMode = "estimated"
+)
+
+// ParseCountMode converte o valor informado (ex: query string) para CountMode
+// Valor vazio resulta em CountExact
+func ParseCountMode(value string) (CountMode, bool) {
+	switch CountMode(value) {
+	case "", CountExact:
+		return CountExact, true
+	case CountNone:
+		return CountNone, true
+	case CountEstimated:
+		return CountEstimated, true
+	default:
+		return "", false
+	}
+}
+
+// PageResult representa o resultado de uma consulta paginada
+type PageResult[E any] struct {
+	Items     []E
+	Total     int64     // Total de registros (não preenchido em CountNone)
+	HasNext   bool      // Indica se existe uma próxima página
+	CountMode CountMode // Modo de contagem efetivamente utilizado
+}
+
+// FindAllWithCountMode retorna todas as entidades com paginação e o modo de contagem informado
+func (r *BaseRepositoryImpl[E]) FindAllWithCountMode(page, pageSize int, orderBy string, mode CountMode) (*PageResult[E], error) {
+	return r.findPage(r.db, false, page, pageSize, orderBy, r.preloads, mode)
+}
+
+// FindAllWhereWithCountMode busca entidades com condições, paginação e o modo de contagem informado
+func (r *BaseRepositoryImpl[E]) FindAllWhereWithCountMode(page, pageSize int, orderBy string, mode CountMode, condition interface{}, args ...interface{}) (*PageResult[E], error) {
+	return r.findPage(r.db.Where(condition, args...), true, page, pageSize, orderBy, r.preloads, mode)
+}
+
+// findPage executa a contagem e a busca paginada sobre a query base informada
+// filtered indica se a query possui condições (a estimativa só vale para a tabela inteira)
+// Com ParallelCount habilitado, a contagem e a busca da página são executadas concorrentemente
+func (r *BaseRepositoryImpl[E]) findPage(base *gorm.DB, filtered bool, page, pageSize int, orderBy string, preloads []string, mode CountMode) (*PageResult[E], error) {
+	if mode == CountEstimated && filtered {
+		mode = CountExact
+	}
+
+	result := &PageResult[E]{CountMode: mode}
+
+	offset := (page - 1) * pageSize
+
+	order := orderBy
+	if order == "" {
+		order = r.defaultOrder
+	}
+
+	// Sem contagem, busca um registro a mais para saber se existe próxima página
+	limit := pageSize
+	if mode == CountNone {
+		limit++
+	}
+
+	count := func() error {
+		switch mode {
+		case CountNone:
+			return nil
+		case CountEstimated:
+			total, ok, err := r.estimateCount()
+			if err != nil {
+				return err
+			}
+			if ok {
+				result.Total = total
+				return nil
+			}
+			// Tabela ainda sem estatísticas: usa a contagem exata
+			result.CountMode = CountExact
+		}
+		return base.Session(&gorm.Session{}).Model(r.newEntity()).Count(&result.Total).Error
+	}
+
+	var entities []E
+	find := func() error {
+		query := base.Session(&gorm.Session{})
+		if len(r.columns) > 0 {
+			// Seleção parcial: os relacionamentos não fazem parte das colunas e não são carregados
+			// As colunas são qualificadas com a tabela para não serem ambíguas nos filtros com JOIN
+			query = query.Select(r.qualifiedColumns())
+			preloads = nil
+		}
+		for _, preload := range preloads {
+			query = query.Preload(preload)
+		}
+		return query.Offset(offset).Limit(limit).Order(order).Find(&entities).Error
+	}
+
+	if r.options.ParallelCount && mode != CountNone {
+		var g errgroup.Group
+		g.Go(count)
+		g.Go(find)
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := count(); err != nil {
+			return nil, err
+		}
+		if err := find(); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.CountMode == CountNone {
+		if len(entities) > pageSize {
+			entities = entities[:pageSize]
+			result.HasNext = true
+		}
+	} else {
+		result.HasNext = int64(offset+len(entities)) < result.Total
+	}
+
+	result.Items = entities
+	return result, nil
+}
+
+// estimateCount retorna a estimativa de registros da tabela (pg_class.reltuples)
+// ok é false quando a tabela ainda não possui estatísticas
+func (r *BaseRepositoryImpl[E]) estimateCount() (int64, bool, error) {
+	var estimate float64
+	err := r.db.Raw(
+		"SELECT reltuples FROM pg_class WHERE oid = to_regclass(?)",
+		r.newEntity().TableName(),
+	).Scan(&estimate).Error
+	if err != nil {
+		return 0, false, err
+	}
+	if estimate < 0 {
+		return 0, false, nil
+	}
+	return int64(estimate), true, nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// PluckIDs retorna apenas os IDs das entidades que atendem a condição, em ordem crescente
+// condition é opcional (nil considera todos os registros). Projeção leve para quando só os IDs
+// interessam, evitando carregar as entidades
+// Ex: produtoRepo.PluckIDs("categoria_id = ?", categoriaID)
+func (r *BaseRepositoryImpl[E]) PluckIDs(condition interface{}, args ...interface{}) ([]uint, error) {
+	return Pluck[uint](r, "id", condition, args...)
+}
+
+// Pluck retorna os valores de uma coluna das entidades que atendem a condição, na ordem da chave primária
+// T é o tipo da coluna (ex: string para códigos); condition é opcional (nil considera todos os registros)
+// Diferente de DistinctValues, valores repetidos e nulos são mantidos (um por registro)
+// Função genérica porque métodos em Go não possuem parâmetros de tipo próprios
+// Ex: repository.Pluck[string](produtoRepo.BaseRepositoryImpl, "codigo", "preco > ?", 100)
+func Pluck[T any, E entity.Entity](r *BaseRepositoryImpl[E], column string, condition interface{}, args ...interface{}) ([]T, error) {
+	field, err := r.lookupField(column)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.Model(r.newEntity())
+	if condition != nil {
+		query = query.Where(condition, args...)
+	}
+
+	values := make([]T, 0)
+	err = query.Order(r.TableName()+".id").Pluck(field.DBName, &values).Error
+	return values, err
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+)
+
+// FindRaw executa uma consulta SQL escrita à mão e retorna as entidades resultantes
+// Para relatórios e consultas que os helpers não cobrem; as colunas do SELECT são associadas aos campos
+// da entidade pelo nome. Preloads e o filtro de exclusão lógica não são aplicados: a consulta deve
+// incluir "deleted_at IS NULL" quando necessário. Sem resultados, retorna lista vazia
+// Ex: repo.FindRaw("SELECT * FROM produtos WHERE preco > ? AND deleted_at IS NULL ORDER BY preco DESC", 100)
+func (r *BaseRepositoryImpl[E]) FindRaw(sql string, args ...interface{}) ([]E, error) {
+	entities := make([]E, 0)
+	err := r.db.Raw(sql, args...).Scan(&entities).Error
+	return entities, err
+}
+
+// FindOneRaw executa uma consulta SQL escrita à mão e retorna a primeira entidade
+// Retorna arqerrors.ErrNotFound quando a consulta não retorna registros (ver FindRaw)
+func (r *BaseRepositoryImpl[E]) FindOneRaw(sql string, args ...interface{}) (E, error) {
+	entity := r.newEntity()
+	result := r.db.Raw(sql, args...).Scan(entity)
+	if result.Error != nil {
+		return entity, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return entity, arqerrors.ErrNotFound
+	}
+	return entity, nil
+}
+
+// ScanRaw executa uma consulta SQL escrita à mão e associa as linhas ao tipo T pelo nome das colunas
+// T é tipicamente uma struct de relatório (ex: struct{ Categoria string; Total int64 }) ou um tipo
+// simples para consultas de uma coluna. Sem resultados, retorna lista vazia
+// Função genérica porque métodos em Go não possuem parâmetros de tipo próprios
+// Ex: repository.ScanRaw[Linha](produtoRepo.BaseRepositoryImpl, "SELECT ... GROUP BY ...", args...)
+func ScanRaw[T any, E entity.Entity](r *BaseRepositoryImpl[E], sql string, args ...interface{}) ([]T, error) {
+	rows := make([]T, 0)
+	err := r.db.Raw(sql, args...).Scan(&rows).Error
+	return rows, err
+}
+
+// ScanOneRaw executa uma consulta SQL escrita à mão e associa a primeira linha ao tipo T
+// Retorna arqerrors.ErrNotFound quando a consulta não retorna registros (ver ScanRaw)
+func ScanOneRaw[T any, E entity.Entity](r *BaseRepositoryImpl[E], sql string, args ...interface{}) (T, error) {
+	var row T
+	result := r.db.Raw(sql, args...).Scan(&row)
+	if result.Error != nil {
+		return row, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return row, arqerrors.ErrNotFound
+	}
+	return row, nil
+}
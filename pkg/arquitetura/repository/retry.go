@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// RetryPolicy política de repetição das escritas que falham por conflito de concorrência no Postgres
+// (falha de serialização 40001 e deadlock 40P01). Esses erros não indicam dados inválidos: a mesma
+// escrita costuma ter sucesso logo em seguida, quando a transação concorrente termina
+type RetryPolicy struct {
+	MaxAttempts int           // Tentativas no total, incluindo a primeira (1 ou menos = sem repetição)
+	BaseDelay   time.Duration // Espera máxima antes da segunda tentativa, dobrada a cada nova tentativa
+	MaxDelay    time.Duration // Limite da espera entre tentativas (zero = sem limite)
+}
+
+// DefaultRetryPolicy política sugerida: até 3 tentativas com espera de até 50ms, 100ms...
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   50 * time.Millisecond,
+	MaxDelay:    time.Second,
+}
+
+// enabled indica se a política repete as escritas
+func (p RetryPolicy) enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// Delay espera antes da tentativa seguinte a attempt (1 = primeira): backoff exponencial com jitter
+// completo (valor aleatório entre zero e o limite), para que as transações em conflito não se repitam juntas
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	limit := p.BaseDelay << (attempt - 1)
+	if limit <= 0 || (p.MaxDelay > 0 && limit > p.MaxDelay) {
+		limit = p.MaxDelay
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(limit) + 1))
+}
+
+// Códigos do Postgres (SQLSTATE) das falhas de concorrência repetidas por Retry
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// IsRetryable indica se o erro é uma falha de serialização (40001) ou um deadlock (40P01) do Postgres,
+// inclusive quando já convertido em erro de negócio (CONCURRENT_UPDATE) pelo repositório
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+}
+
+// Retry executa fn repetindo as falhas de concorrência (IsRetryable) conforme a política, com espera
+// exponencial e jitter entre as tentativas. Demais erros são retornados imediatamente; o cancelamento
+// do contexto interrompe a espera e retorna o último erro
+//
+// fn deve ser a unidade inteira a repetir: dentro de uma transação, o Postgres rejeita qualquer comando
+// após a falha, então a repetição deve envolver a transação (RunInTransactionWithRetry)
+func Retry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || !IsRetryable(err) || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(policy.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// RunInTransactionWithRetry executa fn em uma transação (RunInTransaction), repetindo a transação inteira
+// nas falhas de concorrência. fn pode ser executada mais de uma vez e não deve ter efeitos fora do banco
+// (use AfterCommit, que roda apenas após o commit da tentativa bem-sucedida)
+// Havendo transação no contexto, fn roda em um savepoint sem repetição: a falha aborta a transação
+// externa, que é quem pode ser repetida
+func RunInTransactionWithRetry(ctx context.Context, db *gorm.DB, policy RetryPolicy, fn func(ctx context.Context) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return RunInTransaction(ctx, db, fn)
+	}
+	return Retry(ctx, policy, func() error {
+		return RunInTransaction(ctx, db, fn)
+	})
+}
+
+// WithRetry configura a repetição das escritas deste repositório nas falhas de concorrência
+// (retorna o próprio repositório para chaining). Ver RetryPolicy e Options.Retry
+func (r *BaseRepositoryImpl[E]) WithRetry(policy RetryPolicy) *BaseRepositoryImpl[E] {
+	r.options.Retry = policy
+	return r
+}
+
+// retryWrite executa a escrita com a política de repetição do repositório
+// Em uma transação (do contexto ou WithTx), a escrita não é repetida: após a falha, a transação está
+// abortada e apenas quem a abriu pode repeti-la
+func (r *BaseRepositoryImpl[E]) retryWrite(write func() error) error {
+	if !r.options.Retry.enabled() {
+		return write()
+	}
+	if _, inTx := r.db.Statement.ConnPool.(gorm.TxCommitter); inTx {
+		return write()
+	}
+	return Retry(r.db.Statement.Context, r.options.Retry, write)
+}
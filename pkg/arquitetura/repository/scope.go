@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// ErrScopeNotRegistered indica que Scoped recebeu um escopo não registrado com WithScope
+var ErrScopeNotRegistered = errors.New("escopo não registrado no repositório")
+
+// Scope condição reutilizável de consulta (escopo do GORM), registrada no repositório com WithScope
+// Ex: func(db *gorm.DB) *gorm.DB { return db.Where("ativo = ?", true) }
+type Scope func(db *gorm.DB) *gorm.DB
+
+// WithScope registra um escopo nomeado (retorna o próprio repositório para chaining)
+// Evita repetir a mesma condição em vários serviços: o escopo é aplicado por chamada com Scoped
+func (r *BaseRepositoryImpl[E]) WithScope(name string, scope Scope) *BaseRepositoryImpl[E] {
+	scopes := make(map[string]Scope, len(r.scopes)+1)
+	for registered, s := range r.scopes {
+		scopes[registered] = s
+	}
+	scopes[name] = scope
+	r.scopes = scopes
+	return r
+}
+
+// Scoped retorna uma cópia do repositório com os escopos informados aplicados a todas as consultas e
+// escritas (inclusive após WithContext/WithTx). Com escopo não registrado, todas as operações da cópia
+// retornam ErrScopeNotRegistered
+// Ex: repo.Scoped("Ativas").WithContext(ctx).FindAllWithCountMode(1, 20, "nome ASC", CountExact)
+func (r *BaseRepositoryImpl[E]) Scoped(names ...string) *BaseRepositoryImpl[E] {
+	if len(names) == 0 {
+		return r
+	}
+
+	scopes := make([]func(*gorm.DB) *gorm.DB, 0, len(names))
+	for _, name := range names {
+		scope, ok := r.scopes[name]
+		if !ok {
+			scope = scopeError(fmt.Errorf("%w: %s (%s)", ErrScopeNotRegistered, name, r.TableName()))
+		}
+		scopes = append(scopes, scope)
+	}
+
+	clone := *r
+	clone.db = r.db.Scopes(scopes...).Session(&gorm.Session{})
+	clone.applied = append(append([]func(*gorm.DB) *gorm.DB{}, r.applied...), scopes...)
+	return &clone
+}
+
+// withScopes reaplica os escopos de Scoped a uma nova conexão (ex: a transação do contexto)
+func (r *BaseRepositoryImpl[E]) withScopes(db *gorm.DB) *gorm.DB {
+	if len(r.applied) == 0 {
+		return db
+	}
+	return db.Scopes(r.applied...).Session(&gorm.Session{})
+}
+
+// scopeError escopo que registra o erro na consulta, retornado pela operação antes de executar o SQL
+func scopeError(err error) Scope {
+	return func(db *gorm.DB) *gorm.DB {
+		_ = db.AddError(err)
+		return db
+	}
+}
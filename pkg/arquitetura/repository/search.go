@@ -0,0 +1,311 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrNotSearchable indica uma busca textual em entidade que não implementa entity.Searchable
+var ErrNotSearchable = errors.New("entidade sem colunas de busca textual")
+
+// SearchLanguage configuração de texto do PostgreSQL usada no vetor de busca, nas consultas e nos índices
+const SearchLanguage = "portuguese"
+
+// Marcadores dos termos encontrados nos trechos destacados (SearchHit.Highlights)
+const (
+	HighlightStart = "<mark>"
+	HighlightStop  = "</mark>"
+)
+
+// SearchHit é um resultado da busca textual: a entidade, a relevância e os trechos destacados
+type SearchHit[E any] struct {
+	Entity E
+	Rank   float64 // Relevância (ts_rank ponderado pelos pesos das colunas + similaridade trigram)
+	// Highlights trechos das colunas com os termos entre <mark></mark>, por coluna; apenas as colunas
+	// com termos encontrados pelo texto completo (correspondências só por trigram não são destacadas)
+	Highlights map[string]string
+}
+
+// Searchable indica se a entidade do repositório participa da busca textual (entity.Searchable)
+func (r *BaseRepositoryImpl[E]) Searchable() bool {
+	_, err := r.searchColumns()
+	return err == nil
+}
+
+// Search busca as entidades pelo texto informado nas colunas de entity.Searchable, ordenadas pela relevância
+// A consulta aceita a sintaxe de websearch_to_tsquery ("frase exata", -exclusão, OR) e também encontra
+// trechos de palavras (ILIKE) e grafias aproximadas (similaridade trigram, pg_trgm)
+// Das opções valem paginação, contagem, filtros, período, preloads, campos e lixeira; Sort e OrderBy são
+// ignorados (a ordem é a relevância, desempatada pelo ID). Texto vazio retorna uma página vazia
+func (r *BaseRepositoryImpl[E]) Search(query string, opts ListOptions) (*PageResult[SearchHit[E]], error) {
+	columns, err := r.searchColumns()
+	if err != nil {
+		return nil, err
+	}
+
+	mode := opts.CountMode
+	if mode == "" || mode == CountEstimated {
+		// A estimativa vale apenas para a tabela inteira
+		mode = CountExact
+	}
+	result := &PageResult[SearchHit[E]]{Items: []SearchHit[E]{}, CountMode: mode}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return result, nil
+	}
+
+	base := r.db
+	if opts.IncludeDeleted {
+		base = base.Unscoped()
+	}
+	base, err = r.applySpec(opts.DateRange.apply(base, r.TableName()), opts.Filters)
+	if err != nil {
+		return nil, err
+	}
+	base = base.Model(r.newEntity()).Clauses(clause.Where{Exprs: []clause.Expression{r.searchCondition(columns, query)}})
+
+	if mode == CountExact {
+		if err := base.Session(&gorm.Session{}).Count(&result.Total).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	offset := (opts.Page - 1) * opts.PageSize
+	limit := opts.PageSize
+	if mode == CountNone {
+		// Sem contagem, busca um registro a mais para saber se existe próxima página
+		limit++
+	}
+
+	hits, err := r.searchPage(base, columns, query, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == CountNone {
+		if len(hits) > opts.PageSize {
+			hits = hits[:opts.PageSize]
+			result.HasNext = true
+		}
+	} else {
+		result.HasNext = int64(offset+len(hits)) < result.Total
+	}
+
+	if result.Items, err = r.loadSearchHits(hits, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EnsureSearchIndexes cria os índices da busca textual da entidade (idempotente, chamado na migração):
+// a extensão pg_trgm, um índice GIN sobre o vetor de busca (idx_<tabela>_search) e um índice GIN trigram
+// por coluna (idx_<tabela>_<coluna>_trgm), usado pelo ILIKE e pela similaridade
+func EnsureSearchIndexes(db *gorm.DB, model entity.Entity) error {
+	searchable, ok := model.(entity.Searchable)
+	if !ok || len(searchable.SearchColumns()) == 0 {
+		return ErrNotSearchable
+	}
+	columns := searchable.SearchColumns()
+	table := model.TableName()
+
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return fmt.Errorf("criando extensão pg_trgm: %w", err)
+	}
+
+	statements := []string{fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN ((%s))",
+		quoteName(db, "idx_"+table+"_search"), quoteName(db, table), searchVector(db, "", columns))}
+	for _, column := range columns {
+		statements = append(statements, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s gin_trgm_ops)",
+			quoteName(db, "idx_"+table+"_"+column.Name+"_trgm"), quoteName(db, table), quoteName(db, column.Name)))
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("criando índices de busca de %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// searchPage busca os IDs da página com a relevância e os trechos destacados de cada coluna
+// As entidades dos resultados têm apenas o ID (carregadas depois por loadSearchHits)
+func (r *BaseRepositoryImpl[E]) searchPage(base *gorm.DB, columns []entity.SearchColumn, query string, offset, limit int) ([]SearchHit[E], error) {
+	table := r.TableName()
+
+	selects := []string{quoteColumn(r.db, table, "id")}
+	vars := []interface{}{query}
+	similarities := make([]string, len(columns))
+	for i, column := range columns {
+		similarities[i] = "similarity(coalesce(" + quoteColumn(r.db, table, column.Name) + ", ''), ?)"
+		vars = append(vars, query)
+	}
+	selects = append(selects, "ts_rank("+searchVector(r.db, table, columns)+", "+searchQuery()+") + GREATEST("+
+		strings.Join(similarities, ", ")+") AS search_rank")
+
+	for i, column := range columns {
+		selects = append(selects, fmt.Sprintf("ts_headline('%s'::regconfig, coalesce(%s, ''), %s, 'StartSel=%s, StopSel=%s, MaxWords=35, MinWords=15') AS search_highlight_%d",
+			SearchLanguage, quoteColumn(r.db, table, column.Name), searchQuery(), HighlightStart, HighlightStop, i))
+		vars = append(vars, query)
+	}
+
+	rows, err := base.Session(&gorm.Session{}).
+		Clauses(clause.Select{Expression: clause.Expr{SQL: strings.Join(selects, ", "), Vars: vars}}).
+		Order("search_rank DESC").Order(quoteColumn(r.db, table, "id")).
+		Offset(offset).Limit(limit).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []SearchHit[E]
+	for rows.Next() {
+		var id uint
+		var rank float64
+		fragments := make([]string, len(columns))
+		dest := []interface{}{&id, &rank}
+		for i := range fragments {
+			dest = append(dest, &fragments[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		hit := SearchHit[E]{Entity: r.newEntity(), Rank: rank, Highlights: make(map[string]string)}
+		hit.Entity.SetID(id)
+		for i, fragment := range fragments {
+			if strings.Contains(fragment, HighlightStart) {
+				hit.Highlights[columns[i].Name] = escapeHighlight(fragment)
+			}
+		}
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+// loadSearchHits carrega as entidades da página (com os preloads ou apenas os campos das opções),
+// preservando a ordem da relevância. Entidades removidas entre a busca e a carga são descartadas
+func (r *BaseRepositoryImpl[E]) loadSearchHits(hits []SearchHit[E], opts ListOptions) ([]SearchHit[E], error) {
+	if len(hits) == 0 {
+		return []SearchHit[E]{}, nil
+	}
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.Entity.GetID()
+	}
+
+	query := r.db
+	if opts.IncludeDeleted {
+		query = query.Unscoped()
+	}
+	if len(opts.Fields) > 0 {
+		// Seleção parcial: os relacionamentos não fazem parte das colunas e não são carregados
+		columns, err := r.SelectColumns(opts.Fields)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Select(r.WithSelect(columns...).qualifiedColumns())
+	} else {
+		preloads := r.preloads
+		if opts.Preloads != nil {
+			preloads = opts.Preloads
+		}
+		for _, preload := range preloads {
+			query = query.Preload(preload)
+		}
+	}
+
+	var entities []E
+	if err := query.Where(clause.IN{Column: clause.PrimaryColumn, Values: uintValues(ids)}).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]E, len(entities))
+	for _, entity := range entities {
+		byID[entity.GetID()] = entity
+	}
+
+	loaded := make([]SearchHit[E], 0, len(hits))
+	for _, hit := range hits {
+		if entity, ok := byID[hit.Entity.GetID()]; ok {
+			hit.Entity = entity
+			loaded = append(loaded, hit)
+		}
+	}
+	return loaded, nil
+}
+
+// searchCondition condição da busca: o vetor corresponde à consulta ou alguma coluna contém o texto
+// (ILIKE) ou é similar a ele (operador % do pg_trgm)
+func (r *BaseRepositoryImpl[E]) searchCondition(columns []entity.SearchColumn, query string) clause.Expression {
+	table := r.TableName()
+	conditions := []string{"(" + searchVector(r.db, table, columns) + ") @@ " + searchQuery()}
+	vars := []interface{}{query}
+	like := "%" + escapeLike(query) + "%"
+	for _, column := range columns {
+		name := quoteColumn(r.db, table, column.Name)
+		conditions = append(conditions, name+" ILIKE ?", name+" % ?")
+		vars = append(vars, like, query)
+	}
+	return clause.Expr{SQL: "(" + strings.Join(conditions, " OR ") + ")", Vars: vars}
+}
+
+// searchColumns retorna as colunas de busca da entidade; ErrNotSearchable quando não declaradas
+func (r *BaseRepositoryImpl[E]) searchColumns() ([]entity.SearchColumn, error) {
+	searchable, ok := any(r.newEntity()).(entity.Searchable)
+	if !ok || len(searchable.SearchColumns()) == 0 {
+		return nil, ErrNotSearchable
+	}
+	return searchable.SearchColumns(), nil
+}
+
+// searchVector expressão do vetor de busca: as colunas com os seus pesos, concatenadas
+// Sem tabela, as colunas não são qualificadas (expressão do índice). O PostgreSQL só usa o índice
+// quando a expressão da consulta é a mesma do índice: ambas devem ser montadas aqui
+func searchVector(db *gorm.DB, table string, columns []entity.SearchColumn) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = fmt.Sprintf("setweight(to_tsvector('%s'::regconfig, coalesce(%s, '')), '%s')",
+			SearchLanguage, quoteColumn(db, table, column.Name), searchWeight(column.Weight))
+	}
+	return strings.Join(parts, " || ")
+}
+
+// searchQuery expressão da consulta de texto completo (o texto é o parâmetro)
+func searchQuery() string {
+	return "websearch_to_tsquery('" + SearchLanguage + "'::regconfig, ?)"
+}
+
+// quoteColumn escapa a coluna, qualificada com a tabela quando informada
+func quoteColumn(db *gorm.DB, table, column string) string {
+	if table == "" {
+		return quoteName(db, column)
+	}
+	return db.Statement.Quote(clause.Column{Table: table, Name: column})
+}
+
+// quoteName escapa um identificador (tabela, coluna ou índice)
+func quoteName(db *gorm.DB, name string) string {
+	return db.Statement.Quote(clause.Table{Name: name})
+}
+
+// searchWeight normaliza o peso da coluna (A a D; outro valor = D)
+func searchWeight(weight string) string {
+	switch weight = strings.ToUpper(weight); weight {
+	case "A", "B", "C":
+		return weight
+	default:
+		return "D"
+	}
+}
+
+// escapeHighlight escapa o HTML do trecho destacado, preservando apenas os marcadores dos termos
+func escapeHighlight(fragment string) string {
+	escaped := html.EscapeString(fragment)
+	return strings.NewReplacer(html.EscapeString(HighlightStart), HighlightStart, html.EscapeString(HighlightStop), HighlightStop).Replace(escaped)
+}
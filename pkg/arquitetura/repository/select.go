@@ -0,0 +1,45 @@
+package repository
+
+import "gorm.io/gorm/clause"
+
+// WithSelect retorna uma cópia do repositório cujas listagens paginadas carregam apenas as colunas
+// informadas (SELECT col1, col2...). Os relacionamentos (preloads) não são carregados e os demais campos
+// das entidades ficam com o valor zero. As colunas devem ser validadas com SelectColumns
+func (r *BaseRepositoryImpl[E]) WithSelect(columns ...string) *BaseRepositoryImpl[E] {
+	clone := *r
+	clone.columns = columns
+	return &clone
+}
+
+// SelectColumns valida os campos (coluna ou campo Go) contra o modelo da entidade e retorna as colunas
+// para WithSelect, sem repetições. A chave primária é sempre incluída
+func (r *BaseRepositoryImpl[E]) SelectColumns(fields []string) ([]string, error) {
+	columns := make([]string, 0, len(fields)+1)
+	seen := make(map[string]bool, len(fields)+1)
+	hasID := false
+	for _, name := range fields {
+		field, err := r.resolveColumn(name)
+		if err != nil {
+			return nil, err
+		}
+		if seen[field.DBName] {
+			continue
+		}
+		seen[field.DBName] = true
+		hasID = hasID || field.PrimaryKey
+		columns = append(columns, field.DBName)
+	}
+	if !hasID {
+		columns = append([]string{"id"}, columns...)
+	}
+	return columns, nil
+}
+
+// qualifiedColumns colunas de WithSelect qualificadas e escapadas com a tabela da entidade (ex: "produtos"."id")
+func (r *BaseRepositoryImpl[E]) qualifiedColumns() []string {
+	columns := make([]string, len(r.columns))
+	for i, column := range r.columns {
+		columns[i] = r.db.Statement.Quote(clause.Column{Table: r.TableName(), Name: column})
+	}
+	return columns
+}
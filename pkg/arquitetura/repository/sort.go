@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// SortField campo de ordenação da listagem
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Sort ordenação composta da listagem, na ordem de prioridade dos campos
+type Sort []SortField
+
+// ParseSort converte a ordenação no formato da query string: campos separados por vírgula,
+// com prefixo "-" para ordem decrescente (ex: "preco,-created_at"). Campos vazios são ignorados
+func ParseSort(value string) Sort {
+	var sort Sort
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		desc := strings.HasPrefix(part, "-")
+		part = strings.TrimPrefix(strings.TrimPrefix(part, "-"), "+")
+		if part == "" {
+			continue
+		}
+		sort = append(sort, SortField{Field: part, Desc: desc})
+	}
+	return sort
+}
+
+// String retorna a ordenação no formato da query string (ex: "preco,-created_at")
+func (s Sort) String() string {
+	parts := make([]string, len(s))
+	for i, field := range s {
+		parts[i] = field.Field
+		if field.Desc {
+			parts[i] = "-" + field.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// SortOrder converte a ordenação em uma cláusula ORDER BY segura: os campos são validados contra o modelo
+// da entidade e as colunas são qualificadas e escapadas. A chave primária é adicionada como critério de
+// desempate, para que a paginação seja estável. Ordenação vazia retorna a ordenação padrão do repositório
+func (r *BaseRepositoryImpl[E]) SortOrder(sort Sort) (string, error) {
+	if len(sort) == 0 {
+		return r.defaultOrder, nil
+	}
+
+	parts := make([]string, 0, len(sort)+1)
+	hasID := false
+	for _, s := range sort {
+		field, err := r.resolveColumn(s.Field)
+		if err != nil {
+			return "", err
+		}
+		hasID = hasID || field.PrimaryKey
+		part := r.db.Statement.Quote(clause.Column{Table: r.TableName(), Name: field.DBName})
+		if s.Desc {
+			part += " DESC"
+		}
+		parts = append(parts, part)
+	}
+	if !hasID {
+		parts = append(parts, r.db.Statement.Quote(clause.Column{Table: r.TableName(), Name: "id"}))
+	}
+	return strings.Join(parts, ", "), nil
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// ColumnResolver localiza o campo da entidade pelo nome da coluna ou do campo Go e retorna a coluna
+// qualificada para a query. Campos de relacionamentos são referenciados pelo nome da relação (ex: Categoria.ativo)
+// Retorna erro para campos que não existem no modelo ou não possuem coluna
+type ColumnResolver func(name string) (clause.Column, *schema.Field, error)
+
+// Specification condição de consulta composta sem SQL nos serviços e validadores
+// Os campos são referenciados pelo nome da coluna ou do campo Go e validados contra o modelo da entidade
+// na execução; os valores são sempre enviados como parâmetros
+//
+// Ex: repository.And(repository.Eq("ativo", true), repository.Or(repository.Gte("preco", 10), repository.Like("descricao", "%note%")))
+// Ex: repository.Eq("Categoria.ativo", true) (filtra pelo relacionamento com JOIN, ver relationColumn)
+type Specification interface {
+	// Expression converte a especificação na condição do GORM
+	Expression(resolve ColumnResolver) (clause.Expression, error)
+}
+
+// comparison compara um campo com um valor
+type comparison struct {
+	field string
+	build func(column clause.Column) clause.Expression
+}
+
+func (c comparison) Expression(resolve ColumnResolver) (clause.Expression, error) {
+	column, _, err := resolve(c.field)
+	if err != nil {
+		return nil, err
+	}
+	return c.build(column), nil
+}
+
+// Eq campo igual ao valor (valor nil resulta em IS NULL)
+func Eq(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Eq{Column: column, Value: value} }}
+}
+
+// Neq campo diferente do valor (valor nil resulta em IS NOT NULL)
+func Neq(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Neq{Column: column, Value: value} }}
+}
+
+// Gt campo maior que o valor
+func Gt(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Gt{Column: column, Value: value} }}
+}
+
+// Gte campo maior ou igual ao valor
+func Gte(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Gte{Column: column, Value: value} }}
+}
+
+// Lt campo menor que o valor
+func Lt(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Lt{Column: column, Value: value} }}
+}
+
+// Lte campo menor ou igual ao valor
+func Lte(field string, value interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Lte{Column: column, Value: value} }}
+}
+
+// Like campo corresponde ao padrão (LIKE, diferencia maiúsculas; use % como curinga)
+func Like(field, pattern string) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.Like{Column: column, Value: pattern} }}
+}
+
+// ILike campo corresponde ao padrão sem diferenciar maiúsculas (ILIKE do PostgreSQL)
+func ILike(field, pattern string) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression {
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, pattern}}
+	}}
+}
+
+// In campo igual a um dos valores (sem valores, nenhum registro atende)
+func In(field string, values ...interface{}) Specification {
+	return comparison{field, func(column clause.Column) clause.Expression { return clause.IN{Column: column, Values: values} }}
+}
+
+// IsNull campo nulo
+func IsNull(field string) Specification {
+	return Eq(field, nil)
+}
+
+// composite combina especificações com AND ou OR
+type composite struct {
+	or    bool
+	specs []Specification
+}
+
+func (c composite) Expression(resolve ColumnResolver) (clause.Expression, error) {
+	exprs := make([]clause.Expression, 0, len(c.specs))
+	for _, spec := range c.specs {
+		if spec == nil {
+			continue
+		}
+		expr, err := spec.Expression(resolve)
+		if err != nil {
+			return nil, err
+		}
+		if expr != nil {
+			exprs = append(exprs, expr)
+		}
+	}
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+	if c.or {
+		return clause.Or(exprs...), nil
+	}
+	return clause.And(exprs...), nil
+}
+
+// And todas as especificações devem ser atendidas (especificações nil são ignoradas)
+func And(specs ...Specification) Specification {
+	return composite{specs: specs}
+}
+
+// Or ao menos uma das especificações deve ser atendida (especificações nil são ignoradas)
+func Or(specs ...Specification) Specification {
+	return composite{or: true, specs: specs}
+}
+
+// negation nega uma especificação
+type negation struct {
+	spec Specification
+}
+
+func (n negation) Expression(resolve ColumnResolver) (clause.Expression, error) {
+	expr, err := n.spec.Expression(resolve)
+	if err != nil || expr == nil {
+		return nil, err
+	}
+	return clause.Not(expr), nil
+}
+
+// Not a especificação não deve ser atendida
+func Not(spec Specification) Specification {
+	return negation{spec: spec}
+}
+
+// FindAllBySpec busca as entidades que atendem a especificação, com paginação e o modo de contagem informado
+func (r *BaseRepositoryImpl[E]) FindAllBySpec(page, pageSize int, orderBy string, mode CountMode, spec Specification) (*PageResult[E], error) {
+	return r.FindAllFiltered(page, pageSize, orderBy, mode, DateRange{}, spec)
+}
+
+// FindAllFiltered busca as entidades do período que atendem a especificação, com paginação e o modo de contagem
+// Sem período nem especificação, equivale a FindAllWithCountMode (inclusive a contagem estimada)
+// Atalho de FindAllWithOptions
+func (r *BaseRepositoryImpl[E]) FindAllFiltered(page, pageSize int, orderBy string, mode CountMode, dateRange DateRange, spec Specification) (*PageResult[E], error) {
+	return r.FindAllWithOptions(ListOptions{
+		Page:      page,
+		PageSize:  pageSize,
+		OrderBy:   orderBy,
+		CountMode: mode,
+		DateRange: dateRange,
+		Filters:   spec,
+	})
+}
+
+// FindOneBySpec busca a primeira entidade que atende a especificação
+// Retorna ErrNotFound quando nenhuma entidade atende
+func (r *BaseRepositoryImpl[E]) FindOneBySpec(spec Specification) (E, error) {
+	query, err := r.applySpec(r.db, spec)
+	if err != nil {
+		return r.newEntity(), err
+	}
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	entity := r.newEntity()
+	if err := query.First(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity, arqerrors.ErrNotFound
+		}
+		return entity, err
+	}
+	return entity, nil
+}
+
+// CountBySpec conta as entidades que atendem a especificação
+func (r *BaseRepositoryImpl[E]) CountBySpec(spec Specification) (int64, error) {
+	query, err := r.applySpec(r.db.Model(r.newEntity()), spec)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = query.Count(&count).Error
+	return count, err
+}
+
+// ExistsBySpec verifica se existe alguma entidade que atende a especificação
+func (r *BaseRepositoryImpl[E]) ExistsBySpec(spec Specification) (bool, error) {
+	count, err := r.CountBySpec(spec)
+	return count > 0, err
+}
+
+// applySpec adiciona a condição da especificação à query (spec nil não filtra)
+func (r *BaseRepositoryImpl[E]) applySpec(query *gorm.DB, spec Specification) (*gorm.DB, error) {
+	if spec == nil {
+		return query, nil
+	}
+	var joins []string
+	expr, err := spec.Expression(r.specResolver(&joins))
+	if err != nil {
+		var filterErr *FilterError
+		if errors.As(err, &filterErr) {
+			return nil, &arqerrors.BusinessError{
+				Code:    arqerrors.CodeInvalidParameter,
+				Field:   filterErr.Param,
+				Message: i18n.T(r.db.Statement.Context, i18n.MsgInvalidFilter, i18n.Params{"param": filterErr.Param, "value": filterErr.Value}),
+				Err:     err,
+			}
+		}
+		return nil, err
+	}
+	if expr == nil {
+		return query, nil
+	}
+	for _, join := range joins {
+		query = query.Joins(join)
+	}
+	return query.Clauses(clause.Where{Exprs: []clause.Expression{expr}}), nil
+}
+
+// resolveColumn localiza o campo da entidade com coluna no banco
+func (r *BaseRepositoryImpl[E]) resolveColumn(name string) (*schema.Field, error) {
+	field, err := r.lookupField(name)
+	if err != nil {
+		return nil, err
+	}
+	if field.DBName == "" {
+		return nil, fmt.Errorf("campo %s não possui coluna no banco", name)
+	}
+	return field, nil
+}
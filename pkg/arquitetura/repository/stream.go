@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// StreamBatchSize quantidade de registros lidos do banco por vez no Stream
+const StreamBatchSize = 500
+
+// StreamItem item entregue pelo Stream: a entidade lida ou o erro que interrompeu a leitura
+type StreamItem[E any] struct {
+	Entity E
+	Err    error
+}
+
+// Stream percorre as entidades que atendem a especificação (nil = todas), ordenadas pela chave primária,
+// entregando-as uma a uma pelo canal retornado. A leitura é feita em lotes de StreamBatchSize em uma
+// goroutine, mantendo o uso de memória constante em exportações de grandes volumes
+// O canal é fechado ao fim da leitura; um erro de consulta é entregue como último item e, com ctx
+// cancelado, a leitura é interrompida. Quem consome deve ler o canal até o fim ou cancelar ctx, senão a
+// goroutine fica bloqueada
+//
+// Ex:
+//
+//	for item := range repo.Stream(ctx, arqrepository.Eq("categoria_id", 1)) {
+//		if item.Err != nil {
+//			return item.Err
+//		}
+//		escrever(item.Entity)
+//	}
+func (r *BaseRepositoryImpl[E]) Stream(ctx context.Context, spec Specification) <-chan StreamItem[E] {
+	items := make(chan StreamItem[E])
+
+	go func() {
+		defer close(items)
+
+		send := func(item StreamItem[E]) error {
+			select {
+			case items <- item:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		query, err := r.applySpec(r.db.WithContext(ctx), spec)
+		if err != nil {
+			_ = send(StreamItem[E]{Err: err})
+			return
+		}
+		for _, preload := range r.preloads {
+			query = query.Preload(preload)
+		}
+
+		var batch []E
+		err = query.FindInBatches(&batch, StreamBatchSize, func(tx *gorm.DB, _ int) error {
+			for _, entity := range batch {
+				if err := send(StreamItem[E]{Entity: entity}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+		if err != nil && ctx.Err() == nil {
+			_ = send(StreamItem[E]{Err: err})
+		}
+	}()
+
+	return items
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+
+	"gorm.io/gorm"
+)
+
+// FindDeleted retorna as entidades excluídas logicamente (lixeira) com paginação
+// Sem ordenação informada, as exclusões mais recentes vêm primeiro
+func (r *BaseRepositoryImpl[E]) FindDeleted(page, pageSize int, orderBy string, mode CountMode, dateRange DateRange) (*PageResult[E], error) {
+	if orderBy == "" {
+		orderBy = "deleted_at DESC"
+	}
+	base := dateRange.apply(r.db.Unscoped().Where("deleted_at IS NOT NULL"), r.TableName())
+	return r.findPage(base, true, page, pageSize, orderBy, nil, mode)
+}
+
+// IsDeleted indica se a entidade existe, mas está na lixeira (excluída logicamente)
+// Usada para diferenciar um registro excluído de um que nunca existiu
+func (r *BaseRepositoryImpl[E]) IsDeleted(id uint) (bool, error) {
+	var count int64
+	err := r.db.Unscoped().Model(r.newEntity()).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Restore restaura uma entidade excluída logicamente
+// Retorna ErrNotFound se a entidade não existir ou não estiver na lixeira
+func (r *BaseRepositoryImpl[E]) Restore(id uint) error {
+	var result *gorm.DB
+	err := r.retryWrite(func() error {
+		result = r.writeDB().Unscoped().Model(r.newEntity()).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		return r.translateError(result.Error)
+	})
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return arqerrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeletePermanently remove definitivamente uma entidade que está na lixeira
+// Apenas entidades já excluídas logicamente podem ser removidas, preservando as validações da exclusão
+// Retorna ErrNotFound se a entidade não existir ou não estiver na lixeira
+func (r *BaseRepositoryImpl[E]) DeletePermanently(id uint) error {
+	var result *gorm.DB
+	err := r.retryWrite(func() error {
+		result = r.writeDB().Unscoped().
+			Where("deleted_at IS NOT NULL").
+			Delete(r.newEntity(), id)
+		return r.translateError(result.Error)
+	})
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return arqerrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindAllDeleted é um alias de FindDeleted
+func (r *BaseRepositoryImpl[E]) FindAllDeleted(page, pageSize int, orderBy string, mode CountMode, dateRange DateRange) (*PageResult[E], error) {
+	return r.FindDeleted(page, pageSize, orderBy, mode, dateRange)
+}
+
+// HardDelete é um alias de DeletePermanently
+func (r *BaseRepositoryImpl[E]) HardDelete(id uint) error {
+	return r.DeletePermanently(id)
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+)
+
+// txKey chave da transação da requisição no context.Context
+type txKey struct{}
+
+// afterCommitKey chave das ações agendadas para depois do commit da transação do contexto
+type afterCommitKey struct{}
+
+// conflictKey chave do indicador de falha de concorrência da transação do contexto (ver TxConflicted)
+type conflictKey struct{}
+
+// afterCommitHooks ações agendadas com AfterCommit em uma transação
+type afterCommitHooks struct {
+	mutex sync.Mutex
+	fns   []func()
+}
+
+// ContextWithTx retorna um contexto que carrega a transação informada
+// Repositórios obtidos com WithContext passam a executar suas operações nessa transação
+// Quem faz o commit deve chamar RunAfterCommit em seguida (ver AfterCommit)
+func ContextWithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	// Um savepoint compartilha o indicador de conflito da transação externa, que é quem pode ser repetida
+	if _, ok := ctx.Value(conflictKey{}).(*atomic.Bool); !ok {
+		ctx = context.WithValue(ctx, conflictKey{}, new(atomic.Bool))
+	}
+	ctx = context.WithValue(ctx, txKey{}, tx)
+	return context.WithValue(ctx, afterCommitKey{}, &afterCommitHooks{})
+}
+
+// TxConflicted indica se uma operação da transação do contexto falhou por serialização ou deadlock
+// (IsRetryable), mesmo que o erro tenha sido tratado pelo handler: a transação está abortada e quem a
+// abriu pode repeti-la (ex: TransactionMiddleware)
+func TxConflicted(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	conflict, ok := ctx.Value(conflictKey{}).(*atomic.Bool)
+	return ok && conflict.Load()
+}
+
+// markConflict registra a falha de concorrência na transação do contexto (ver TxConflicted)
+func markConflict(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if conflict, ok := ctx.Value(conflictKey{}).(*atomic.Bool); ok {
+		conflict.Store(true)
+	}
+}
+
+// AfterCommit agenda fn para depois do commit da transação do contexto; sem transação, fn é executada
+// imediatamente. Com rollback, fn é descartada. Usado para efeitos fora do banco que não devem
+// acontecer antes de as escritas ficarem visíveis (ex: invalidação de cache, publicação de eventos)
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := afterCommitFromContext(ctx)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.mutex.Lock()
+	hooks.fns = append(hooks.fns, fn)
+	hooks.mutex.Unlock()
+}
+
+// RunAfterCommit executa as ações agendadas com AfterCommit na transação do contexto
+// Chamado por quem abriu a transação, após o commit (RunInTransaction e TransactionMiddleware)
+func RunAfterCommit(ctx context.Context) {
+	hooks, ok := afterCommitFromContext(ctx)
+	if !ok {
+		return
+	}
+	hooks.mutex.Lock()
+	fns := hooks.fns
+	hooks.fns = nil
+	hooks.mutex.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// afterCommitFromContext retorna as ações agendadas da transação do contexto, se houver
+func afterCommitFromContext(ctx context.Context) (*afterCommitHooks, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks)
+	return hooks, ok && hooks != nil
+}
+
+// TxFromContext retorna a transação armazenada no contexto, se houver
+func TxFromContext(ctx context.Context) (*gorm.DB, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	tx, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok && tx != nil
+}
+
+// WithContext retorna uma cópia do repositório vinculada ao contexto informado:
+// as queries recebem o prazo do contexto e, havendo transação no contexto (ContextWithTx),
+// são executadas nela. Sem transação, retorna uma cópia que usa a conexão original
+func (r *BaseRepositoryImpl[E]) WithContext(ctx context.Context) *BaseRepositoryImpl[E] {
+	if ctx == nil {
+		return r
+	}
+
+	clone := *r
+	if tx, ok := TxFromContext(ctx); ok {
+		clone.db = r.withScopes(tx.WithContext(ctx))
+		// Uma transação usa uma única conexão: COUNT e busca da página não podem ser concorrentes
+		clone.options.ParallelCount = false
+	} else {
+		clone.db = r.db.WithContext(ctx)
+	}
+	return &clone
+}
+
+// WithTx retorna uma cópia do repositório que executa as operações na transação informada
+func (r *BaseRepositoryImpl[E]) WithTx(tx *gorm.DB) *BaseRepositoryImpl[E] {
+	clone := *r
+	clone.db = r.withScopes(tx)
+	clone.options.ParallelCount = false
+	return &clone
+}
+
+// Transaction executa fn em uma transação, entregando o repositório vinculado a ela
+// O contexto recebido por fn carrega a transação: outros repositórios obtidos com WithContext(ctx)
+// participam da mesma transação. Retornar erro (ou panic) em fn desfaz todas as escritas
+//
+// Ex: criar um produto e atualizar a categoria atomicamente
+//
+//	err := produtoRepo.Transaction(ctx, func(ctx context.Context, repo *BaseRepositoryImpl[*models.Produto]) error {
+//		if err := repo.Create(produto); err != nil {
+//			return err
+//		}
+//		return categoriaRepo.WithContext(ctx).Update(categoria)
+//	})
+func (r *BaseRepositoryImpl[E]) Transaction(ctx context.Context, fn func(ctx context.Context, repo *BaseRepositoryImpl[E]) error) error {
+	return RunInTransaction(ctx, r.db, func(ctx context.Context) error {
+		return fn(ctx, r.WithContext(ctx))
+	})
+}
+
+// RunInTransaction executa fn em uma transação propagada no contexto (ContextWithTx)
+// Havendo transação no contexto (ex: TransactionMiddleware), fn é executada em um savepoint dela:
+// o erro desfaz apenas as escritas de fn e o commit continua a cargo da transação externa
+// As ações agendadas com AfterCommit em fn rodam após o commit (no savepoint, após o commit externo)
+func RunInTransaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, nested := afterCommitFromContext(ctx)
+	if tx, ok := TxFromContext(ctx); ok {
+		db = tx
+	}
+
+	var txCtx context.Context
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx = ContextWithTx(ctx, tx)
+		return fn(txCtx)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !nested {
+		RunAfterCommit(txCtx)
+		return nil
+	}
+	// Savepoint confirmado: as ações passam a depender do commit da transação externa
+	hooks, _ := afterCommitFromContext(txCtx)
+	hooks.mutex.Lock()
+	fns := hooks.fns
+	hooks.mutex.Unlock()
+	for _, fn := range fns {
+		AfterCommit(ctx, fn)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// UnitOfWork delimita uma unidade de trabalho transacional: as leituras e escritas de fn,
+// em qualquer repositório obtido com WithContext(ctx), são confirmadas ou desfeitas em conjunto
+//
+// Ex:
+//
+//	err := uow.Do(ctx, func(ctx context.Context) error {
+//		produtos := produtoRepo.WithContext(ctx)
+//		categorias := categoriaRepo.WithContext(ctx)
+//		...
+//	})
+type UnitOfWork struct {
+	db    *gorm.DB
+	retry *RetryPolicy // Repetição nas falhas de concorrência (WithRetry); nil = Options.Retry padrão
+}
+
+// NewUnitOfWork cria uma unidade de trabalho sobre a conexão informada
+func NewUnitOfWork(db *gorm.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// WithRetry configura a repetição da unidade de trabalho nas falhas de concorrência (retorna a própria
+// unidade para chaining). Sem configuração, usa a política padrão dos repositórios (Options.Retry)
+func (u *UnitOfWork) WithRetry(policy RetryPolicy) *UnitOfWork {
+	u.retry = &policy
+	return u
+}
+
+// Do executa fn em uma transação: commit quando fn retorna nil, rollback em erro ou panic
+// Falhas de serialização/deadlock (IsRetryable) repetem a transação inteira conforme a política de
+// repetição (ver RunInTransactionWithRetry): fn pode ser executada mais de uma vez
+// Havendo transação no contexto (ex: TransactionMiddleware ou outra unidade de trabalho), fn participa
+// dela sem savepoint e sem repetição: o commit/rollback e a repetição ficam a cargo de quem a abriu
+// Sem conexão (ex: serviço montado com um repositório falso nos testes), fn é executada sem transação
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+	if u.db == nil {
+		return Retry(ctx, u.retryPolicy(), func() error {
+			return fn(ctx)
+		})
+	}
+	return RunInTransactionWithRetry(ctx, u.db, u.retryPolicy(), fn)
+}
+
+// retryPolicy política de repetição da unidade de trabalho
+func (u *UnitOfWork) retryPolicy() RetryPolicy {
+	if u.retry != nil {
+		return *u.retry
+	}
+	return defaultOptions.Retry
+}
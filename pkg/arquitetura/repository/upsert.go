@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+)
+
+// Upsert insere a entidade ou, se já existir um registro com os mesmos valores em conflictColumns,
+// atualiza nele as colunas de updateColumns, em um único INSERT ... ON CONFLICT (sem janela de corrida
+// entre a verificação e a gravação). conflictColumns deve corresponder a uma restrição única (ex: codigo)
+// updateColumns vazio atualiza todas as colunas graváveis, exceto as de conflito e o identificador público;
+// nesse caso updated_at é atualizado e um registro na lixeira é restaurado
+// A entidade recebe o estado gravado (ID e datas do registro existente, quando atualizado)
+//
+// Ex: repo.Upsert(produto, []string{"codigo"}, []string{"descricao", "preco"})
+func (r *BaseRepositoryImpl[E]) Upsert(entity E, conflictColumns []string, updateColumns []string) error {
+	if len(conflictColumns) == 0 {
+		return fmt.Errorf("upsert sem colunas de conflito")
+	}
+
+	conflict := make([]clause.Column, len(conflictColumns))
+	conflictNames := make(map[string]bool, len(conflictColumns))
+	for i, name := range conflictColumns {
+		field, err := r.lookupField(name)
+		if err != nil {
+			return err
+		}
+		conflict[i] = clause.Column{Name: field.DBName}
+		conflictNames[field.DBName] = true
+	}
+
+	var updates []string
+	if len(updateColumns) > 0 {
+		for _, name := range updateColumns {
+			field, err := r.lookupField(name)
+			if err != nil {
+				return err
+			}
+			if field.PrimaryKey || field.DBName == "" {
+				return fmt.Errorf("campo %s não pode ser alterado", name)
+			}
+			updates = append(updates, field.DBName)
+		}
+	} else {
+		columns, err := r.upsertColumns(conflictNames)
+		if err != nil {
+			return err
+		}
+		updates = columns
+	}
+
+	return r.retryWrite(func() error {
+		err := r.writeDB().Clauses(
+			clause.OnConflict{Columns: conflict, DoUpdates: clause.AssignmentColumns(updates)},
+			clause.Returning{},
+		).Create(entity).Error
+		return r.translateError(err)
+	})
+}
+
+// upsertColumns retorna as colunas atualizadas por padrão no upsert: as graváveis (exceto as de conflito e
+// o identificador público), a data de atualização e o soft delete
+func (r *BaseRepositoryImpl[E]) upsertColumns(conflict map[string]bool) ([]string, error) {
+	fields, err := r.writableFields()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]string, 0, len(fields)+2)
+	for _, field := range fields {
+		if conflict[field.DBName] || field.Name == "PublicID" {
+			continue
+		}
+		columns = append(columns, field.DBName)
+	}
+	for _, name := range []string{"UpdatedAt", "DeletedAt"} {
+		if field, err := r.lookupField(name); err == nil && field.DBName != "" {
+			columns = append(columns, field.DBName)
+		}
+	}
+	return columns, nil
+}
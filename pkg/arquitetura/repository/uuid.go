@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UUIDRepositoryImpl é o repositório genérico das entidades com chave primária UUID (entity.BaseEntityUUID)
+// Oferece o CRUD do repositório base com os métodos indexados por uuid.UUID; as consultas específicas
+// ficam no repositório da entidade (GetDB). E é o tipo ponteiro da entidade (ex: *models.Pedido)
+type UUIDRepositoryImpl[E entity.EntityUUID] struct {
+	db           *gorm.DB
+	preloads     []string
+	defaultOrder string
+}
+
+// NewUUIDRepository cria uma nova instância do repositório de entidades com chave UUID
+// A ordenação padrão usa a data de criação, pois UUIDs aleatórios não refletem a ordem de inserção
+func NewUUIDRepository[E entity.EntityUUID](db *gorm.DB) *UUIDRepositoryImpl[E] {
+	return &UUIDRepositoryImpl[E]{
+		db:           db,
+		preloads:     []string{},
+		defaultOrder: "created_at ASC, id ASC",
+	}
+}
+
+// WithPreloads configura os preloads padrão (retorna o próprio repositório para chaining)
+func (r *UUIDRepositoryImpl[E]) WithPreloads(preloads ...string) *UUIDRepositoryImpl[E] {
+	r.preloads = preloads
+	return r
+}
+
+// WithDefaultOrder configura a ordenação padrão (retorna o próprio repositório para chaining)
+func (r *UUIDRepositoryImpl[E]) WithDefaultOrder(order string) *UUIDRepositoryImpl[E] {
+	r.defaultOrder = order
+	return r
+}
+
+// WithContext retorna uma cópia do repositório vinculada ao contexto, participando da transação
+// do contexto quando houver (ver BaseRepositoryImpl.WithContext)
+func (r *UUIDRepositoryImpl[E]) WithContext(ctx context.Context) *UUIDRepositoryImpl[E] {
+	if ctx == nil {
+		return r
+	}
+
+	clone := *r
+	if tx, ok := TxFromContext(ctx); ok {
+		clone.db = tx.WithContext(ctx)
+	} else {
+		clone.db = r.db.WithContext(ctx)
+	}
+	return &clone
+}
+
+// WithTx retorna uma cópia do repositório que executa as operações na transação informada
+func (r *UUIDRepositoryImpl[E]) WithTx(tx *gorm.DB) *UUIDRepositoryImpl[E] {
+	clone := *r
+	clone.db = tx
+	return &clone
+}
+
+// GetDB retorna a instância do banco de dados
+func (r *UUIDRepositoryImpl[E]) GetDB() *gorm.DB {
+	return r.db
+}
+
+// TableName retorna o nome da tabela da entidade
+func (r *UUIDRepositoryImpl[E]) TableName() string {
+	return r.newEntity().TableName()
+}
+
+// Create insere uma nova entidade no banco de dados (o ID é gerado quando não informado)
+func (r *UUIDRepositoryImpl[E]) Create(entity E) error {
+	return r.translateError(r.db.Create(entity).Error)
+}
+
+// FindByID busca uma entidade pelo ID
+func (r *UUIDRepositoryImpl[E]) FindByID(id uuid.UUID) (E, error) {
+	entity := r.newEntity()
+	query := r.db
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	if err := query.Where(r.idEq(id)).First(entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity, arqerrors.ErrNotFound
+		}
+		return entity, err
+	}
+	return entity, nil
+}
+
+// FindAllByIDs busca as entidades dos IDs informados em uma única query, na ordem dos IDs
+// IDs inexistentes são ignorados (o resultado pode ter menos itens que ids)
+func (r *UUIDRepositoryImpl[E]) FindAllByIDs(ids []uuid.UUID) ([]E, error) {
+	if len(ids) == 0 {
+		return []E{}, nil
+	}
+
+	values := make([]interface{}, len(ids))
+	for i, id := range ids {
+		values[i] = id
+	}
+
+	query := r.db.Where(clause.IN{Column: clause.PrimaryColumn, Values: values})
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	var entities []E
+	if err := query.Find(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]E, len(entities))
+	for _, entity := range entities {
+		byID[entity.GetID()] = entity
+	}
+	ordered := make([]E, 0, len(entities))
+	for _, id := range ids {
+		if entity, ok := byID[id]; ok {
+			ordered = append(ordered, entity)
+			delete(byID, id)
+		}
+	}
+	return ordered, nil
+}
+
+// FindAll retorna todas as entidades com paginação
+func (r *UUIDRepositoryImpl[E]) FindAll(page, pageSize int, orderBy string) ([]E, int64, error) {
+	var total int64
+	if err := r.db.Model(r.newEntity()).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := orderBy
+	if order == "" {
+		order = r.defaultOrder
+	}
+
+	query := r.db
+	for _, preload := range r.preloads {
+		query = query.Preload(preload)
+	}
+
+	var entities []E
+	err := query.Offset((page - 1) * pageSize).Limit(pageSize).Order(order).Find(&entities).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return entities, total, nil
+}
+
+// Update atualiza uma entidade existente
+func (r *UUIDRepositoryImpl[E]) Update(entity E) error {
+	return r.translateError(r.db.Save(entity).Error)
+}
+
+// UpdateFields atualiza apenas as colunas informadas da entidade (coluna → valor)
+// Retorna ErrNotFound quando a entidade não existe
+func (r *UUIDRepositoryImpl[E]) UpdateFields(id uuid.UUID, fields map[string]interface{}) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	result := r.db.Model(r.newEntity()).Where(r.idEq(id)).Updates(fields)
+	if result.Error != nil {
+		return r.translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return arqerrors.ErrNotFound
+	}
+	return nil
+}
+
+// Delete remove uma entidade pelo ID (soft delete se configurado)
+func (r *UUIDRepositoryImpl[E]) Delete(id uuid.UUID) error {
+	result := r.db.Where(r.idEq(id)).Delete(r.newEntity())
+	if result.Error != nil {
+		return r.translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return arqerrors.ErrNotFound
+	}
+	return nil
+}
+
+// ExistsByID verifica se existe uma entidade com o ID
+func (r *UUIDRepositoryImpl[E]) ExistsByID(id uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(r.newEntity()).Where(r.idEq(id)).Count(&count).Error
+	return count > 0, err
+}
+
+// idEq condição pela chave primária (um UUID zero não é tratado como ausência de condição pelo GORM)
+func (r *UUIDRepositoryImpl[E]) idEq(id uuid.UUID) clause.Expression {
+	return clause.Eq{Column: clause.PrimaryColumn, Value: id}
+}
+
+// translateError converte violações de restrição do banco em erros de negócio (ver BaseRepositoryImpl.translateError)
+func (r *UUIDRepositoryImpl[E]) translateError(err error) error {
+	return translateDBError(r.db.Statement.Context, r.TableName(), err)
+}
+
+// newEntity cria uma nova instância da entidade usando reflection
+func (r *UUIDRepositoryImpl[E]) newEntity() E {
+	var zero E
+	t := reflect.TypeOf(zero)
+	if t.Kind() == reflect.Ptr {
+		return reflect.New(t.Elem()).Interface().(E)
+	}
+	return zero
+}
@@ -0,0 +1,75 @@
+package selfcheck
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RouteInfo descreve uma rota montada na aplicação
+type RouteInfo struct {
+	Method   string   `json:"method"`
+	Path     string   `json:"path"`
+	Name     string   `json:"name,omitempty"`
+	Handler  string   `json:"handler"`
+	Handlers []string `json:"handlers"`
+}
+
+// Routes retorna as rotas montadas (sem os middlewares globais nem os HEAD automáticos),
+// ordenadas por path e método. Handler é o último da cadeia; Handlers inclui os middlewares da rota
+func Routes(app *fiber.App) []RouteInfo {
+	routes := app.GetRoutes(true)
+	result := make([]RouteInfo, 0, len(routes))
+	for _, route := range routes {
+		if route.Method == fiber.MethodHead || len(route.Handlers) == 0 {
+			continue
+		}
+
+		names := make([]string, len(route.Handlers))
+		for i, handler := range route.Handlers {
+			names[i] = handlerName(handler)
+		}
+		result = append(result, RouteInfo{
+			Method:   route.Method,
+			Path:     route.Path,
+			Name:     route.Name,
+			Handler:  names[len(names)-1],
+			Handlers: names,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].Method < result[j].Method
+	})
+	return result
+}
+
+// RoutesHandler lista as rotas montadas (GET /admin/routes)
+func RoutesHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{
+			"routes": Routes(c.App()),
+			"checks": Default().Results(),
+		})
+	}
+}
+
+// handlerName retorna o nome da função do handler sem o caminho do módulo
+// (ex: handler.(*BaseHandlerImpl[...]).GetByID-fm)
+func handlerName(handler fiber.Handler) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return "desconhecido"
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,85 @@
+package selfcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Checker é implementado pelos componentes que verificam a própria configuração na inicialização
+// (ex: BaseServiceImpl verifica entidade, tabela, mapper e validador)
+type Checker interface {
+	SelfCheck() []string
+}
+
+// Result resultado da verificação de um componente
+type Result struct {
+	Name     string   `json:"name"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+// Registry registro dos componentes verificados na inicialização
+// Erros de montagem (ex: repositório instanciado com o tipo genérico errado, serviço sem validador)
+// são detectados antes de a aplicação receber tráfego
+type Registry struct {
+	checkers map[string]Checker
+	mutex    sync.RWMutex
+}
+
+// NewRegistry cria um novo registro
+func NewRegistry() *Registry {
+	return &Registry{checkers: make(map[string]Checker)}
+}
+
+// defaultRegistry registro utilizado pelos serviços base
+var defaultRegistry = NewRegistry()
+
+// Default retorna o registro padrão da aplicação
+func Default() *Registry {
+	return defaultRegistry
+}
+
+// Register adiciona um componente (um novo registro com o mesmo nome substitui o anterior)
+func (r *Registry) Register(name string, checker Checker) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.checkers[name] = checker
+}
+
+// Results executa as verificações de todos os componentes, em ordem alfabética
+func (r *Registry) Results() []Result {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	results := make([]Result, 0, len(r.checkers))
+	for name, checker := range r.checkers {
+		results = append(results, Result{Name: name, Problems: run(checker)})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
+
+// Run executa as verificações e retorna um erro com todos os problemas encontrados
+func (r *Registry) Run() error {
+	var problems []string
+	for _, result := range r.Results() {
+		for _, problem := range result.Problems {
+			problems = append(problems, result.Name+": "+problem)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("verificação da montagem falhou: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// run executa a verificação do componente, tratando panics como problema
+func run(checker Checker) (problems []string) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			problems = append(problems, fmt.Sprintf("panic na verificação: %v", recovered))
+		}
+	}()
+	return checker.SelfCheck()
+}
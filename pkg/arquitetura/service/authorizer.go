@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// Authorizer decide se a operação é permitida para quem executa a requisição (informado no contexto,
+// ex: papéis do usuário). Consultado pelo BaseServiceImpl antes de cada operação; negada, a operação
+// retorna FORBIDDEN (403) sem efeito
+//
+// entity é a entidade da operação: convertida do request na criação e carregada do banco na leitura por ID,
+// atualização e exclusão. Em operações sem uma entidade carregada (listagens, busca, exportação, histórico,
+// atualização em massa e operações da lixeira) entity é nil e a decisão vale para a coleção
+// Restaurar e excluir definitivamente da lixeira consultam CanDelete
+type Authorizer[E entity.Entity] interface {
+	CanCreate(ctx context.Context, entity E) bool
+	CanRead(ctx context.Context, entity E) bool
+	CanUpdate(ctx context.Context, entity E) bool
+	CanDelete(ctx context.Context, entity E) bool
+}
+
+// AllowAll permite todas as operações (padrão do serviço e base para autorizações parciais)
+type AllowAll[E entity.Entity] struct{}
+
+func (AllowAll[E]) CanCreate(ctx context.Context, entity E) bool { return true }
+
+func (AllowAll[E]) CanRead(ctx context.Context, entity E) bool { return true }
+
+func (AllowAll[E]) CanUpdate(ctx context.Context, entity E) bool { return true }
+
+func (AllowAll[E]) CanDelete(ctx context.Context, entity E) bool { return true }
+
+// WithAuthorizer configura a autorização das operações (nil restaura o padrão que permite tudo)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithAuthorizer(authorizer Authorizer[E]) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	if isNilEntity(authorizer) {
+		authorizer = AllowAll[E]{}
+	}
+	s.authorizer = authorizer
+	return s
+}
+
+// authorize consulta o Authorizer para a operação e retorna FORBIDDEN quando ela não é permitida
+// Sem entidade carregada, informe o valor zero de E (nil)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) authorize(ctx context.Context, operation OperationType, entity E) error {
+	var allowed bool
+	switch operation {
+	case OperationCreate:
+		allowed = s.authorizer.CanCreate(ctx, entity)
+	case OperationRead:
+		allowed = s.authorizer.CanRead(ctx, entity)
+	case OperationUpdate, OperationBulkUpdate:
+		allowed = s.authorizer.CanUpdate(ctx, entity)
+	case OperationDelete:
+		allowed = s.authorizer.CanDelete(ctx, entity)
+	default:
+		allowed = true
+	}
+	if allowed {
+		return nil
+	}
+
+	fields := logging.Fields{
+		"entity":    s.Config.EntityName,
+		"operation": operation,
+	}
+	if !isNilEntity(entity) {
+		fields["id"] = entity.GetID()
+	}
+	s.log.WithFields(fields).Warn("Operação não autorizada")
+	return arqerrors.NewBusinessError(arqerrors.CodeForbidden, s.message(ctx, i18n.MsgForbidden))
+}
+
+// authorizeCollection consulta o Authorizer para operações sem uma entidade carregada (entity nil)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) authorizeCollection(ctx context.Context, operation OperationType) error {
+	var none E
+	return s.authorize(ctx, operation, none)
+}
@@ -2,67 +2,132 @@ package service
 
 import (
 	"context"
+	"strconv"
 
+	"api_fibergorm/pkg/arquitetura/audit"
 	"api_fibergorm/pkg/arquitetura/dto"
 	"api_fibergorm/pkg/arquitetura/entity"
 	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/outbox"
 	"api_fibergorm/pkg/arquitetura/repository"
+	"api_fibergorm/pkg/arquitetura/selfcheck"
 
-	"github.com/sirupsen/logrus"
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
 )
 
 // BaseService define a interface base para serviços
 // E é o tipo ponteiro da entidade que implementa entity.Entity (ex: *models.Categoria)
 type BaseService[E entity.Entity, CreateReq any, UpdateReq any, Resp any] interface {
 	Create(ctx context.Context, req *CreateReq) (*Resp, error)
+	ValidateCreate(ctx context.Context, req *CreateReq) error
+	ValidateUpdate(ctx context.Context, id uint, req *UpdateReq) error
 	GetByID(ctx context.Context, id uint) (*Resp, error)
-	GetAll(ctx context.Context, page, pageSize int) (*dto.PaginatedResponse[Resp], error)
+	GetByPublicID(ctx context.Context, publicID uuid.UUID) (*Resp, error)
+	GetAll(ctx context.Context, opts repository.ListOptions) (*dto.PaginatedResponse[Resp], error)
+	Count(ctx context.Context, opts repository.ListOptions) (*dto.CountResponse, error)
+	Search(ctx context.Context, query string, opts repository.ListOptions) (*dto.PaginatedResponse[dto.SearchHit[Resp]], error)
+	Searchable() bool
+	GetAllAfterCursor(ctx context.Context, cursor *repository.Cursor, pageSize int) (*dto.CursorPaginatedResponse[Resp], error)
+	StreamAll(ctx context.Context, batchSize int, fn func(resp *Resp) error) error
 	Update(ctx context.Context, id uint, req *UpdateReq) (*Resp, error)
+	Patch(ctx context.Context, id uint, fieldMask []string, req *UpdateReq) (*Resp, error)
 	Delete(ctx context.Context, id uint) error
+	GetDeleted(ctx context.Context, page, pageSize int, countMode repository.CountMode, dateRange repository.DateRange) (*dto.PaginatedResponse[Resp], error)
+	Restore(ctx context.Context, id uint) (*Resp, error)
+	Duplicate(ctx context.Context, id uint, overrides map[string]interface{}) (*Resp, error)
+	DeletePermanently(ctx context.Context, id uint) error
+	GetHistory(ctx context.Context, id uint, filter audit.Filter, page, pageSize int) (*dto.PaginatedResponse[audit.EntryResponse], error)
+	DiffVersions(ctx context.Context, id uint, from, to audit.VersionRef) (*audit.DiffResponse, error)
+	BulkUpsert(ctx context.Context, key string, reqs []CreateReq) (*dto.BulkResponse, error)
+	Import(ctx context.Context, rows []dto.ImportRow[CreateReq]) (*dto.ImportResponse, error)
+	UpdateWhere(ctx context.Context, update *BulkUpdate) (int64, error)
+	CheckParent(ctx context.Context, relation ParentRelation, parentID uint) error
+	CheckInParent(ctx context.Context, relation ParentRelation, parentID, id uint) error
+	GetAllByParent(ctx context.Context, relation ParentRelation, parentID uint, page, pageSize int, countMode repository.CountMode) (*dto.PaginatedResponse[Resp], error)
+	GetByIDInParent(ctx context.Context, relation ParentRelation, parentID, id uint) (*Resp, error)
 }
 
 // ServiceConfig contém as configurações do serviço
 type ServiceConfig struct {
-	EntityName   string // Nome da entidade para logs e mensagens
-	DefaultOrder string // Ordenação padrão
-	MaxPageSize  int    // Tamanho máximo da página
+	EntityName    string // Nome da entidade para logs e mensagens
+	DefaultOrder  string // Ordenação padrão
+	MaxPageSize   int    // Tamanho máximo da página
+	CoalesceReads bool   // Agrupa leituras idênticas simultâneas em uma única query (singleflight)
+
+	NaturalKeys []string // Colunas aceitas como chave natural no upsert em lote (ex: codigo)
+	MaxBulkSize int      // Quantidade máxima de itens por lote
+
+	MaxImportRows   int // Quantidade máxima de linhas por arquivo de importação
+	ImportBatchSize int // Linhas gravadas por transação na importação
+
+	ValidatorOptional bool // Dispensa o validador de negócio na verificação da inicialização (SelfCheck)
+	GoneWhenDeleted   bool // Busca/alteração por ID de registro na lixeira retorna GONE (410) em vez de NOT_FOUND
 }
 
 // DefaultServiceConfig retorna configuração padrão
 func DefaultServiceConfig(entityName string) *ServiceConfig {
 	return &ServiceConfig{
-		EntityName:   entityName,
-		DefaultOrder: "id ASC",
-		MaxPageSize:  100,
+		EntityName:    entityName,
+		DefaultOrder:  "id ASC",
+		MaxPageSize:   100,
+		CoalesceReads: true,
+		MaxBulkSize:   1000,
+
+		MaxImportRows:   10000,
+		ImportBatchSize: 500,
 	}
 }
 
 // BaseServiceImpl é a implementação base do serviço genérico
 // E é o tipo ponteiro da entidade que implementa entity.Entity (ex: *models.Categoria)
 type BaseServiceImpl[E entity.Entity, CreateReq any, UpdateReq any, Resp any] struct {
-	repo            *repository.BaseRepositoryImpl[E]
+	repo            repository.BaseRepository[E]
 	mapper          dto.Mapper[E, CreateReq, UpdateReq, Resp]
 	validator       EntityValidator[E, CreateReq, UpdateReq]
+	hooks           ServiceHooks[E, CreateReq, UpdateReq]
+	authorizer      Authorizer[E]
+	events          *events.Bus // Barramento dos eventos de entidade (WithEvents); nil = desabilitado
+	outbox          *outbox.Outbox
+	outboxTopic     string
 	structValidator *StructValidator
-	log             *logrus.Logger
+	log             logging.Logger
 	Config          *ServiceConfig
+	reads           singleflight.Group
+	auditor         *audit.Auditor
+	uow             *repository.UnitOfWork
+	cached          *repository.CachedRepository[E] // Cache de leitura (WithCache); nil = desabilitado
 }
 
 // NewBaseService cria uma nova instância do serviço base
+// repo é o repositório base adaptado (repo.AsBaseRepository()) ou, nos testes, um falso (ver repository/mocks)
 func NewBaseService[E entity.Entity, CreateReq any, UpdateReq any, Resp any](
-	repo *repository.BaseRepositoryImpl[E],
+	repo repository.BaseRepository[E],
 	mapper dto.Mapper[E, CreateReq, UpdateReq, Resp],
-	log *logrus.Logger,
+	log logging.Logger,
 	config *ServiceConfig,
 ) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
-	return &BaseServiceImpl[E, CreateReq, UpdateReq, Resp]{
+	s := &BaseServiceImpl[E, CreateReq, UpdateReq, Resp]{
 		repo:            repo,
 		mapper:          mapper,
 		validator:       &NoOpValidator[E, CreateReq, UpdateReq]{},
-		structValidator: NewStructValidator(),
+		hooks:           NoOpHooks[E, CreateReq, UpdateReq]{},
+		authorizer:      AllowAll[E]{},
+		events:          events.Default(),
+		structValidator: DefaultStructValidator(),
 		log:             log,
 		Config:          config,
 	}
+	if repo != nil {
+		s.uow = repository.NewUnitOfWork(repo.GetDB())
+	}
+
+	// A montagem é verificada na inicialização, após a configuração do validador
+	selfcheck.Default().Register("service:"+config.EntityName, s)
+	return s
 }
 
 // WithValidator configura um validador customizado
@@ -71,47 +136,108 @@ func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithValidator(v EntityV
 	return s
 }
 
+// WithValidators configura vários validadores executados em ordem, com os resultados combinados
+// (ver ValidatorChain). Permite compor validadores transversais (ex: tenant, cota) com os da entidade
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithValidators(validators ...EntityValidator[E, CreateReq, UpdateReq]) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	s.validator = NewValidatorChain(validators...)
+	return s
+}
+
+// WithUnitOfWork configura a unidade de trabalho usada nas escritas (padrão: conexão do repositório)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithUnitOfWork(uow *repository.UnitOfWork) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	s.uow = uow
+	return s
+}
+
 // GetRepository retorna o repositório para uso em validações
-func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetRepository() *repository.BaseRepositoryImpl[E] {
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetRepository() repository.BaseRepository[E] {
 	return s.repo
 }
 
 // GetLogger retorna o logger para uso em validações
-func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetLogger() *logrus.Logger {
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetLogger() logging.Logger {
 	return s.log
 }
 
+// CoalesceRead executa a leitura identificada por key agrupando chamadas simultâneas:
+// enquanto uma leitura está em andamento, as demais com a mesma chave aguardam e recebem o mesmo resultado
+// O resultado é compartilhado entre os chamadores e não deve ser modificado
+// fn recebe o contexto da leitura, que mantém o prazo do chamador; o cancelamento de um chamador
+// não interrompe a leitura compartilhada. Com transação no contexto a leitura não é agrupada
+// (deve enxergar as escritas da própria transação)
+// Exportado para uso em leituras específicas dos serviços filhos (ex: listagem de ativos)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) CoalesceRead(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if _, inTx := repository.TxFromContext(ctx); inTx || !s.Config.CoalesceReads {
+		return fn(ctx)
+	}
+
+	result, err, shared := s.reads.Do(key, func() (interface{}, error) {
+		readCtx, cancel := sharedReadContext(ctx)
+		defer cancel()
+		return fn(readCtx)
+	})
+	if shared {
+		s.log.WithFields(logging.Fields{
+			"entity": s.Config.EntityName,
+			"key":    key,
+		}).Debug("Leitura agrupada (singleflight)")
+	}
+	return result, err
+}
+
 // Create cria uma nova entidade
 func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Create(ctx context.Context, req *CreateReq) (*Resp, error) {
 	s.log.WithField("entity", s.Config.EntityName).Info("Iniciando criação")
 
 	// Validação de struct (tags de validação)
-	if structErrors := s.structValidator.ToValidationResult(req); structErrors != nil && structErrors.HasErrors() {
+	if structErrors := s.structValidator.ToValidationResult(ctx, req); structErrors != nil && structErrors.HasErrors() {
 		s.log.WithField("errors", structErrors.Errors).Warn("Erro de validação de struct na criação")
-		return nil, &arqerrors.ValidationErrors{Errors: structErrors.Errors}
+		return nil, structErrors.ToErrors()
 	}
 
-	// Validação customizada da entidade
-	validationCtx := &ValidationContext{
-		Context:   ctx,
-		Operation: OperationCreate,
-	}
+	// Validação, persistência e auditoria na mesma transação
+	var entity E
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		// Validação customizada da entidade
+		if err := s.validateCreate(ctx, req); err != nil {
+			return err
+		}
 
-	if customErrors := s.validator.ValidateCreate(validationCtx, req); customErrors != nil && customErrors.HasErrors() {
-		s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na criação")
-		return nil, &arqerrors.ValidationErrors{Errors: customErrors.Errors}
-	}
+		// Converte request para entidade
+		var err error
+		entity, err = s.toEntity(ctx, req)
+		if err != nil {
+			return err
+		}
+		if err := s.authorize(ctx, OperationCreate, entity); err != nil {
+			return err
+		}
+		if err := s.hooks.BeforeCreate(ctx, req, entity); err != nil {
+			return err
+		}
 
-	// Converte request para entidade
-	entity := s.mapper.ToEntity(req)
+		// Persiste no banco
+		if err := s.repo.WithContext(ctx).Create(entity); err != nil {
+			s.log.WithError(err).Error("Erro ao criar no banco de dados")
+			return err
+		}
 
-	// Persiste no banco
-	if err := s.repo.Create(entity); err != nil {
-		s.log.WithError(err).Error("Erro ao criar no banco de dados")
+		s.audit(ctx, entity.GetID(), audit.OperationCreate, nil, audit.Snapshot(entity))
+		if err := s.hooks.AfterCreate(ctx, entity); err != nil {
+			return err
+		}
+		if err := s.enqueue(ctx, events.TypeCreated, entity); err != nil {
+			return err
+		}
+		s.publishCreated(ctx, entity)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	s.log.WithField("entity", s.Config.EntityName).Info("Criado com sucesso")
+	s.InvalidateCache(ctx)
 
 	// Converte para response
 	response := s.mapper.ToResponse(entity)
@@ -120,97 +246,377 @@ func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Create(ctx context.Cont
 
 // GetByID busca uma entidade pelo ID
 func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetByID(ctx context.Context, id uint) (*Resp, error) {
-	s.log.WithFields(logrus.Fields{
+	s.log.WithFields(logging.Fields{
 		"entity": s.Config.EntityName,
 		"id":     id,
 	}).Info("Buscando por ID")
 
-	entity, err := s.repo.FindByID(id)
+	// Requisições simultâneas pelo mesmo ID compartilham a mesma query
+	result, err := s.CoalesceRead(ctx, "id:"+strconv.FormatUint(uint64(id), 10), func(ctx context.Context) (interface{}, error) {
+		return s.findByID(ctx, id)
+	})
 	if err != nil {
 		if arqerrors.IsNotFound(err) {
 			s.log.WithField("id", id).Warn("Não encontrado")
-			return nil, arqerrors.NewBusinessError("NOT_FOUND", s.Config.EntityName+" não encontrado(a)")
+			return nil, s.notFound(ctx, id)
 		}
 		s.log.WithError(err).Error("Erro ao buscar")
 		return nil, err
 	}
 
-	response := s.mapper.ToResponse(entity)
-	return response, nil
+	entity, ok := result.(E)
+	if !ok || isNilEntity(entity) {
+		return nil, s.notFound(ctx, id)
+	}
+	if err := s.authorize(ctx, OperationRead, entity); err != nil {
+		return nil, err
+	}
+	return s.mapper.ToResponse(entity), nil
 }
 
-// GetAll retorna todas as entidades com paginação
-func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetAll(ctx context.Context, page, pageSize int) (*dto.PaginatedResponse[Resp], error) {
-	s.log.WithFields(logrus.Fields{
-		"entity":   s.Config.EntityName,
-		"page":     page,
-		"pageSize": pageSize,
+// GetByPublicID busca uma entidade pelo identificador público (UUID)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetByPublicID(ctx context.Context, publicID uuid.UUID) (*Resp, error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"public_id": publicID,
+	}).Info("Buscando por UUID")
+
+	result, err := s.CoalesceRead(ctx, "uuid:"+publicID.String(), func(ctx context.Context) (interface{}, error) {
+		return s.repo.WithContext(ctx).FindByPublicID(publicID)
+	})
+	if err != nil {
+		if arqerrors.IsNotFound(err) {
+			s.log.WithField("public_id", publicID).Warn("Não encontrado")
+			return nil, arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFound))
+		}
+		s.log.WithError(err).Error("Erro ao buscar por UUID")
+		return nil, err
+	}
+
+	entity, ok := result.(E)
+	if !ok || isNilEntity(entity) {
+		return nil, arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFound))
+	}
+	if err := s.authorize(ctx, OperationRead, entity); err != nil {
+		return nil, err
+	}
+	return s.mapper.ToResponse(entity), nil
+}
+
+// GetAll retorna as entidades com paginação conforme as opções da listagem: ordenação (Sort, ou
+// Config.DefaultOrder), filtros, período, modo de contagem, seleção de campos, preloads e lixeira
+// Com condições, a contagem estimada é substituída pela exata. Fields limita as colunas carregadas
+// (vazio = todas): os demais campos das respostas ficam com o valor zero
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetAll(ctx context.Context, opts repository.ListOptions) (*dto.PaginatedResponse[Resp], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"page":      opts.Page,
+		"pageSize":  opts.PageSize,
+		"countMode": opts.CountMode,
+		"filtered":  opts.Filtered(),
+		"sort":      opts.Sort.String(),
 	}).Info("Listando")
 
-	// Normaliza paginação
-	page, pageSize = s.normalizePagination(page, pageSize)
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
 
-	entities, total, err := s.repo.FindAll(page, pageSize, s.Config.DefaultOrder)
+	// Normaliza paginação e ordenação
+	opts.Page, opts.PageSize = s.normalizePagination(opts.Page, opts.PageSize)
+	if opts.CountMode == "" {
+		opts.CountMode = repository.CountExact
+	}
+	if len(opts.Sort) > 0 {
+		var err error
+		if opts.OrderBy, err = s.repo.SortOrder(opts.Sort); err != nil {
+			return nil, err
+		}
+		opts.Sort = nil
+	} else if opts.OrderBy == "" {
+		opts.OrderBy = s.Config.DefaultOrder
+	}
+
+	var result *repository.PageResult[E]
+	var err error
+	if s.cached != nil && !opts.Filtered() && len(opts.Fields) == 0 && opts.Preloads == nil {
+		result, err = s.cached.WithContext(ctx).FindAllWithCountMode(opts.Page, opts.PageSize, opts.OrderBy, opts.CountMode)
+	} else {
+		result, err = s.repo.WithContext(ctx).FindAllWithOptions(opts)
+	}
 	if err != nil {
 		s.log.WithError(err).Error("Erro ao listar")
 		return nil, err
 	}
 
 	// Converte para responses
-	responses := make([]Resp, len(entities))
-	for i := range entities {
-		responses[i] = *s.mapper.ToResponse(entities[i])
+	responses := MapResponses(result.Items, s.mapper.ToResponse)
+
+	return ToPaginatedResponse(responses, result, opts.Page, opts.PageSize), nil
+}
+
+// Count retorna o total de entidades que atendem às condições das opções (filtros, período e lixeira),
+// sem carregar os registros. Com CountEstimated e sem condições, usa a estimativa da tabela
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Count(ctx context.Context, opts repository.ListOptions) (*dto.CountResponse, error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"countMode": opts.CountMode,
+		"filtered":  opts.Filtered(),
+	}).Info("Contando")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
 	}
 
-	return dto.NewPaginatedResponse(responses, total, page, pageSize), nil
+	total, mode, err := s.repo.WithContext(ctx).CountWithOptions(opts)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao contar")
+		return nil, err
+	}
+
+	return &dto.CountResponse{
+		Total:     total,
+		Estimated: mode == repository.CountEstimated,
+	}, nil
 }
 
-// Update atualiza uma entidade existente
-func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Update(ctx context.Context, id uint, req *UpdateReq) (*Resp, error) {
-	s.log.WithFields(logrus.Fields{
-		"entity": s.Config.EntityName,
-		"id":     id,
-	}).Info("Iniciando atualização")
+// Searchable indica se a entidade participa da busca textual (declara entity.Searchable)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Searchable() bool {
+	return s.repo.Searchable()
+}
 
-	// Busca a entidade existente
-	entity, err := s.repo.FindByID(id)
+// Search busca as entidades pelo texto nas colunas de busca da entidade, ordenadas pela relevância
+// Cada resultado traz o response, a relevância e os trechos destacados. Das opções, a ordenação é ignorada
+// (ver repository.BaseRepositoryImpl.Search); os resultados não usam o cache das listagens
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Search(ctx context.Context, query string, opts repository.ListOptions) (*dto.PaginatedResponse[dto.SearchHit[Resp]], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"page":      opts.Page,
+		"pageSize":  opts.PageSize,
+		"countMode": opts.CountMode,
+		"filtered":  opts.Filtered(),
+	}).Info("Buscando")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
+
+	opts.Page, opts.PageSize = s.normalizePagination(opts.Page, opts.PageSize)
+
+	result, err := s.repo.WithContext(ctx).Search(query, opts)
 	if err != nil {
-		if arqerrors.IsNotFound(err) {
-			s.log.WithField("id", id).Warn("Não encontrado para atualização")
-			return nil, arqerrors.NewBusinessError("NOT_FOUND", s.Config.EntityName+" não encontrado(a)")
+		s.log.WithError(err).Error("Erro na busca")
+		return nil, err
+	}
+
+	hits := make([]dto.SearchHit[Resp], 0, len(result.Items))
+	for _, item := range result.Items {
+		response := s.mapper.ToResponse(item.Entity)
+		if response == nil {
+			continue
 		}
-		s.log.WithError(err).Error("Erro ao buscar para atualização")
+		hits = append(hits, dto.SearchHit[Resp]{Item: *response, Rank: item.Rank, Highlights: item.Highlights})
+	}
+
+	return ToPaginatedResponse(hits, result, opts.Page, opts.PageSize), nil
+}
+
+// GetAllAfterCursor retorna a página seguinte ao cursor (paginação por keyset, na ordenação padrão)
+// Indicada para tabelas grandes: o custo não cresce com a profundidade da página. cursor nil retorna a primeira página
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetAllAfterCursor(ctx context.Context, cursor *repository.Cursor, pageSize int) (*dto.CursorPaginatedResponse[Resp], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":   s.Config.EntityName,
+		"pageSize": pageSize,
+		"cursor":   cursor != nil,
+	}).Info("Listando por cursor")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
 		return nil, err
 	}
 
-	// Validação de struct (tags de validação)
-	if structErrors := s.structValidator.ToValidationResult(req); structErrors != nil && structErrors.HasErrors() {
-		s.log.WithField("errors", structErrors.Errors).Warn("Erro de validação de struct na atualização")
-		return nil, &arqerrors.ValidationErrors{Errors: structErrors.Errors}
+	_, pageSize = s.normalizePagination(1, pageSize)
+
+	result, err := s.repo.WithContext(ctx).FindAfterCursor(cursor, pageSize, s.Config.DefaultOrder)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao listar por cursor")
+		return nil, err
 	}
 
-	// Validação customizada da entidade
-	validationCtx := &ValidationContext{
-		Context:   ctx,
-		Operation: OperationUpdate,
-		EntityID:  id,
+	return &dto.CursorPaginatedResponse[Resp]{
+		Data:       MapResponses(result.Items, s.mapper.ToResponse),
+		PageSize:   pageSize,
+		HasNext:    result.HasNext,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// StreamAll percorre todas as entidades em lotes, entregando cada response a fn
+// Usado em exportações: apenas um lote é mantido em memória por vez
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) StreamAll(ctx context.Context, batchSize int, fn func(resp *Resp) error) error {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"batchSize": batchSize,
+	}).Info("Exportando")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return err
+	}
+
+	if batchSize < 1 {
+		batchSize = s.Config.MaxPageSize
 	}
 
-	if customErrors := s.validator.ValidateUpdate(validationCtx, entity, req); customErrors != nil && customErrors.HasErrors() {
-		s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na atualização")
-		return nil, &arqerrors.ValidationErrors{Errors: customErrors.Errors}
+	err := s.repo.WithContext(ctx).FindInBatches(batchSize, func(batch []E) error {
+		for _, entity := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			response := s.mapper.ToResponse(entity)
+			if response == nil {
+				continue
+			}
+			if err := fn(response); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao exportar")
 	}
+	return err
+}
 
-	// Aplica as alterações
-	s.mapper.ApplyUpdate(entity, req)
+// ToPaginatedResponse monta a resposta paginada conforme o modo de contagem utilizado
+// Exportado para uso em serviços específicos com listagens próprias
+func ToPaginatedResponse[E any, Resp any](responses []Resp, result *repository.PageResult[E], page, pageSize int) *dto.PaginatedResponse[Resp] {
+	if result.CountMode == repository.CountNone {
+		return dto.NewPaginatedResponseWithoutTotal(responses, page, pageSize, result.HasNext)
+	}
 
-	// Persiste no banco
-	if err := s.repo.Update(entity); err != nil {
-		s.log.WithError(err).Error("Erro ao atualizar no banco de dados")
+	response := dto.NewPaginatedResponse(responses, result.Total, page, pageSize)
+	response.HasNext = result.HasNext
+	response.TotalEstimated = result.CountMode == repository.CountEstimated
+	return response
+}
+
+// Update atualiza uma entidade existente
+// Busca, validação, persistência e auditoria são executadas na mesma transação
+// Com WithExpectedUpdatedAt no contexto, registros alterados após a data informada são rejeitados (409)
+// Apenas as colunas alteradas pelo request são gravadas (UpdateFields), sem sobrescrever as demais
+// Campos zero do request ("", 0, nil) são tratados como não informados; para gravá-los use Patch
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Update(ctx context.Context, id uint, req *UpdateReq) (*Resp, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+	}).Info("Iniciando atualização")
+
+	return s.update(ctx, id, req, func(ctx context.Context, entity E) error {
+		return s.applyUpdate(ctx, entity, req)
+	})
+}
+
+// Patch atualiza apenas os campos da máscara (nomes JSON do request, ex: []string{"descricao", "preco"}),
+// inclusive com valores zero: "" e 0 são gravados e ponteiros nil limpam o campo (NULL)
+// Campo da máscara inexistente no request retorna erro de validação; máscara vazia não altera nada
+// Segue o mesmo fluxo do Update (validações, ganchos, auditoria e eventos na mesma transação)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Patch(ctx context.Context, id uint, fieldMask []string, req *UpdateReq) (*Resp, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+		"mask":   fieldMask,
+	}).Info("Iniciando atualização parcial")
+
+	return s.update(ctx, id, req, func(ctx context.Context, entity E) error {
+		return s.applyPatch(ctx, entity, req, fieldMask)
+	})
+}
+
+// update executa a atualização aplicando o request na entidade com apply (Update ou Patch)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) update(ctx context.Context, id uint, req *UpdateReq, apply func(ctx context.Context, entity E) error) (*Resp, error) {
+	var entity E
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		// Busca a entidade existente
+		var err error
+		entity, err = s.repo.WithContext(ctx).FindByID(id)
+		if err != nil {
+			if arqerrors.IsNotFound(err) {
+				s.log.WithField("id", id).Warn("Não encontrado para atualização")
+				return s.notFound(ctx, id)
+			}
+			s.log.WithError(err).Error("Erro ao buscar para atualização")
+			return err
+		}
+		if err := s.authorize(ctx, OperationUpdate, entity); err != nil {
+			return err
+		}
+
+		// Pré-condição da requisição (If-Unmodified-Since / expected_updated_at)
+		if err := s.checkPrecondition(ctx, entity); err != nil {
+			return err
+		}
+
+		// Validação de struct (tags de validação) e customizada da entidade
+		if err := s.validateUpdate(ctx, id, entity, req); err != nil {
+			return err
+		}
+
+		// Estado anterior para a trilha de auditoria
+		var before map[string]interface{}
+		if s.auditor != nil {
+			before = audit.Snapshot(entity)
+		}
+		columns, err := s.repo.ColumnValues(entity)
+		if err != nil {
+			return err
+		}
+		previous := cloneEntity(entity)
+
+		// Aplica as alterações
+		if err := apply(ctx, entity); err != nil {
+			return err
+		}
+		if err := s.hooks.BeforeUpdate(ctx, req, entity); err != nil {
+			return err
+		}
+
+		// Persiste apenas as colunas alteradas pelo request, preservando alterações concorrentes nas demais
+		changed, err := s.repo.ChangedColumns(columns, entity)
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 {
+			s.log.WithField("id", id).Debug("Nenhum campo alterado na atualização")
+			return nil
+		}
+		if err := s.repo.WithContext(ctx).UpdateFields(id, changed); err != nil {
+			if arqerrors.IsNotFound(err) {
+				return arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFound))
+			}
+			s.log.WithError(err).Error("Erro ao atualizar no banco de dados")
+			return err
+		}
+
+		s.audit(ctx, id, audit.OperationUpdate, before, audit.Snapshot(entity))
+
+		// Recarrega o registro gravado (updated_at, alterações concorrentes e relacionamentos)
+		if entity, err = s.repo.WithContext(ctx).FindByID(id); err != nil {
+			return err
+		}
+		if err := s.hooks.AfterUpdate(ctx, previous, entity); err != nil {
+			return err
+		}
+		if err := s.enqueue(ctx, events.TypeUpdated, entity); err != nil {
+			return err
+		}
+		s.publishUpdated(ctx, previous, entity)
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	s.log.WithField("id", id).Info("Atualizado com sucesso")
+	s.InvalidateCache(ctx)
 
 	// Converte para response
 	response := s.mapper.ToResponse(entity)
@@ -218,42 +624,65 @@ func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Update(ctx context.Cont
 }
 
 // Delete remove uma entidade pelo ID
+// Busca, validação, exclusão e auditoria são executadas na mesma transação
 func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Delete(ctx context.Context, id uint) error {
-	s.log.WithFields(logrus.Fields{
+	s.log.WithFields(logging.Fields{
 		"entity": s.Config.EntityName,
 		"id":     id,
 	}).Info("Iniciando exclusão")
 
-	// Busca a entidade existente
-	entity, err := s.repo.FindByID(id)
-	if err != nil {
-		if arqerrors.IsNotFound(err) {
-			s.log.WithField("id", id).Warn("Não encontrado para exclusão")
-			return arqerrors.NewBusinessError("NOT_FOUND", s.Config.EntityName+" não encontrado(a)")
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		// Busca a entidade existente
+		entity, err := s.repo.WithContext(ctx).FindByID(id)
+		if err != nil {
+			if arqerrors.IsNotFound(err) {
+				s.log.WithField("id", id).Warn("Não encontrado para exclusão")
+				return s.notFound(ctx, id)
+			}
+			s.log.WithError(err).Error("Erro ao buscar para exclusão")
+			return err
+		}
+		if err := s.authorize(ctx, OperationDelete, entity); err != nil {
+			return err
 		}
-		s.log.WithError(err).Error("Erro ao buscar para exclusão")
-		return err
-	}
 
-	// Validação customizada da entidade
-	validationCtx := &ValidationContext{
-		Context:   ctx,
-		Operation: OperationDelete,
-		EntityID:  id,
-	}
+		// Validação customizada da entidade
+		validationCtx := &ValidationContext{
+			Context:   ctx,
+			Operation: OperationDelete,
+			EntityID:  id,
+		}
 
-	if customErrors := s.validator.ValidateDelete(validationCtx, entity); customErrors != nil && customErrors.HasErrors() {
-		s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na exclusão")
-		return &arqerrors.ValidationErrors{Errors: customErrors.Errors}
-	}
+		if customErrors := s.validator.ValidateDelete(validationCtx, entity); customErrors != nil && customErrors.HasErrors() {
+			s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na exclusão")
+			return customErrors.ToErrors()
+		}
+		if err := s.hooks.BeforeDelete(ctx, entity); err != nil {
+			return err
+		}
 
-	// Remove do banco
-	if err := s.repo.Delete(id); err != nil {
-		s.log.WithError(err).Error("Erro ao excluir do banco de dados")
+		// Remove do banco
+		if err := s.repo.WithContext(ctx).Delete(id); err != nil {
+			s.log.WithError(err).Error("Erro ao excluir do banco de dados")
+			return err
+		}
+
+		s.audit(ctx, id, audit.OperationDelete, audit.Snapshot(entity), nil)
+		if err := s.hooks.AfterDelete(ctx, entity); err != nil {
+			return err
+		}
+		if err := s.enqueue(ctx, events.TypeDeleted, entity); err != nil {
+			return err
+		}
+		s.publishDeleted(ctx, entity)
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
 	s.log.WithField("id", id).Info("Excluído com sucesso")
+	s.InvalidateCache(ctx)
 	return nil
 }
 
@@ -270,3 +699,18 @@ func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) normalizePagination(pag
 	}
 	return page, pageSize
 }
+
+// message traduz a mensagem para o idioma da requisição, com o nome da entidade no placeholder {entity}
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) message(ctx context.Context, key string) string {
+	return i18n.T(ctx, key, i18n.Params{"entity": i18n.Entity(ctx, s.Config.EntityName)})
+}
+
+// sharedReadContext cria o contexto de uma leitura agrupada: mantém os valores e o prazo do chamador,
+// mas não é cancelado junto com ele (os demais chamadores aguardam o mesmo resultado)
+func sharedReadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return detached, func() {}
+}
@@ -0,0 +1,275 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/mapper"
+	"api_fibergorm/pkg/arquitetura/repository"
+	"api_fibergorm/pkg/arquitetura/repository/mocks"
+	"api_fibergorm/pkg/arquitetura/service"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+type item struct {
+	entity.BaseEntity
+	Nome  string `gorm:"size:100;not null"`
+	Ativo bool
+}
+
+func (item) TableName() string { return "itens" }
+
+type createItemRequest struct {
+	Nome string `json:"nome" validate:"required"`
+}
+
+type updateItemRequest struct {
+	Nome string `json:"nome"`
+}
+
+type itemResponse struct {
+	ID    uint   `json:"id"`
+	Nome  string `json:"nome"`
+	Ativo bool   `json:"ativo"`
+}
+
+// recordingHooks registra os ganchos executados, com o nome da entidade no momento da chamada
+type recordingHooks struct {
+	service.NoOpHooks[*item, createItemRequest, updateItemRequest]
+	calls       []string
+	beforeError error
+}
+
+func (h *recordingHooks) BeforeCreate(ctx context.Context, req *createItemRequest, e *item) error {
+	h.calls = append(h.calls, "BeforeCreate:"+e.Nome)
+	if h.beforeError != nil {
+		return h.beforeError
+	}
+	e.Ativo = true
+	return nil
+}
+
+func (h *recordingHooks) AfterCreate(ctx context.Context, e *item) error {
+	h.calls = append(h.calls, "AfterCreate:"+e.Nome)
+	return nil
+}
+
+func (h *recordingHooks) BeforeUpdate(ctx context.Context, req *updateItemRequest, e *item) error {
+	h.calls = append(h.calls, "BeforeUpdate:"+e.Nome)
+	return h.beforeError
+}
+
+func (h *recordingHooks) AfterUpdate(ctx context.Context, before, after *item) error {
+	h.calls = append(h.calls, "AfterUpdate:"+before.Nome+"->"+after.Nome)
+	return nil
+}
+
+func (h *recordingHooks) BeforeDelete(ctx context.Context, e *item) error {
+	h.calls = append(h.calls, "BeforeDelete:"+e.Nome)
+	return h.beforeError
+}
+
+func (h *recordingHooks) AfterDelete(ctx context.Context, e *item) error {
+	h.calls = append(h.calls, "AfterDelete:"+e.Nome)
+	return nil
+}
+
+// newItemService monta o serviço com o repositório falso e os ganchos de teste
+func newItemService(t *testing.T, hooks *recordingHooks, seed ...*item) (*service.BaseServiceImpl[*item, createItemRequest, updateItemRequest, itemResponse], *mocks.Repository[*item]) {
+	t.Helper()
+
+	log := logrus.New()
+	log.SetLevel(logrus.PanicLevel)
+
+	repo := mocks.NewRepository[*item](seed...)
+	svc := service.NewBaseService[*item, createItemRequest, updateItemRequest, itemResponse](
+		repo,
+		mapper.NewAutoMapper[*item, createItemRequest, updateItemRequest, itemResponse](),
+		logging.NewLogrus(log),
+		service.DefaultServiceConfig("Item"),
+	).WithHooks(hooks)
+	return svc, repo
+}
+
+func assertCalls(t *testing.T, got []string, expected ...string) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("ganchos = %v, esperado %v", got, expected)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("ganchos = %v, esperado %v", got, expected)
+		}
+	}
+}
+
+func TestBaseServiceCreateRunsHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks)
+
+	resp, err := svc.Create(context.Background(), &createItemRequest{Nome: "Teclado"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if resp.ID == 0 || resp.Nome != "Teclado" {
+		t.Errorf("response = %+v", resp)
+	}
+	if !resp.Ativo {
+		t.Error("ajuste de BeforeCreate não foi gravado")
+	}
+	assertCalls(t, hooks.calls, "BeforeCreate:Teclado", "AfterCreate:Teclado")
+
+	if items := repo.Items(); len(items) != 1 || !items[0].Ativo {
+		t.Errorf("itens gravados = %+v", items)
+	}
+}
+
+func TestBaseServiceCreateHookErrorAbortsInsert(t *testing.T) {
+	hookErr := arqerrors.NewBusinessError("BLOQUEADO", "criação bloqueada")
+	hooks := &recordingHooks{beforeError: hookErr}
+	svc, repo := newItemService(t, hooks)
+
+	_, err := svc.Create(context.Background(), &createItemRequest{Nome: "Teclado"})
+	if !errors.Is(err, hookErr) {
+		t.Fatalf("erro = %v, esperado o erro do gancho", err)
+	}
+	assertCalls(t, hooks.calls, "BeforeCreate:Teclado")
+	if items := repo.Items(); len(items) != 0 {
+		t.Errorf("itens gravados = %+v, esperado nenhum", items)
+	}
+}
+
+func TestBaseServiceCreateValidatesStruct(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, _ := newItemService(t, hooks)
+
+	_, err := svc.Create(context.Background(), &createItemRequest{})
+	if err == nil {
+		t.Fatal("esperado erro de validação com nome vazio")
+	}
+	assertCalls(t, hooks.calls)
+}
+
+func TestBaseServiceUpdateRunsHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks, &item{Nome: "Teclado"})
+	id := repo.Items()[0].ID
+
+	resp, err := svc.Update(context.Background(), id, &updateItemRequest{Nome: "Mouse"})
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if resp.Nome != "Mouse" {
+		t.Errorf("nome = %q, esperado Mouse", resp.Nome)
+	}
+	assertCalls(t, hooks.calls, "BeforeUpdate:Mouse", "AfterUpdate:Teclado->Mouse")
+
+	stored, err := repo.FindByID(id)
+	if err != nil || stored.Nome != "Mouse" {
+		t.Errorf("registro gravado = %+v, %v", stored, err)
+	}
+}
+
+func TestBaseServiceUpdateWithoutChangesSkipsAfterUpdate(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks, &item{Nome: "Teclado"})
+
+	if _, err := svc.Update(context.Background(), repo.Items()[0].ID, &updateItemRequest{Nome: "Teclado"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	assertCalls(t, hooks.calls, "BeforeUpdate:Teclado")
+}
+
+func TestBaseServiceUpdateNotFound(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, _ := newItemService(t, hooks)
+
+	_, err := svc.Update(context.Background(), 99, &updateItemRequest{Nome: "Mouse"})
+	if businessErr, ok := arqerrors.GetBusinessError(err); !ok || businessErr.Code != "NOT_FOUND" {
+		t.Fatalf("erro = %v, esperado NOT_FOUND", err)
+	}
+	assertCalls(t, hooks.calls)
+}
+
+func TestBaseServiceDeleteRunsHooks(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks, &item{Nome: "Teclado"})
+	id := repo.Items()[0].ID
+
+	if err := svc.Delete(context.Background(), id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	assertCalls(t, hooks.calls, "BeforeDelete:Teclado", "AfterDelete:Teclado")
+
+	if items := repo.Items(); len(items) != 0 {
+		t.Errorf("itens ativos = %+v, esperado nenhum", items)
+	}
+	if deleted := repo.Deleted(); len(deleted) != 1 || deleted[0].ID != id {
+		t.Errorf("lixeira = %+v", deleted)
+	}
+}
+
+func TestBaseServiceDeleteHookErrorKeepsRecord(t *testing.T) {
+	hookErr := errors.New("exclusão bloqueada")
+	hooks := &recordingHooks{beforeError: hookErr}
+	svc, repo := newItemService(t, hooks, &item{Nome: "Teclado"})
+
+	if err := svc.Delete(context.Background(), repo.Items()[0].ID); !errors.Is(err, hookErr) {
+		t.Fatalf("erro = %v, esperado o erro do gancho", err)
+	}
+	assertCalls(t, hooks.calls, "BeforeDelete:Teclado")
+	if items := repo.Items(); len(items) != 1 {
+		t.Errorf("itens ativos = %+v, esperado o registro mantido", items)
+	}
+}
+
+func TestBaseServiceCreateRetriesSerializationFailure(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks)
+	svc.WithUnitOfWork(repository.NewUnitOfWork(nil).WithRetry(repository.RetryPolicy{MaxAttempts: 2}))
+
+	// A primeira tentativa falha por serialização (40001); a segunda grava no repositório falso
+	attempts := 0
+	repo.CreateFunc = func(e *item) error {
+		attempts++
+		repo.CreateFunc = nil
+		return &pgconn.PgError{Code: "40001", Message: "could not serialize access"}
+	}
+
+	resp, err := svc.Create(context.Background(), &createItemRequest{Nome: "Teclado"})
+	if err != nil {
+		t.Fatalf("Create: %v, esperado sucesso na segunda tentativa", err)
+	}
+	if attempts != 1 || resp.ID == 0 {
+		t.Errorf("tentativas com falha = %d, response = %+v", attempts, resp)
+	}
+	assertCalls(t, hooks.calls, "BeforeCreate:Teclado", "BeforeCreate:Teclado", "AfterCreate:Teclado")
+	if items := repo.Items(); len(items) != 1 {
+		t.Errorf("itens gravados = %+v, esperado um", items)
+	}
+}
+
+func TestBaseServiceCreateReturnsConflictAfterRetries(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc, repo := newItemService(t, hooks)
+	svc.WithUnitOfWork(repository.NewUnitOfWork(nil).WithRetry(repository.RetryPolicy{MaxAttempts: 2}))
+
+	attempts := 0
+	repo.CreateFunc = func(e *item) error {
+		attempts++
+		return &pgconn.PgError{Code: "40P01", Message: "deadlock detected"}
+	}
+
+	if _, err := svc.Create(context.Background(), &createItemRequest{Nome: "Teclado"}); !repository.IsRetryable(err) {
+		t.Fatalf("erro = %v, esperado o deadlock após as tentativas", err)
+	}
+	if attempts != 2 {
+		t.Errorf("tentativas = %d, esperado 2", attempts)
+	}
+}
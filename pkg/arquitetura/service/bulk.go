@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// BulkUpsert insere ou atualiza uma lista de registros identificados pela chave natural informada
+// (ex: codigo), que deve estar em Config.NaturalKeys. Todos os itens são validados antes da gravação:
+// se algum falhar, nada é gravado e o resultado traz os erros por item. Caso contrário, as inserções
+// e atualizações são gravadas em uma única transação, com os ganchos AfterCreate/AfterUpdate e os eventos
+// de cada item (os ganchos Before* não são executados no lote)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) BulkUpsert(ctx context.Context, key string, reqs []CreateReq) (*dto.BulkResponse, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"key":    key,
+		"total":  len(reqs),
+	}).Info("Iniciando upsert em lote")
+
+	if !s.isNaturalKey(key) {
+		return nil, &arqerrors.ValidationErrors{Errors: map[string]string{
+			"key": i18n.T(ctx, i18n.MsgBulkUnsupportedKey, i18n.Params{"entity": i18n.Entity(ctx, s.Config.EntityName), "key": key}),
+		}}
+	}
+	if len(reqs) == 0 {
+		return nil, &arqerrors.ValidationErrors{Errors: map[string]string{"items": i18n.T(ctx, i18n.MsgBulkEmpty, nil)}}
+	}
+	if s.Config.MaxBulkSize > 0 && len(reqs) > s.Config.MaxBulkSize {
+		return nil, &arqerrors.ValidationErrors{Errors: map[string]string{
+			"items": i18n.T(ctx, i18n.MsgBulkTooLarge, i18n.Params{"max": s.Config.MaxBulkSize}),
+		}}
+	}
+
+	// Converte os itens e extrai as chaves naturais
+	// Itens que o mapper não consegue converter são reportados como erro do item
+	entities := make([]E, len(reqs))
+	keys := make([]interface{}, len(reqs))
+	lookup := make([]interface{}, 0, len(reqs))
+	convertErrors := make(map[int]*arqerrors.ValidationErrors)
+	for i := range reqs {
+		entity, err := s.toEntity(ctx, &reqs[i])
+		if err != nil {
+			itemErrors, ok := bulkItemErrors(err)
+			if !ok {
+				return nil, err
+			}
+			convertErrors[i] = itemErrors
+			continue
+		}
+		entities[i] = entity
+		value, err := s.repo.KeyValue(entity, key)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = value
+		lookup = append(lookup, value)
+	}
+
+	// Registros existentes com as chaves do lote (uma única query)
+	existing, err := s.repo.WithContext(ctx).FindByKeys(key, lookup)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao buscar registros existentes do lote")
+		return nil, err
+	}
+
+	response := &dto.BulkResponse{
+		Total: len(reqs),
+		Items: make([]dto.BulkItemResult, len(reqs)),
+	}
+	var creates, updates, previous []E
+	var befores []map[string]interface{}
+	seen := make(map[interface{}]int, len(reqs))
+
+	for i := range reqs {
+		item := dto.BulkItemResult{Index: i, Key: keys[i]}
+
+		if itemErrors, ok := convertErrors[i]; ok {
+			item.Status = dto.BulkStatusError
+			item.Errors = itemErrors.Errors
+			item.Codes = itemErrors.Codes
+			response.Items[i] = item
+			response.Failed++
+			continue
+		}
+
+		if first, ok := seen[keys[i]]; ok {
+			item.Status = dto.BulkStatusError
+			item.Errors = map[string]string{key: i18n.T(ctx, i18n.MsgBulkDuplicateKey, i18n.Params{"index": first})}
+			response.Items[i] = item
+			response.Failed++
+			continue
+		}
+		seen[keys[i]] = i
+
+		var codes map[string]string
+		current, found := existing[keys[i]]
+		if !found {
+			item.Status = dto.BulkStatusCreated
+			item.Errors, codes = s.authorizeBulkItem(ctx, OperationCreate, entities[i])
+			if len(item.Errors) == 0 {
+				item.Errors, codes = s.validateBulkCreate(ctx, &reqs[i])
+			}
+			creates = append(creates, entities[i])
+		} else {
+			item.Status = dto.BulkStatusUpdated
+			item.ID = current.GetID()
+			var before map[string]interface{}
+			if s.auditor != nil {
+				before = audit.Snapshot(current)
+			}
+			previous = append(previous, cloneEntity(current))
+			item.Errors, codes = s.authorizeBulkItem(ctx, OperationUpdate, current)
+			if len(item.Errors) == 0 {
+				item.Errors, codes = s.applyBulkUpdate(ctx, current, &reqs[i])
+			}
+			updates = append(updates, current)
+			befores = append(befores, before)
+		}
+
+		if len(item.Errors) > 0 {
+			item.Codes = codes
+			item.Status = dto.BulkStatusError
+			item.ID = 0
+			response.Failed++
+		}
+		response.Items[i] = item
+	}
+
+	if response.Failed > 0 {
+		s.log.WithField("failed", response.Failed).Warn("Upsert em lote rejeitado por erros de validação")
+		return response, nil
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.WithContext(ctx).SaveAll(creates, updates); err != nil {
+			s.log.WithError(err).Error("Erro ao gravar lote no banco de dados")
+			return err
+		}
+		for _, entity := range creates {
+			if err := s.hooks.AfterCreate(ctx, entity); err != nil {
+				return err
+			}
+			if err := s.enqueue(ctx, events.TypeCreated, entity); err != nil {
+				return err
+			}
+			s.publishCreated(ctx, entity)
+		}
+		for i, entity := range updates {
+			if err := s.hooks.AfterUpdate(ctx, previous[i], entity); err != nil {
+				return err
+			}
+			if err := s.enqueue(ctx, events.TypeUpdated, entity); err != nil {
+				return err
+			}
+			s.publishUpdated(ctx, previous[i], entity)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.InvalidateCache(ctx)
+
+	// IDs gerados nas inserções (creates preserva a ordem dos itens criados)
+	created := 0
+	for i := range response.Items {
+		if response.Items[i].Status == dto.BulkStatusCreated {
+			response.Items[i].ID = creates[created].GetID()
+			created++
+		}
+	}
+	response.Created = len(creates)
+	response.Updated = len(updates)
+
+	for _, entity := range creates {
+		s.audit(ctx, entity.GetID(), audit.OperationCreate, nil, audit.Snapshot(entity))
+	}
+	for i, entity := range updates {
+		s.audit(ctx, entity.GetID(), audit.OperationUpdate, befores[i], audit.Snapshot(entity))
+	}
+
+	s.log.WithFields(logging.Fields{
+		"entity":  s.Config.EntityName,
+		"created": response.Created,
+		"updated": response.Updated,
+	}).Info("Upsert em lote concluído")
+	return response, nil
+}
+
+// validateBulkCreate aplica as validações de criação a um item do lote
+// Retorna os erros e os códigos dos campos inválidos
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) validateBulkCreate(ctx context.Context, req *CreateReq) (map[string]string, map[string]string) {
+	if structErrors := s.structValidator.ToValidationResult(ctx, req); structErrors != nil && structErrors.HasErrors() {
+		return structErrors.Errors, nil
+	}
+
+	validationCtx := &ValidationContext{
+		Context:   ctx,
+		Operation: OperationCreate,
+	}
+	if customErrors := s.validator.ValidateCreate(validationCtx, req); customErrors != nil && customErrors.HasErrors() {
+		return customErrors.Errors, customErrors.Codes
+	}
+	return nil, nil
+}
+
+// applyBulkUpdate valida o item do lote como atualização do registro existente e aplica as alterações
+// O item (request de criação) é convertido para o request de atualização pelos campos JSON
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) applyBulkUpdate(ctx context.Context, entity E, req *CreateReq) (map[string]string, map[string]string) {
+	if structErrors := s.structValidator.ToValidationResult(ctx, req); structErrors != nil && structErrors.HasErrors() {
+		return structErrors.Errors, nil
+	}
+
+	var update UpdateReq
+	data, err := json.Marshal(req)
+	if err == nil {
+		err = json.Unmarshal(data, &update)
+	}
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao converter item do lote para atualização")
+		return map[string]string{"item": i18n.T(ctx, i18n.MsgBulkConvert, nil)}, nil
+	}
+
+	validationCtx := &ValidationContext{
+		Context:   ctx,
+		Operation: OperationUpdate,
+		EntityID:  entity.GetID(),
+	}
+	if customErrors := s.validator.ValidateUpdate(validationCtx, entity, &update); customErrors != nil && customErrors.HasErrors() {
+		return customErrors.Errors, customErrors.Codes
+	}
+
+	if err := s.applyUpdate(ctx, entity, &update); err != nil {
+		if itemErrors, ok := bulkItemErrors(err); ok {
+			return itemErrors.Errors, itemErrors.Codes
+		}
+		s.log.WithError(err).Error("Erro ao aplicar item do lote na atualização")
+		return map[string]string{"item": i18n.T(ctx, i18n.MsgBulkConvert, nil)}, nil
+	}
+	return nil, nil
+}
+
+// authorizeBulkItem consulta a autorização de um item do lote, reportando a negação como erro do item
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) authorizeBulkItem(ctx context.Context, operation OperationType, entity E) (map[string]string, map[string]string) {
+	if err := s.authorize(ctx, operation, entity); err != nil {
+		itemErrors, _ := bulkItemErrors(err)
+		return itemErrors.Errors, itemErrors.Codes
+	}
+	return nil, nil
+}
+
+// bulkItemErrors converte a falha de conversão de um item nos erros reportados no resultado do lote
+// Retorna false para erros que não são do item (ex: mapper que retorna entidade nil)
+func bulkItemErrors(err error) (*arqerrors.ValidationErrors, bool) {
+	var validationErrors *arqerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return validationErrors, true
+	}
+	if businessErr, ok := arqerrors.GetBusinessError(err); ok {
+		field := businessErr.Field
+		if field == "" {
+			field = "item"
+		}
+		result := arqerrors.NewValidationErrors()
+		result.AddWithCode(field, businessErr.Code, businessErr.Message)
+		return result, true
+	}
+	return nil, false
+}
+
+// isNaturalKey verifica se a coluna está declarada como chave natural da entidade
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) isNaturalKey(key string) bool {
+	for _, k := range s.Config.NaturalKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// BulkUpdate descreve uma atualização em massa: Values são aplicados aos registros que atendem Condition
+// As chaves de Values aceitam o nome da coluna ou do campo Go; valores calculados usam gorm.Expr
+type BulkUpdate struct {
+	Condition interface{}
+	Args      []interface{}
+	Values    map[string]interface{}
+}
+
+// BulkUpdateValidator pode ser implementado pelo validador da entidade para validar (ou ajustar)
+// as atualizações em massa antes da gravação. Sem ele, UpdateWhere não aplica validações de negócio
+type BulkUpdateValidator interface {
+	ValidateBulkUpdate(ctx *ValidationContext, update *BulkUpdate) *ValidationResult
+}
+
+// UpdateWhere atualiza em massa os registros que atendem a condição, sem carregá-los (ex: reajuste de
+// preço dos produtos de uma categoria). O validador da entidade é consultado quando implementa
+// BulkUpdateValidator. Retorna a quantidade de registros atualizados
+// As alterações não geram entradas no histórico (os registros afetados não são carregados)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) UpdateWhere(ctx context.Context, update *BulkUpdate) (int64, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"fields": len(update.Values),
+	}).Info("Iniciando atualização em massa")
+
+	if len(update.Values) == 0 {
+		return 0, &arqerrors.ValidationErrors{Errors: map[string]string{"values": i18n.T(ctx, i18n.MsgBulkUpdateNoValues, nil)}}
+	}
+	if err := s.authorizeCollection(ctx, OperationBulkUpdate); err != nil {
+		return 0, err
+	}
+
+	var affected int64
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		if validator, ok := s.validator.(BulkUpdateValidator); ok {
+			validationCtx := &ValidationContext{
+				Context:   ctx,
+				Operation: OperationBulkUpdate,
+			}
+			if customErrors := validator.ValidateBulkUpdate(validationCtx, update); customErrors != nil && customErrors.HasErrors() {
+				s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na atualização em massa")
+				return customErrors.ToErrors()
+			}
+		}
+
+		var err error
+		affected, err = s.repo.WithContext(ctx).UpdateWhere(update.Condition, update.Args, update.Values)
+		if err != nil {
+			s.log.WithError(err).Error("Erro na atualização em massa")
+		}
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.InvalidateCache(ctx)
+
+	s.log.WithFields(logging.Fields{
+		"entity":  s.Config.EntityName,
+		"updated": affected,
+	}).Info("Atualização em massa concluída")
+	return affected, nil
+}
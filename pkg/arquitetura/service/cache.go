@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/cache"
+	"api_fibergorm/pkg/arquitetura/repository"
+)
+
+// WithCache habilita o cache de leitura (repository.CachedRepository) nas buscas por ID e nas listagens
+// sem filtros, seleção de campos ou período. As escritas do serviço invalidam o cache após o commit;
+// escritas feitas por outros caminhos aparecem em até ttl. c nil ou ttl <= 0 mantém o cache desabilitado,
+// assim como um repositório que não é o base (ex: falsos dos testes)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithCache(c cache.Cache, ttl time.Duration) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	impl, ok := repository.Unwrap(s.repo)
+	if c == nil || ttl <= 0 || !ok {
+		s.cached = nil
+		return s
+	}
+	s.cached = repository.NewCachedRepository(impl, c, ttl)
+	return s
+}
+
+// InvalidateCache descarta as buscas e listagens em cache da entidade (nada a fazer sem WithCache)
+// Em uma transação, a invalidação ocorre após o commit (repository.AfterCommit), evitando que uma leitura
+// concorrente recoloque no cache o estado anterior. Falhas são registradas no log: o TTL limita a desatualização
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) InvalidateCache(ctx context.Context) {
+	if s.cached == nil {
+		return
+	}
+	repository.AfterCommit(ctx, func() {
+		if err := s.cached.Invalidate(context.WithoutCancel(ctx)); err != nil {
+			s.log.WithError(err).WithField("entity", s.Config.EntityName).Warn("Falha ao invalidar o cache")
+		}
+	})
+}
+
+// findByID busca a entidade pelo ID, pelo cache quando habilitado
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) findByID(ctx context.Context, id uint) (E, error) {
+	if s.cached != nil {
+		return s.cached.WithContext(ctx).FindByID(id)
+	}
+	return s.repo.WithContext(ctx).FindByID(id)
+}
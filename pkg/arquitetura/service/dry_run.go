@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// ValidateCreate executa as validações da criação (tags do request, validador customizado e conversão
+// para a entidade) sem gravar nada. Retorna os mesmos erros de validação que Create retornaria
+// Os ganchos (BeforeCreate) não são executados
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) ValidateCreate(ctx context.Context, req *CreateReq) error {
+	s.log.WithField("entity", s.Config.EntityName).Debug("Validando criação (dry run)")
+
+	if structErrors := s.structValidator.ToValidationResult(ctx, req); structErrors != nil && structErrors.HasErrors() {
+		return structErrors.ToErrors()
+	}
+	if err := s.validateCreate(ctx, req); err != nil {
+		return err
+	}
+	entity, err := s.toEntity(ctx, req)
+	if err != nil {
+		return err
+	}
+	return s.authorize(ctx, OperationCreate, entity)
+}
+
+// ValidateUpdate executa as validações da atualização da entidade sem gravar nada
+// Retorna NOT_FOUND quando a entidade não existe e os mesmos erros de validação que Update retornaria
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) ValidateUpdate(ctx context.Context, id uint, req *UpdateReq) error {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+	}).Debug("Validando atualização (dry run)")
+
+	entity, err := s.repo.WithContext(ctx).FindByID(id)
+	if err != nil {
+		if arqerrors.IsNotFound(err) {
+			return s.notFound(ctx, id)
+		}
+		s.log.WithError(err).Error("Erro ao buscar para validação")
+		return err
+	}
+	if err := s.authorize(ctx, OperationUpdate, entity); err != nil {
+		return err
+	}
+
+	return s.validateUpdate(ctx, id, entity, req)
+}
+
+// validateCreate executa o validador customizado da criação
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) validateCreate(ctx context.Context, req *CreateReq) error {
+	validationCtx := &ValidationContext{
+		Context:   ctx,
+		Operation: OperationCreate,
+	}
+
+	if customErrors := s.validator.ValidateCreate(validationCtx, req); customErrors != nil && customErrors.HasErrors() {
+		s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na criação")
+		return customErrors.ToErrors()
+	}
+	return nil
+}
+
+// validateUpdate executa a validação de struct (tags) e o validador customizado da atualização
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) validateUpdate(ctx context.Context, id uint, entity E, req *UpdateReq) error {
+	if structErrors := s.structValidator.ToValidationResult(ctx, req); structErrors != nil && structErrors.HasErrors() {
+		s.log.WithField("errors", structErrors.Errors).Warn("Erro de validação de struct na atualização")
+		return structErrors.ToErrors()
+	}
+
+	validationCtx := &ValidationContext{
+		Context:   ctx,
+		Operation: OperationUpdate,
+		EntityID:  id,
+	}
+
+	if customErrors := s.validator.ValidateUpdate(validationCtx, entity, req); customErrors != nil && customErrors.HasErrors() {
+		s.log.WithField("errors", customErrors.Errors).Warn("Erro de validação customizada na atualização")
+		return customErrors.ToErrors()
+	}
+	return nil
+}
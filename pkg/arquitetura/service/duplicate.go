@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/mapper"
+)
+
+// Duplicate cria uma cópia da entidade: os campos do request de criação são preenchidos a partir do
+// registro existente (por nome, como no AutoMapper), os campos de overrides (nomes JSON do request, ex:
+// {"codigo": "PROD002"}) são aplicados por cima e a cópia segue o fluxo do Create (validações, ganchos,
+// auditoria e eventos). ID, identificador público, datas e autoria não são copiados
+// Campo de overrides que não existe no request ou com valor do tipo errado retorna erro de validação
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Duplicate(ctx context.Context, id uint, overrides map[string]interface{}) (*Resp, error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"id":        id,
+		"overrides": len(overrides),
+	}).Info("Iniciando duplicação")
+
+	source, err := s.repo.WithContext(ctx).FindByID(id)
+	if err != nil {
+		if arqerrors.IsNotFound(err) {
+			s.log.WithField("id", id).Warn("Não encontrado para duplicação")
+			return nil, s.notFound(ctx, id)
+		}
+		s.log.WithError(err).Error("Erro ao buscar para duplicação")
+		return nil, err
+	}
+	if err := s.authorize(ctx, OperationRead, source); err != nil {
+		return nil, err
+	}
+
+	var req CreateReq
+	mapper.Map(source, &req)
+
+	if len(overrides) > 0 {
+		if err := s.applyOverrides(ctx, &req, overrides); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Create(ctx, &req)
+}
+
+// applyOverrides aplica no request os campos informados, inclusive com valores zero ("" e 0)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) applyOverrides(ctx context.Context, req *CreateReq, overrides map[string]interface{}) error {
+	data, err := json.Marshal(overrides)
+	if err != nil {
+		return s.mappingError(ctx, err)
+	}
+
+	var values CreateReq
+	if err := json.Unmarshal(data, &values); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			err = arqerrors.NewMappingError(typeErr.Field, fmt.Errorf("esperado %s", typeErr.Type))
+		}
+		return s.mappingError(ctx, err)
+	}
+
+	fields := make([]string, 0, len(overrides))
+	for field := range overrides {
+		fields = append(fields, field)
+	}
+	if err := mapper.ApplyMask(&values, req, fields); err != nil {
+		return s.mappingError(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/repository"
+)
+
+// WithEvents configura o barramento dos eventos de entidade (padrão: events.Default()); nil desabilita os eventos
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithEvents(bus *events.Bus) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	s.events = bus
+	return s
+}
+
+// publish agenda a publicação do evento para depois do commit da unidade de trabalho (descartado no rollback)
+// Os assinantes recebem um contexto sem o cancelamento da requisição, que pode ter terminado
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) publish(ctx context.Context, build func(meta events.Metadata) events.Event, op events.Type, entity E) {
+	if s.events == nil || !s.events.HasSubscribers() {
+		return
+	}
+
+	event := build(s.eventMetadata(ctx, op, entity))
+	repository.AfterCommit(ctx, func() {
+		s.events.Publish(context.WithoutCancel(ctx), event)
+	})
+}
+
+// eventMetadata monta os metadados dos eventos da entidade (barramento e outbox)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) eventMetadata(ctx context.Context, op events.Type, entity E) events.Metadata {
+	info := audit.InfoFromContext(ctx)
+	return events.Metadata{
+		Type:       op,
+		EntityName: s.Config.EntityName,
+		Table:      s.repo.TableName(),
+		ID:         entity.GetID(),
+		PublicID:   entity.GetPublicID(),
+		Actor:      info.Actor,
+		RequestID:  info.RequestID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// publishCreated agenda o evento EntityCreated
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) publishCreated(ctx context.Context, entity E) {
+	s.publish(ctx, func(meta events.Metadata) events.Event {
+		return events.EntityCreated[E]{Metadata: meta, Entity: entity}
+	}, events.TypeCreated, entity)
+}
+
+// publishUpdated agenda o evento EntityUpdated
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) publishUpdated(ctx context.Context, before, after E) {
+	s.publish(ctx, func(meta events.Metadata) events.Event {
+		return events.EntityUpdated[E]{Metadata: meta, Before: before, After: after}
+	}, events.TypeUpdated, after)
+}
+
+// publishDeleted agenda o evento EntityDeleted
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) publishDeleted(ctx context.Context, entity E) {
+	s.publish(ctx, func(meta events.Metadata) events.Event {
+		return events.EntityDeleted[E]{Metadata: meta, Entity: entity}
+	}, events.TypeDeleted, entity)
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// WithAuditor habilita a trilha de auditoria: criação, atualização, exclusão, restauração
+// e exclusão definitiva passam a ser registradas e o histórico fica disponível em GetHistory
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithAuditor(a *audit.Auditor) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	s.auditor = a
+	return s
+}
+
+// audit registra a operação na trilha de auditoria, quando habilitada
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) audit(ctx context.Context, id uint, op audit.Operation, before, after map[string]interface{}) {
+	if s.auditor == nil {
+		return
+	}
+	s.auditor.Record(ctx, s.repo.TableName(), id, op, before, after)
+}
+
+// GetHistory retorna o histórico de alterações da entidade (quem, quando e o que mudou), do mais recente
+// para o mais antigo. Sem auditoria habilitada, retorna uma página vazia
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetHistory(ctx context.Context, id uint, filter audit.Filter, page, pageSize int) (*dto.PaginatedResponse[audit.EntryResponse], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"id":        id,
+		"operation": filter.Operation,
+		"page":      page,
+		"pageSize":  pageSize,
+	}).Info("Consultando histórico")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
+
+	page, pageSize = s.normalizePagination(page, pageSize)
+
+	if s.auditor == nil {
+		return dto.NewPaginatedResponse([]audit.EntryResponse{}, 0, page, pageSize), nil
+	}
+
+	entries, total, err := s.auditor.History(ctx, s.repo.TableName(), id, filter, page, pageSize)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao consultar histórico")
+		return nil, err
+	}
+
+	responses := make([]audit.EntryResponse, len(entries))
+	for i := range entries {
+		responses[i] = entries[i].ToResponse()
+	}
+
+	return dto.NewPaginatedResponse(responses, total, page, pageSize), nil
+}
+
+// DiffVersions compara duas versões da entidade registradas na trilha de auditoria
+// (ex: o estado de terça-feira com o atual) e retorna as diferenças campo a campo
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) DiffVersions(ctx context.Context, id uint, from, to audit.VersionRef) (*audit.DiffResponse, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+	}).Info("Comparando versões")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
+
+	if s.auditor == nil {
+		return nil, arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgHistoryUnavailable))
+	}
+
+	fromEntry, err := s.auditor.Version(ctx, s.repo.TableName(), id, from)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao buscar versão inicial")
+		return nil, err
+	}
+	toEntry, err := s.auditor.Version(ctx, s.repo.TableName(), id, to)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao buscar versão final")
+		return nil, err
+	}
+
+	// Versão por ID inexistente ou nenhuma versão até a data final
+	if toEntry == nil || (from.EntryID != 0 && fromEntry == nil) {
+		s.log.WithField("id", id).Warn("Versão não encontrada no histórico")
+		return nil, arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgVersionNotFound))
+	}
+
+	return audit.NewDiffResponse(fromEntry, toEntry), nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"reflect"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// ServiceHooks define ganchos opcionais executados pelo BaseServiceImpl ao redor das escritas
+// Rodam na mesma unidade de trabalho da operação: um erro desfaz a escrita e é retornado ao cliente
+// (use erros de negócio ou de validação). Efeitos fora do banco (ex: e-mail) devem usar repository.AfterCommit
+// Embuta NoOpHooks para implementar apenas os ganchos necessários
+type ServiceHooks[E entity.Entity, CreateReq any, UpdateReq any] interface {
+	// BeforeCreate é chamado após a conversão do request, antes da inserção (pode ajustar a entidade)
+	BeforeCreate(ctx context.Context, req *CreateReq, entity E) error
+
+	// AfterCreate é chamado após a inserção, com o ID já preenchido
+	AfterCreate(ctx context.Context, entity E) error
+
+	// BeforeUpdate é chamado após a aplicação do request, antes da gravação (alterações na entidade são gravadas)
+	BeforeUpdate(ctx context.Context, req *UpdateReq, entity E) error
+
+	// AfterUpdate é chamado após a gravação com o estado anterior e o gravado; não é chamado sem colunas alteradas
+	AfterUpdate(ctx context.Context, before, after E) error
+
+	// BeforeDelete é chamado após a validação, antes da exclusão
+	BeforeDelete(ctx context.Context, entity E) error
+
+	// AfterDelete é chamado após a exclusão, com o estado da entidade antes dela
+	AfterDelete(ctx context.Context, entity E) error
+}
+
+// NoOpHooks implementa todos os ganchos sem efeito (padrão do serviço e base para ganchos parciais)
+type NoOpHooks[E entity.Entity, CreateReq any, UpdateReq any] struct{}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) BeforeCreate(ctx context.Context, req *CreateReq, entity E) error {
+	return nil
+}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) AfterCreate(ctx context.Context, entity E) error {
+	return nil
+}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) BeforeUpdate(ctx context.Context, req *UpdateReq, entity E) error {
+	return nil
+}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) AfterUpdate(ctx context.Context, before, after E) error {
+	return nil
+}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) BeforeDelete(ctx context.Context, entity E) error {
+	return nil
+}
+
+func (NoOpHooks[E, CreateReq, UpdateReq]) AfterDelete(ctx context.Context, entity E) error {
+	return nil
+}
+
+// WithHooks configura os ganchos do ciclo de vida das escritas (nil restaura o padrão sem efeito)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithHooks(hooks ServiceHooks[E, CreateReq, UpdateReq]) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	if isNilEntity(hooks) {
+		hooks = NoOpHooks[E, CreateReq, UpdateReq]{}
+	}
+	s.hooks = hooks
+	return s
+}
+
+// cloneEntity copia a entidade (cópia rasa), preservando o estado anterior para AfterUpdate
+func cloneEntity[E entity.Entity](entity E) E {
+	source := reflect.ValueOf(entity)
+	copied := reflect.New(source.Elem().Type())
+	copied.Elem().Set(source.Elem())
+	return copied.Interface().(E)
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// importRow linha válida da importação, pronta para gravação
+type importRow[E any] struct {
+	line   int
+	entity E
+}
+
+// Import grava as linhas de um arquivo de importação (ver handler.Import) como novos registros
+// Cada linha passa pelas mesmas validações da criação (tags do request e validador de negócio) e as chaves
+// naturais (Config.NaturalKeys) não podem se repetir no arquivo. As linhas válidas são gravadas em lotes de
+// Config.ImportBatchSize, cada lote em uma transação com os ganchos AfterCreate e os eventos de cada registro
+// (os ganchos Before* não são executados, como no upsert em lote); as rejeitadas são reportadas com o número
+// da linha e os erros por campo. Um lote recusado pelo banco (ex: chave duplicada gravada por outra
+// requisição) tem todas as suas linhas rejeitadas, sem desfazer os lotes já gravados
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Import(ctx context.Context, rows []dto.ImportRow[CreateReq]) (*dto.ImportResponse, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"total":  len(rows),
+	}).Info("Iniciando importação")
+
+	if len(rows) == 0 {
+		return nil, &arqerrors.ValidationErrors{Errors: map[string]string{"file": i18n.T(ctx, i18n.MsgImportEmpty, nil)}}
+	}
+	if s.Config.MaxImportRows > 0 && len(rows) > s.Config.MaxImportRows {
+		return nil, &arqerrors.ValidationErrors{Errors: map[string]string{
+			"file": i18n.T(ctx, i18n.MsgImportTooLarge, i18n.Params{"max": s.Config.MaxImportRows}),
+		}}
+	}
+
+	response := &dto.ImportResponse{Total: len(rows), Errors: []dto.ImportRowError{}}
+	reject := func(line int, errs, codes map[string]string) {
+		response.Errors = append(response.Errors, dto.ImportRowError{Line: line, Errors: errs, Codes: codes})
+	}
+
+	// Validação e conversão de todas as linhas antes da gravação
+	var valid []importRow[E]
+	seen := make(map[string]map[interface{}]int, len(s.Config.NaturalKeys))
+	for _, key := range s.Config.NaturalKeys {
+		seen[key] = make(map[interface{}]int)
+	}
+	for _, row := range rows {
+		if len(row.Errors) > 0 {
+			reject(row.Line, row.Errors, nil)
+			continue
+		}
+		if errs, codes := s.validateBulkCreate(ctx, row.Request); len(errs) > 0 {
+			reject(row.Line, errs, codes)
+			continue
+		}
+
+		entity, err := s.toEntity(ctx, row.Request)
+		if err == nil {
+			err = s.authorize(ctx, OperationCreate, entity)
+		}
+		if err != nil {
+			rowErrors, ok := bulkItemErrors(err)
+			if !ok {
+				return nil, err
+			}
+			reject(row.Line, rowErrors.Errors, rowErrors.Codes)
+			continue
+		}
+
+		duplicates, err := s.importDuplicates(ctx, seen, entity, row.Line)
+		if err != nil {
+			return nil, err
+		}
+		if len(duplicates) > 0 {
+			reject(row.Line, duplicates, nil)
+			continue
+		}
+		valid = append(valid, importRow[E]{line: row.Line, entity: entity})
+	}
+
+	batchSize := s.Config.ImportBatchSize
+	if batchSize < 1 {
+		batchSize = len(valid)
+	}
+	for start := 0; start < len(valid); start += batchSize {
+		batch := valid[start:min(start+batchSize, len(valid))]
+		if err := s.importBatch(ctx, batch); err != nil {
+			batchErrors, ok := bulkItemErrors(err)
+			if !ok {
+				return nil, err
+			}
+			s.log.WithError(err).WithField("lines", len(batch)).Warn("Lote da importação recusado")
+			for _, row := range batch {
+				reject(row.line, batchErrors.Errors, batchErrors.Codes)
+			}
+			continue
+		}
+		response.Imported += len(batch)
+	}
+	if response.Imported > 0 {
+		s.InvalidateCache(ctx)
+	}
+
+	sort.SliceStable(response.Errors, func(i, j int) bool { return response.Errors[i].Line < response.Errors[j].Line })
+	response.Rejected = len(response.Errors)
+
+	s.log.WithFields(logging.Fields{
+		"entity":   s.Config.EntityName,
+		"imported": response.Imported,
+		"rejected": response.Rejected,
+	}).Info("Importação concluída")
+	return response, nil
+}
+
+// importBatch grava um lote de linhas válidas em uma transação, com os ganchos e os eventos de cada registro
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) importBatch(ctx context.Context, batch []importRow[E]) error {
+	creates := make([]E, len(batch))
+	for i, row := range batch {
+		creates[i] = row.entity
+	}
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		if err := s.repo.WithContext(ctx).SaveAll(creates, nil); err != nil {
+			s.log.WithError(err).Error("Erro ao gravar lote da importação no banco de dados")
+			return err
+		}
+		for _, entity := range creates {
+			if err := s.hooks.AfterCreate(ctx, entity); err != nil {
+				return err
+			}
+			if err := s.enqueue(ctx, events.TypeCreated, entity); err != nil {
+				return err
+			}
+			s.publishCreated(ctx, entity)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entity := range creates {
+		s.audit(ctx, entity.GetID(), audit.OperationCreate, nil, audit.Snapshot(entity))
+	}
+	return nil
+}
+
+// importDuplicates verifica se as chaves naturais da entidade já apareceram em outra linha do arquivo
+// Registra as chaves da linha em seen e retorna os erros por chave repetida
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) importDuplicates(ctx context.Context, seen map[string]map[interface{}]int, entity E, line int) (map[string]string, error) {
+	duplicates := make(map[string]string)
+	for _, key := range s.Config.NaturalKeys {
+		value, err := s.repo.KeyValue(entity, key)
+		if err != nil {
+			return nil, err
+		}
+		if first, ok := seen[key][value]; ok {
+			duplicates[key] = i18n.T(ctx, i18n.MsgImportDuplicateKey, i18n.Params{"line": first})
+		}
+	}
+	if len(duplicates) > 0 {
+		return duplicates, nil
+	}
+	for _, key := range s.Config.NaturalKeys {
+		value, _ := s.repo.KeyValue(entity, key)
+		seen[key][value] = line
+	}
+	return nil, nil
+}
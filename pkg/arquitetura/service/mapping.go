@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/mapper"
+)
+
+// toEntity converte o request de criação para a entidade pelo mapper
+// Falhas de conversão viram erros de validação; entidade nil é tratada como erro interno
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) toEntity(ctx context.Context, req *CreateReq) (E, error) {
+	entity, err := s.mapper.ToEntity(req)
+	if err != nil {
+		var zero E
+		return zero, s.mappingError(ctx, err)
+	}
+	if isNilEntity(entity) {
+		s.log.WithField("entity", s.Config.EntityName).Error("Mapper retornou entidade nil")
+		return entity, fmt.Errorf("mapper de %s retornou entidade nil", s.Config.EntityName)
+	}
+	return entity, nil
+}
+
+// applyUpdate aplica o request de atualização na entidade pelo mapper
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) applyUpdate(ctx context.Context, entity E, req *UpdateReq) error {
+	if isNilEntity(entity) {
+		return fmt.Errorf("entidade %s nil na atualização", s.Config.EntityName)
+	}
+	if err := s.mapper.ApplyUpdate(entity, req); err != nil {
+		return s.mappingError(ctx, err)
+	}
+	return nil
+}
+
+// applyPatch aplica na entidade os campos da máscara do request (PATCH), pelo mapper quando implementa
+// dto.PatchMapper ou por correspondência de nomes (mapper.ApplyMask)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) applyPatch(ctx context.Context, entity E, req *UpdateReq, mask []string) error {
+	if isNilEntity(entity) {
+		return fmt.Errorf("entidade %s nil na atualização", s.Config.EntityName)
+	}
+	var err error
+	if patchMapper, ok := s.mapper.(dto.PatchMapper[E, UpdateReq]); ok {
+		err = patchMapper.ApplyPatch(entity, req, mask)
+	} else {
+		err = mapper.ApplyMask(req, entity, mask)
+	}
+	if err != nil {
+		return s.mappingError(ctx, err)
+	}
+	return nil
+}
+
+// mappingError converte a falha do mapper no erro retornado ao cliente
+// Erros de validação e de negócio são repassados; MappingError com campo vira erro de validação
+// do campo e os demais viram INVALID_BODY
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) mappingError(ctx context.Context, err error) error {
+	var validationErrors *arqerrors.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return validationErrors
+	}
+	if businessErr, ok := arqerrors.GetBusinessError(err); ok {
+		return businessErr
+	}
+
+	s.log.WithError(err).Warn("Falha na conversão dos dados do request")
+
+	var mappingErr *arqerrors.MappingError
+	if errors.As(err, &mappingErr) && mappingErr.Field != "" {
+		detail := ""
+		if mappingErr.Err != nil {
+			detail = mappingErr.Err.Error()
+		}
+		result := arqerrors.NewValidationErrors()
+		result.Add(mappingErr.Field, i18n.T(ctx, i18n.MsgMappingField, i18n.Params{"field": mappingErr.Field, "detail": detail}))
+		return result
+	}
+	return arqerrors.WrapError(err, arqerrors.CodeInvalidBody, i18n.T(ctx, i18n.MsgMappingFailed, nil))
+}
+
+// MapResponses converte as entidades em responses, ignorando entidades nil
+// Exportado para uso em serviços específicos com listagens próprias
+func MapResponses[E any, Resp any](items []E, toResponse func(E) *Resp) []Resp {
+	responses := make([]Resp, 0, len(items))
+	for _, item := range items {
+		if isNilEntity(item) {
+			continue
+		}
+		if response := toResponse(item); response != nil {
+			responses = append(responses, *response)
+		}
+	}
+	return responses
+}
+
+// isNilEntity verifica se a entidade é nil (interface nil ou ponteiro nil)
+func isNilEntity(entity interface{}) bool {
+	if entity == nil {
+		return true
+	}
+	v := reflect.ValueOf(entity)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
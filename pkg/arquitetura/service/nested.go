@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/repository"
+)
+
+// ParentRelation descreve o vínculo de um sub-recurso com seu recurso pai
+// (ex: produtos de uma categoria em /categorias/:categoria_id/produtos)
+type ParentRelation struct {
+	ParentName string // Nome da entidade pai para mensagens (ex: categoria)
+	ForeignKey string // Coluna da chave estrangeira na entidade filha (ex: categoria_id)
+
+	// ParentExists verifica se o recurso pai existe (e não está na lixeira)
+	ParentExists func(ctx context.Context, parentID uint) (bool, error)
+}
+
+// CheckParent verifica se o recurso pai existe
+// Retorna NOT_FOUND com o nome da entidade pai quando não existir
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) CheckParent(ctx context.Context, relation ParentRelation, parentID uint) error {
+	exists, err := relation.ParentExists(ctx, parentID)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao verificar recurso pai")
+		return err
+	}
+	if !exists {
+		s.log.WithFields(logging.Fields{
+			"parent":    relation.ParentName,
+			"parent_id": parentID,
+		}).Warn("Recurso pai não encontrado")
+		return arqerrors.NewBusinessError(arqerrors.CodeNotFound, i18n.T(ctx, i18n.MsgNotFound, i18n.Params{"entity": i18n.Entity(ctx, relation.ParentName)}))
+	}
+	return nil
+}
+
+// CheckInParent verifica se o recurso pai existe e se a entidade pertence a ele
+// Entidades de outro pai são tratadas como inexistentes (NOT_FOUND)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) CheckInParent(ctx context.Context, relation ParentRelation, parentID, id uint) error {
+	if err := s.CheckParent(ctx, relation, parentID); err != nil {
+		return err
+	}
+
+	exists, err := s.repo.WithContext(ctx).ExistsWhere(parentCondition(relation, parentID, id))
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao verificar vínculo com o recurso pai")
+		return err
+	}
+	if !exists {
+		s.log.WithFields(logging.Fields{
+			"parent_id": parentID,
+			"id":        id,
+		}).Warn("Não encontrado no recurso pai")
+		return arqerrors.NewBusinessError(arqerrors.CodeNotFound, s.message(ctx, i18n.MsgNotFound))
+	}
+	return nil
+}
+
+// GetAllByParent retorna as entidades do recurso pai com paginação
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetAllByParent(ctx context.Context, relation ParentRelation, parentID uint, page, pageSize int, countMode repository.CountMode) (*dto.PaginatedResponse[Resp], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"parent":    relation.ParentName,
+		"parent_id": parentID,
+		"page":      page,
+		"pageSize":  pageSize,
+		"countMode": countMode,
+	}).Info("Listando por recurso pai")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
+
+	if err := s.CheckParent(ctx, relation, parentID); err != nil {
+		return nil, err
+	}
+
+	page, pageSize = s.normalizePagination(page, pageSize)
+
+	result, err := s.repo.WithContext(ctx).FindAllWhereWithCountMode(page, pageSize, s.Config.DefaultOrder, countMode, parentCondition(relation, parentID, 0))
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao listar por recurso pai")
+		return nil, err
+	}
+
+	responses := MapResponses(result.Items, s.mapper.ToResponse)
+	return ToPaginatedResponse(responses, result, page, pageSize), nil
+}
+
+// GetByIDInParent busca uma entidade pelo ID dentro do recurso pai
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetByIDInParent(ctx context.Context, relation ParentRelation, parentID, id uint) (*Resp, error) {
+	if err := s.CheckParent(ctx, relation, parentID); err != nil {
+		return nil, err
+	}
+
+	entity, err := s.repo.WithContext(ctx).FindOneWhere(parentCondition(relation, parentID, id))
+	if err != nil {
+		if arqerrors.IsNotFound(err) {
+			s.log.WithFields(logging.Fields{
+				"parent_id": parentID,
+				"id":        id,
+			}).Warn("Não encontrado no recurso pai")
+			return nil, arqerrors.NewBusinessError(arqerrors.CodeNotFound, s.message(ctx, i18n.MsgNotFound))
+		}
+		s.log.WithError(err).Error("Erro ao buscar no recurso pai")
+		return nil, err
+	}
+	if isNilEntity(entity) {
+		return nil, arqerrors.NewBusinessError(arqerrors.CodeNotFound, s.message(ctx, i18n.MsgNotFound))
+	}
+	if err := s.authorize(ctx, OperationRead, entity); err != nil {
+		return nil, err
+	}
+
+	return s.mapper.ToResponse(entity), nil
+}
+
+// parentCondition monta o filtro pela chave estrangeira (e pelo ID, quando informado)
+// As colunas do map são escapadas pelo GORM
+func parentCondition(relation ParentRelation, parentID, id uint) map[string]interface{} {
+	condition := map[string]interface{}{relation.ForeignKey: parentID}
+	if id != 0 {
+		condition["id"] = id
+	}
+	return condition
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"api_fibergorm/pkg/arquitetura/events"
+	"api_fibergorm/pkg/arquitetura/outbox"
+)
+
+// WithOutbox habilita os eventos de integração: criação, atualização e exclusão gravam no outbox, na
+// transação da escrita, o evento com o response da entidade, publicado depois no tópico pelo outbox.Relay
+// Uma falha na gravação do evento desfaz a escrita. Outbox nil desabilita
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) WithOutbox(o *outbox.Outbox, topic string) *BaseServiceImpl[E, CreateReq, UpdateReq, Resp] {
+	s.outbox = o
+	s.outboxTopic = topic
+	return s
+}
+
+// enqueue grava o evento de integração da entidade no outbox, quando habilitado
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) enqueue(ctx context.Context, op events.Type, entity E) error {
+	if s.outbox == nil {
+		return nil
+	}
+	if err := s.outbox.Add(ctx, s.outboxTopic, s.eventMetadata(ctx, op, entity), s.mapper.ToResponse(entity)); err != nil {
+		s.log.WithError(err).WithField("entity", s.Config.EntityName).Error("Erro ao gravar evento no outbox")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+)
+
+// expectedUpdatedAtKey chave da pré-condição da atualização no context.Context
+type expectedUpdatedAtKey struct{}
+
+// WithExpectedUpdatedAt retorna um contexto com a pré-condição da atualização: Update e Patch são
+// rejeitados (STALE_UPDATE, 409) quando o registro gravado foi alterado depois da data informada
+// A comparação é feita em segundos, a precisão das datas das respostas e do If-Unmodified-Since
+func WithExpectedUpdatedAt(ctx context.Context, updatedAt time.Time) context.Context {
+	return context.WithValue(ctx, expectedUpdatedAtKey{}, updatedAt)
+}
+
+// ExpectedUpdatedAtFromContext retorna a pré-condição da atualização armazenada no contexto
+func ExpectedUpdatedAtFromContext(ctx context.Context) (time.Time, bool) {
+	if ctx != nil {
+		if updatedAt, ok := ctx.Value(expectedUpdatedAtKey{}).(time.Time); ok {
+			return updatedAt, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// checkPrecondition rejeita a atualização quando o registro foi alterado depois da versão esperada
+// Entidades sem data de atualização (entity.Modifiable) não são verificadas
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) checkPrecondition(ctx context.Context, e E) error {
+	expected, ok := ExpectedUpdatedAtFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	modifiable, ok := any(e).(entity.Modifiable)
+	if !ok {
+		return nil
+	}
+
+	stored := modifiable.LastModified().UTC().Truncate(time.Second)
+	if stored.After(expected.UTC().Truncate(time.Second)) {
+		s.log.WithFields(logging.Fields{
+			"entity":     s.Config.EntityName,
+			"id":         e.GetID(),
+			"updated_at": stored,
+			"expected":   expected,
+		}).Warn("Atualização rejeitada: registro alterado após a versão informada")
+		return arqerrors.NewBusinessError(arqerrors.CodeStaleUpdate, s.message(ctx, i18n.MsgStaleUpdate))
+	}
+	return nil
+}
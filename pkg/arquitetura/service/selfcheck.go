@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// SelfCheck verifica a montagem do serviço (executado na inicialização pelo selfcheck.Default())
+// Verifica se o tipo genérico da entidade é um ponteiro para struct, se o GORM consegue interpretar o
+// modelo, se TableName está definido e corresponde ao do modelo e se o mapper e o validador foram configurados
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) SelfCheck() []string {
+	var problems []string
+
+	var zero E
+	entityType := reflect.TypeOf(zero)
+	if entityType == nil || entityType.Kind() != reflect.Ptr || entityType.Elem().Kind() != reflect.Struct {
+		problems = append(problems, fmt.Sprintf("tipo da entidade deve ser um ponteiro para struct (recebido %v)", entityType))
+		return problems
+	}
+
+	if s.repo == nil {
+		problems = append(problems, "repositório não configurado")
+	} else if tableName := s.repo.TableName(); tableName == "" {
+		problems = append(problems, "TableName da entidade "+entityType.String()+" está vazio")
+	} else if parsed, err := schema.Parse(reflect.New(entityType.Elem()).Interface(), &sync.Map{}, s.namer()); err != nil {
+		problems = append(problems, fmt.Sprintf("modelo %s inválido para o GORM: %v", entityType, err))
+	} else if parsed.Table != tableName {
+		problems = append(problems, fmt.Sprintf("tabela do modelo (%s) difere de TableName (%s)", parsed.Table, tableName))
+	}
+
+	if isNilEntity(s.mapper) {
+		problems = append(problems, "mapper não configurado")
+	}
+
+	if isNilEntity(s.validator) {
+		problems = append(problems, "validador nil")
+	} else if _, noop := s.validator.(*NoOpValidator[E, CreateReq, UpdateReq]); noop && !s.Config.ValidatorOptional {
+		problems = append(problems, "validador não configurado (use WithValidator ou ServiceConfig.ValidatorOptional)")
+	} else if chain, ok := s.validator.(*ValidatorChain[E, CreateReq, UpdateReq]); ok {
+		if len(chain.Validators()) == 0 && !s.Config.ValidatorOptional {
+			problems = append(problems, "WithValidators sem validadores")
+		}
+		for i, v := range chain.Validators() {
+			if isNilEntity(v) {
+				problems = append(problems, fmt.Sprintf("validador %d de WithValidators nil", i))
+			}
+		}
+	}
+
+	return problems
+}
+
+// namer convenção de nomes da conexão do repositório (padrão do GORM em repositórios sem banco, como os falsos)
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) namer() schema.Namer {
+	if db := s.repo.GetDB(); db != nil && db.Config != nil && db.NamingStrategy != nil {
+		return db.NamingStrategy
+	}
+	return schema.NamingStrategy{}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"api_fibergorm/pkg/arquitetura/audit"
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
+	"api_fibergorm/pkg/arquitetura/logging"
+	"api_fibergorm/pkg/arquitetura/repository"
+)
+
+// GetDeleted retorna as entidades da lixeira (excluídas logicamente) com paginação
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) GetDeleted(ctx context.Context, page, pageSize int, countMode repository.CountMode, dateRange repository.DateRange) (*dto.PaginatedResponse[Resp], error) {
+	s.log.WithFields(logging.Fields{
+		"entity":    s.Config.EntityName,
+		"page":      page,
+		"pageSize":  pageSize,
+		"countMode": countMode,
+	}).Info("Listando lixeira")
+
+	if err := s.authorizeCollection(ctx, OperationRead); err != nil {
+		return nil, err
+	}
+
+	page, pageSize = s.normalizePagination(page, pageSize)
+
+	result, err := s.repo.WithContext(ctx).FindDeleted(page, pageSize, "", countMode, dateRange)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao listar lixeira")
+		return nil, err
+	}
+
+	responses := MapResponses(result.Items, s.mapper.ToResponse)
+
+	return ToPaginatedResponse(responses, result, page, pageSize), nil
+}
+
+// Restore restaura uma entidade da lixeira e retorna seu estado atual
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) Restore(ctx context.Context, id uint) (*Resp, error) {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+	}).Info("Iniciando restauração")
+
+	if err := s.authorizeCollection(ctx, OperationDelete); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.WithContext(ctx).Restore(id); err != nil {
+		if arqerrors.IsNotFound(err) {
+			s.log.WithField("id", id).Warn("Não encontrado na lixeira para restauração")
+			return nil, arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFoundInTrash))
+		}
+		s.log.WithError(err).Error("Erro ao restaurar no banco de dados")
+		return nil, err
+	}
+	s.InvalidateCache(ctx)
+
+	entity, err := s.repo.WithContext(ctx).FindByID(id)
+	if err != nil {
+		s.log.WithError(err).Error("Erro ao buscar após restauração")
+		return nil, err
+	}
+
+	s.log.WithField("id", id).Info("Restaurado com sucesso")
+	snapshot := audit.Snapshot(entity)
+	s.audit(ctx, id, audit.OperationRestore, snapshot, snapshot)
+	return s.mapper.ToResponse(entity), nil
+}
+
+// DeletePermanently remove definitivamente uma entidade da lixeira
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) DeletePermanently(ctx context.Context, id uint) error {
+	s.log.WithFields(logging.Fields{
+		"entity": s.Config.EntityName,
+		"id":     id,
+	}).Warn("Iniciando exclusão definitiva")
+
+	if err := s.authorizeCollection(ctx, OperationDelete); err != nil {
+		return err
+	}
+
+	if err := s.repo.WithContext(ctx).DeletePermanently(id); err != nil {
+		if arqerrors.IsNotFound(err) {
+			s.log.WithField("id", id).Warn("Não encontrado na lixeira para exclusão definitiva")
+			return arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFoundInTrash))
+		}
+		s.log.WithError(err).Error("Erro ao excluir definitivamente do banco de dados")
+		return err
+	}
+	s.InvalidateCache(ctx)
+
+	s.log.WithField("id", id).Warn("Excluído definitivamente")
+	s.audit(ctx, id, audit.OperationDeletePermanently, nil, nil)
+	return nil
+}
+
+// notFound monta o erro de registro inexistente para o ID
+// Com GoneWhenDeleted, um registro que está na lixeira responde GONE (410) indicando que pode ser restaurado,
+// em vez do mesmo NOT_FOUND de um ID que nunca existiu
+func (s *BaseServiceImpl[E, CreateReq, UpdateReq, Resp]) notFound(ctx context.Context, id uint) error {
+	if s.Config.GoneWhenDeleted {
+		deleted, err := s.repo.WithContext(ctx).IsDeleted(id)
+		if err != nil {
+			s.log.WithError(err).Error("Erro ao verificar a lixeira")
+			return err
+		}
+		if deleted {
+			return arqerrors.NewBusinessError(arqerrors.CodeGone, i18n.T(ctx, i18n.MsgGone, i18n.Params{
+				"entity": i18n.Entity(ctx, s.Config.EntityName),
+				"id":     strconv.FormatUint(uint64(id), 10),
+			}))
+		}
+	}
+	return arqerrors.NewBusinessError("NOT_FOUND", s.message(ctx, i18n.MsgNotFound))
+}
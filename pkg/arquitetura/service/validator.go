@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	stderrors "errors"
+	"strings"
+	"sync"
 
 	"api_fibergorm/pkg/arquitetura/entity"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/i18n"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -20,13 +25,18 @@ type OperationType string
 
 const (
 	OperationCreate OperationType = "create"
+	OperationRead   OperationType = "read"
 	OperationUpdate OperationType = "update"
 	OperationDelete OperationType = "delete"
+
+	OperationBulkUpdate OperationType = "bulk_update"
 )
 
 // ValidationResult representa o resultado de uma validação
+// Codes associa os campos a códigos do catálogo de erros (ver arqerrors.RegisterCode)
 type ValidationResult struct {
 	Errors map[string]string
+	Codes  map[string]string
 }
 
 // NewValidationResult cria um novo resultado de validação
@@ -41,6 +51,22 @@ func (v *ValidationResult) AddError(field, message string) {
 	v.Errors[field] = message
 }
 
+// AddErrorWithCode adiciona um erro de validação com o código do catálogo de erros
+func (v *ValidationResult) AddErrorWithCode(field, code, message string) {
+	v.Errors[field] = message
+	if v.Codes == nil {
+		v.Codes = make(map[string]string)
+	}
+	v.Codes[field] = code
+}
+
+// AddCodedError adiciona um erro de validação com o código do catálogo de erros e a mensagem do código
+// no idioma da requisição (chave i18n.CodeKey(code), registrada com i18n.RegisterMessages)
+// Ex: result.AddCodedError(ctx.Context, "codigo", CodeProdutoCodigoDuplicado, nil)
+func (v *ValidationResult) AddCodedError(ctx context.Context, field, code string, params i18n.Params) {
+	v.AddErrorWithCode(field, code, i18n.T(ctx, i18n.CodeKey(code), params))
+}
+
 // HasErrors retorna true se há erros
 func (v *ValidationResult) HasErrors() bool {
 	return len(v.Errors) > 0
@@ -54,6 +80,20 @@ func (v *ValidationResult) Merge(other *ValidationResult) {
 	for field, message := range other.Errors {
 		v.Errors[field] = message
 	}
+	for field, code := range other.Codes {
+		if v.Codes == nil {
+			v.Codes = make(map[string]string)
+		}
+		v.Codes[field] = code
+	}
+}
+
+// ToErrors converte o resultado para o erro de validação retornado pelos serviços
+func (v *ValidationResult) ToErrors() *arqerrors.ValidationErrors {
+	return &arqerrors.ValidationErrors{
+		Errors: v.Errors,
+		Codes:  v.Codes,
+	}
 }
 
 // EntityValidator é a interface para validações específicas de uma entidade
@@ -87,55 +127,107 @@ func (n *NoOpValidator[E, CreateReq, UpdateReq]) ValidateDelete(ctx *ValidationC
 }
 
 // StructValidator valida structs usando tags de validação
+// As mensagens vêm do catálogo de mensagens (chave i18n.ValidationKey(tag)), no idioma da requisição;
+// novas tags e mensagens são registradas com RegisterRule, RegisterMessage e RegisterStructLevel, e os
+// registros devem ser feitos na inicialização, antes de atender requisições
 type StructValidator struct {
 	validate *validator.Validate
+	messages map[string]string // Mensagens fixas por tag, que prevalecem sobre o catálogo
 }
 
+var (
+	defaultStructValidator     *StructValidator
+	defaultStructValidatorOnce sync.Once
+)
+
 // NewStructValidator cria um novo validador de structs
+// Inclui as tags de documentos brasileiros: cpf, cnpj, cep e telefone_br
 func NewStructValidator() *StructValidator {
+	validate := validator.New()
+	registerBrazilianRules(validate)
+
 	return &StructValidator{
-		validate: validator.New(),
+		validate: validate,
+		messages: make(map[string]string),
 	}
 }
 
-// Validate valida uma struct e retorna os erros formatados
+// DefaultStructValidator retorna o validador de structs compartilhado pelos handlers e serviços base
+// As regras registradas nele valem para toda a aplicação
+func DefaultStructValidator() *StructValidator {
+	defaultStructValidatorOnce.Do(func() {
+		defaultStructValidator = NewStructValidator()
+	})
+	return defaultStructValidator
+}
+
+// RegisterRule registra uma tag de validação customizada e sua mensagem
+// messageTemplate aceita {field} e {param} (ex: "O campo {field} deve ser múltiplo de {param}") e vale para
+// todos os idiomas; vazio, a mensagem é buscada no catálogo pela chave i18n.ValidationKey(tag), que pode
+// ser traduzida com i18n.RegisterMessages
+//
+// Ex: sv.RegisterRule("sku", func(fl validator.FieldLevel) bool { ... }, "O campo {field} deve ser um SKU válido")
+func (sv *StructValidator) RegisterRule(tag string, fn validator.Func, messageTemplate string) error {
+	if err := sv.validate.RegisterValidation(tag, fn); err != nil {
+		return err
+	}
+	if messageTemplate != "" {
+		sv.messages[tag] = messageTemplate
+	}
+	return nil
+}
+
+// RegisterMessage registra ou substitui a mensagem de uma tag em todos os idiomas
+// Para traduzir a mensagem, registre a chave i18n.ValidationKey(tag) com i18n.RegisterMessages
+func (sv *StructValidator) RegisterMessage(tag, messageTemplate string) {
+	sv.messages[tag] = messageTemplate
+}
+
+// RegisterStructLevel registra uma validação envolvendo vários campos dos tipos informados
+// Os erros são reportados com sl.ReportError(valor, campo, campoStruct, tag, param) e usam a
+// mensagem registrada para a tag
+func (sv *StructValidator) RegisterStructLevel(fn validator.StructLevelFunc, types ...interface{}) {
+	sv.validate.RegisterStructValidation(fn, types...)
+}
+
+// Validate valida uma struct e retorna os erros formatados no idioma padrão
 func (sv *StructValidator) Validate(i interface{}) map[string]string {
+	return sv.ValidateContext(context.Background(), i)
+}
+
+// ValidateContext valida uma struct e retorna os erros formatados no idioma do contexto (ver i18n.WithLocale)
+func (sv *StructValidator) ValidateContext(ctx context.Context, i interface{}) map[string]string {
 	errors := make(map[string]string)
 
 	if err := sv.validate.Struct(i); err != nil {
-		for _, err := range err.(validator.ValidationErrors) {
-			field := err.Field()
-			tag := err.Tag()
-
-			switch tag {
-			case "required":
-				errors[field] = "O campo " + field + " é obrigatório"
-			case "min":
-				errors[field] = "O campo " + field + " deve ter no mínimo " + err.Param() + " caracteres"
-			case "max":
-				errors[field] = "O campo " + field + " deve ter no máximo " + err.Param() + " caracteres"
-			case "gt":
-				errors[field] = "O campo " + field + " deve ser maior que " + err.Param()
-			case "gte":
-				errors[field] = "O campo " + field + " deve ser maior ou igual a " + err.Param()
-			case "lt":
-				errors[field] = "O campo " + field + " deve ser menor que " + err.Param()
-			case "lte":
-				errors[field] = "O campo " + field + " deve ser menor ou igual a " + err.Param()
-			case "email":
-				errors[field] = "O campo " + field + " deve ser um email válido"
-			default:
-				errors[field] = "O campo " + field + " é inválido"
-			}
+		var validationErrors validator.ValidationErrors
+		if !stderrors.As(err, &validationErrors) {
+			errors["_"] = err.Error()
+			return errors
+		}
+		for _, err := range validationErrors {
+			errors[err.Field()] = sv.message(ctx, err)
 		}
 	}
 
 	return errors
 }
 
-// ToValidationResult converte erros de validação para ValidationResult
-func (sv *StructValidator) ToValidationResult(i interface{}) *ValidationResult {
-	errors := sv.Validate(i)
+// message monta a mensagem do erro: a mensagem fixa da tag ou a do catálogo no idioma do contexto
+func (sv *StructValidator) message(ctx context.Context, err validator.FieldError) string {
+	params := i18n.Params{"field": err.Field(), "param": err.Param()}
+	if template, ok := sv.messages[err.Tag()]; ok {
+		return strings.NewReplacer("{field}", err.Field(), "{param}", err.Param()).Replace(template)
+	}
+	if key := i18n.ValidationKey(err.Tag()); i18n.Has(ctx, key) {
+		return i18n.T(ctx, key, params)
+	}
+	return i18n.T(ctx, i18n.MsgValidationInvalid, params)
+}
+
+// ToValidationResult converte erros de validação para ValidationResult, com as mensagens no idioma do contexto
+func (sv *StructValidator) ToValidationResult(ctx context.Context, i interface{}) *ValidationResult {
+	errors := sv.ValidateContext(ctx, i)
 	if len(errors) == 0 {
 		return nil
 	}
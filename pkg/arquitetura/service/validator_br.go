@@ -0,0 +1,128 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	cpfPattern  = regexp.MustCompile(`^(\d{11}|\d{3}\.\d{3}\.\d{3}-\d{2})$`)
+	cnpjPattern = regexp.MustCompile(`^(\d{14}|\d{2}\.\d{3}\.\d{3}/\d{4}-\d{2})$`)
+	cepPattern  = regexp.MustCompile(`^\d{5}-?\d{3}$`)
+	fonePattern = regexp.MustCompile(`^[\d\s()+-]+$`)
+)
+
+// registerBrazilianRules registra as tags de documentos brasileiros: cpf, cnpj, cep e telefone_br
+func registerBrazilianRules(v *validator.Validate) {
+	_ = v.RegisterValidation("cpf", func(fl validator.FieldLevel) bool { return IsCPF(fl.Field().String()) })
+	_ = v.RegisterValidation("cnpj", func(fl validator.FieldLevel) bool { return IsCNPJ(fl.Field().String()) })
+	_ = v.RegisterValidation("cep", func(fl validator.FieldLevel) bool { return IsCEP(fl.Field().String()) })
+	_ = v.RegisterValidation("telefone_br", func(fl validator.FieldLevel) bool { return IsTelefoneBR(fl.Field().String()) })
+}
+
+// IsCPF verifica se o valor é um CPF válido (com ou sem máscara), conferindo os dígitos verificadores
+func IsCPF(value string) bool {
+	if !cpfPattern.MatchString(value) {
+		return false
+	}
+
+	digits := onlyDigits(value)
+	if repeated(digits) {
+		return false
+	}
+	return checkDigit(digits[:9], 10) == digits[9] &&
+		checkDigit(digits[:10], 11) == digits[10]
+}
+
+// IsCNPJ verifica se o valor é um CNPJ válido (com ou sem máscara), conferindo os dígitos verificadores
+func IsCNPJ(value string) bool {
+	if !cnpjPattern.MatchString(value) {
+		return false
+	}
+
+	digits := onlyDigits(value)
+	if repeated(digits) {
+		return false
+	}
+	return cnpjCheckDigit(digits[:12]) == digits[12] &&
+		cnpjCheckDigit(digits[:13]) == digits[13]
+}
+
+// IsCEP verifica se o valor é um CEP no formato 00000-000 ou 00000000
+func IsCEP(value string) bool {
+	return cepPattern.MatchString(value)
+}
+
+// IsTelefoneBR verifica se o valor é um telefone brasileiro com DDD, fixo (10 dígitos) ou celular
+// (11 dígitos, iniciando em 9), aceitando máscara e o código do país (+55)
+func IsTelefoneBR(value string) bool {
+	if !fonePattern.MatchString(value) {
+		return false
+	}
+
+	digits := onlyDigits(value)
+	if (len(digits) == 12 || len(digits) == 13) && strings.HasPrefix(digits, "55") {
+		digits = digits[2:]
+	}
+
+	// DDD: dois dígitos de 1 a 9
+	if len(digits) < 2 || digits[0] == '0' || digits[1] == '0' {
+		return false
+	}
+
+	switch len(digits) {
+	case 10:
+		return digits[2] >= '2' && digits[2] <= '8'
+	case 11:
+		return digits[2] == '9'
+	}
+	return false
+}
+
+// checkDigit calcula o dígito verificador do CPF com pesos decrescentes a partir de weight
+func checkDigit(digits string, weight int) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		sum += int(digits[i]-'0') * (weight - i)
+	}
+	rest := sum * 10 % 11
+	if rest == 10 {
+		rest = 0
+	}
+	return byte('0' + rest)
+}
+
+// cnpjCheckDigit calcula o dígito verificador do CNPJ (pesos de 2 a 9, da direita para a esquerda)
+func cnpjCheckDigit(digits string) byte {
+	sum, weight := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight++
+		if weight > 9 {
+			weight = 2
+		}
+	}
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - rest)
+}
+
+// onlyDigits remove a máscara, mantendo apenas os dígitos
+func onlyDigits(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// repeated indica sequências de um único dígito (ex: 111.111.111-11), que passam no cálculo mas são inválidas
+func repeated(digits string) bool {
+	return strings.Count(digits, digits[:1]) == len(digits)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"api_fibergorm/pkg/arquitetura/entity"
+)
+
+// ValidatorChain compõe vários validadores da entidade em um só (ver WithValidators)
+// Os validadores são executados em ordem e os resultados combinados: todos são consultados,
+// mesmo quando um anterior já retornou erros, e o primeiro erro de cada campo prevalece
+type ValidatorChain[E entity.Entity, CreateReq any, UpdateReq any] struct {
+	validators []EntityValidator[E, CreateReq, UpdateReq]
+}
+
+// NewValidatorChain cria a composição dos validadores na ordem informada
+func NewValidatorChain[E entity.Entity, CreateReq any, UpdateReq any](validators ...EntityValidator[E, CreateReq, UpdateReq]) *ValidatorChain[E, CreateReq, UpdateReq] {
+	return &ValidatorChain[E, CreateReq, UpdateReq]{validators: validators}
+}
+
+// Validators retorna os validadores da composição
+func (c *ValidatorChain[E, CreateReq, UpdateReq]) Validators() []EntityValidator[E, CreateReq, UpdateReq] {
+	return c.validators
+}
+
+// ValidateCreate executa a validação de criação de todos os validadores
+func (c *ValidatorChain[E, CreateReq, UpdateReq]) ValidateCreate(ctx *ValidationContext, req *CreateReq) *ValidationResult {
+	result := NewValidationResult()
+	for _, v := range c.validators {
+		result.mergeFirst(v.ValidateCreate(ctx, req))
+	}
+	return result
+}
+
+// ValidateUpdate executa a validação de atualização de todos os validadores
+func (c *ValidatorChain[E, CreateReq, UpdateReq]) ValidateUpdate(ctx *ValidationContext, entity E, req *UpdateReq) *ValidationResult {
+	result := NewValidationResult()
+	for _, v := range c.validators {
+		result.mergeFirst(v.ValidateUpdate(ctx, entity, req))
+	}
+	return result
+}
+
+// ValidateDelete executa a validação de exclusão de todos os validadores
+func (c *ValidatorChain[E, CreateReq, UpdateReq]) ValidateDelete(ctx *ValidationContext, entity E) *ValidationResult {
+	result := NewValidationResult()
+	for _, v := range c.validators {
+		result.mergeFirst(v.ValidateDelete(ctx, entity))
+	}
+	return result
+}
+
+// ValidateBulkUpdate executa a validação das atualizações em massa dos validadores que implementam
+// BulkUpdateValidator (os demais são ignorados)
+func (c *ValidatorChain[E, CreateReq, UpdateReq]) ValidateBulkUpdate(ctx *ValidationContext, update *BulkUpdate) *ValidationResult {
+	result := NewValidationResult()
+	for _, v := range c.validators {
+		if bulk, ok := v.(BulkUpdateValidator); ok {
+			result.mergeFirst(bulk.ValidateBulkUpdate(ctx, update))
+		}
+	}
+	return result
+}
+
+// mergeFirst combina o resultado mantendo os erros já registrados (o primeiro erro de cada campo prevalece)
+func (v *ValidationResult) mergeFirst(other *ValidationResult) {
+	if other == nil {
+		return
+	}
+	for field, message := range other.Errors {
+		if _, exists := v.Errors[field]; exists {
+			continue
+		}
+		if code, ok := other.Codes[field]; ok {
+			v.AddErrorWithCode(field, code, message)
+			continue
+		}
+		v.AddError(field, message)
+	}
+}
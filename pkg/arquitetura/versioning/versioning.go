@@ -0,0 +1,135 @@
+package versioning
+
+import (
+	"fmt"
+	"strings"
+
+	"api_fibergorm/pkg/arquitetura/dto"
+	arqerrors "api_fibergorm/pkg/arquitetura/errors"
+	"api_fibergorm/pkg/arquitetura/handler"
+	"api_fibergorm/pkg/arquitetura/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// HeaderVersion cabeçalho usado na negociação da versão e informado nas respostas
+const HeaderVersion = "API-Version"
+
+// Config configurações das versões da API
+type Config struct {
+	Prefix   string   // Prefixo das rotas versionadas (padrão: /api)
+	Versions []string // Versões disponíveis (ex: v1, v2)
+	Default  string   // Versão usada quando o cliente não informa (padrão: a primeira de Versions)
+
+	// Negotiation habilita o acesso sem versão no path (ex: /api/categorias),
+	// resolvendo a versão pelo cabeçalho API-Version ou usando Default
+	Negotiation bool
+}
+
+// Registry mantém os grupos de rotas de cada versão da API
+// Os handlers de cada versão compartilham os serviços; apenas os DTOs mudam
+// (ver handler.NewVersionedService)
+type Registry struct {
+	config Config
+	groups map[string]fiber.Router
+}
+
+// New cria os grupos de rotas das versões configuradas (ex: /api/v1, /api/v2)
+// Registra o middleware que informa a versão atendida e, com a negociação habilitada,
+// resolve a versão das rotas sem versão
+func New(app *fiber.App, config Config) (*Registry, error) {
+	if len(config.Versions) == 0 {
+		return nil, fmt.Errorf("nenhuma versão da API configurada")
+	}
+	if config.Prefix == "" {
+		config.Prefix = "/api"
+	}
+	config.Prefix = strings.TrimRight(config.Prefix, "/")
+	if config.Default == "" {
+		config.Default = config.Versions[0]
+	}
+
+	r := &Registry{
+		config: config,
+		groups: make(map[string]fiber.Router, len(config.Versions)),
+	}
+	if !r.isVersion(config.Default) {
+		return nil, fmt.Errorf("versão padrão da API %s não está entre as versões disponíveis (%s)",
+			config.Default, strings.Join(config.Versions, ", "))
+	}
+
+	app.Use(config.Prefix, r.middleware())
+
+	for _, version := range config.Versions {
+		r.groups[version] = app.Group(config.Prefix + "/" + version)
+	}
+	return r, nil
+}
+
+// Group retorna o grupo de rotas da versão (ex: "v1" → /api/v1)
+// Versões não configuradas causam panic, pois indicam erro de programação no registro das rotas
+func (r *Registry) Group(version string) fiber.Router {
+	group, ok := r.groups[version]
+	if !ok {
+		panic("versão da API não configurada: " + version)
+	}
+	return group
+}
+
+// Versions retorna as versões configuradas
+func (r *Registry) Versions() []string {
+	return r.config.Versions
+}
+
+// middleware informa a versão atendida no cabeçalho API-Version e, com a negociação habilitada,
+// reescreve as rotas sem versão para a versão solicitada (ex: /api/categorias → /api/v2/categorias)
+func (r *Registry) middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rest := strings.TrimPrefix(c.Path(), r.config.Prefix)
+		segment := strings.SplitN(strings.TrimPrefix(rest, "/"), "/", 2)[0]
+
+		if r.isVersion(segment) {
+			c.Set(HeaderVersion, segment)
+			return c.Next()
+		}
+
+		if !r.config.Negotiation {
+			return c.Next()
+		}
+
+		version := r.config.Default
+		if requested := c.Get(HeaderVersion); requested != "" {
+			version = normalize(requested)
+			if !r.isVersion(version) {
+				return handler.SendError(c, fiber.StatusBadRequest, dto.ErrorResponse{
+					Code:  arqerrors.CodeUnsupportedVersion,
+					Error: handler.Message(c, i18n.MsgUnsupportedVersion, i18n.Params{"version": requested, "versions": strings.Join(r.config.Versions, ", ")}),
+				})
+			}
+		}
+
+		c.Path(r.config.Prefix + "/" + version + rest)
+		c.Set(HeaderVersion, version)
+		c.Append(fiber.HeaderVary, HeaderVersion)
+		return c.Next()
+	}
+}
+
+// isVersion verifica se o valor é uma das versões configuradas
+func (r *Registry) isVersion(value string) bool {
+	for _, version := range r.config.Versions {
+		if version == value {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize aceita a versão com ou sem o prefixo "v" (ex: "2" → "v2")
+func normalize(version string) string {
+	version = strings.ToLower(strings.TrimSpace(version))
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	return version
+}